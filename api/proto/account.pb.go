@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: api/proto/account.proto
+
+package transaction
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Клиент банка
+type Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Segment       string                 `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`     // retail, sme, corporate
+	Residency     string                 `protobuf:"bytes,4,opt,name=residency,proto3" json:"residency,omitempty"` // resident, non_resident
+	Country       string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	KycRiskRating string                 `protobuf:"bytes,6,opt,name=kyc_risk_rating,json=kycRiskRating,proto3" json:"kyc_risk_rating,omitempty"` // low, medium, high
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_api_proto_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Customer) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Customer) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Customer) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *Customer) GetResidency() string {
+	if x != nil {
+		return x.Residency
+	}
+	return ""
+}
+
+func (x *Customer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Customer) GetKycRiskRating() string {
+	if x != nil {
+		return x.KycRiskRating
+	}
+	return ""
+}
+
+func (x *Customer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Customer) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Счет клиента
+type Account struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber    string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpenedAt         string                 `protobuf:"bytes,4,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	DeclaredTurnover float64                `protobuf:"fixed64,5,opt,name=declared_turnover,json=declaredTurnover,proto3" json:"declared_turnover,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_api_proto_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Account) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *Account) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetOpenedAt() string {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return ""
+}
+
+func (x *Account) GetDeclaredTurnover() float64 {
+	if x != nil {
+		return x.DeclaredTurnover
+	}
+	return 0
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Account) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Счет вместе с владельцем
+type AccountProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Customer      *Customer              `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountProfile) Reset() {
+	*x = AccountProfile{}
+	mi := &file_api_proto_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountProfile) ProtoMessage() {}
+
+func (x *AccountProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountProfile.ProtoReflect.Descriptor instead.
+func (*AccountProfile) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountProfile) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountProfile) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// Запрос на получение клиента
+type GetCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	mi := &file_api_proto_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCustomerRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// Запрос на получение счета
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_api_proto_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccountRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+// Запрос на получение счетов клиента
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_api_proto_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAccountsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+// Ответ со счетами клиента
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_api_proto_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// Запрос активности счета
+type GetAccountActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // Время операции, RFC3339, включительно
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // Время операции, RFC3339, не включительно
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"` // Группировка итогов: day, week, month
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`  // Последних операций в каждом направлении
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountActivityRequest) Reset() {
+	*x = GetAccountActivityRequest{}
+	mi := &file_api_proto_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountActivityRequest) ProtoMessage() {}
+
+func (x *GetAccountActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountActivityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAccountActivityRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetAccountActivityRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAccountActivityRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAccountActivityRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetAccountActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Активность счета
+type AccountActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Sent          []*AccountTransaction  `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`         // Счет - отправитель
+	Received      []*AccountTransaction  `protobuf:"bytes,3,rep,name=received,proto3" json:"received,omitempty"` // Счет - контрагент
+	Totals        *AccountActivityTotals `protobuf:"bytes,4,opt,name=totals,proto3" json:"totals,omitempty"`
+	Velocity      *AccountVelocity       `protobuf:"bytes,5,opt,name=velocity,proto3" json:"velocity,omitempty"` // Не заполнено, если Redis недоступен
+	ListEntries   []*AccountListEntry    `protobuf:"bytes,6,rep,name=list_entries,json=listEntries,proto3" json:"list_entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountActivity) Reset() {
+	*x = AccountActivity{}
+	mi := &file_api_proto_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountActivity) ProtoMessage() {}
+
+func (x *AccountActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountActivity.ProtoReflect.Descriptor instead.
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AccountActivity) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *AccountActivity) GetSent() []*AccountTransaction {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+func (x *AccountActivity) GetReceived() []*AccountTransaction {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *AccountActivity) GetTotals() *AccountActivityTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *AccountActivity) GetVelocity() *AccountVelocity {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *AccountActivity) GetListEntries() []*AccountListEntry {
+	if x != nil {
+		return x.ListEntries
+	}
+	return nil
+}
+
+// Операция в активности счета
+type AccountTransaction struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingId        string                 `protobuf:"bytes,1,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	TransactionId       string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountNumber       string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount              float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionType     string                 `protobuf:"bytes,6,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	CounterpartyAccount string                 `protobuf:"bytes,7,opt,name=counterparty_account,json=counterpartyAccount,proto3" json:"counterparty_account,omitempty"`
+	CounterpartyCountry string                 `protobuf:"bytes,8,opt,name=counterparty_country,json=counterpartyCountry,proto3" json:"counterparty_country,omitempty"`
+	Channel             string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Timestamp           string                 `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	RiskScore           int32                  `protobuf:"varint,12,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	RiskLevel           string                 `protobuf:"bytes,13,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Flags               []string               `protobuf:"bytes,14,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AccountTransaction) Reset() {
+	*x = AccountTransaction{}
+	mi := &file_api_proto_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTransaction) ProtoMessage() {}
+
+func (x *AccountTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTransaction.ProtoReflect.Descriptor instead.
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccountTransaction) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetCounterpartyAccount() string {
+	if x != nil {
+		return x.CounterpartyAccount
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetCounterpartyCountry() string {
+	if x != nil {
+		return x.CounterpartyCountry
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetRiskScore() int32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// Итоги операций счета; суммы в разных валютах не складываются
+type AccountActivityTotals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ByCurrency    []*ActivityTotal       `protobuf:"bytes,1,rep,name=by_currency,json=byCurrency,proto3" json:"by_currency,omitempty"`
+	ByCountry     []*ActivityTotal       `protobuf:"bytes,2,rep,name=by_country,json=byCountry,proto3" json:"by_country,omitempty"`
+	ByChannel     []*ActivityTotal       `protobuf:"bytes,3,rep,name=by_channel,json=byChannel,proto3" json:"by_channel,omitempty"`
+	ByPeriod      []*ActivityTotal       `protobuf:"bytes,4,rep,name=by_period,json=byPeriod,proto3" json:"by_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountActivityTotals) Reset() {
+	*x = AccountActivityTotals{}
+	mi := &file_api_proto_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountActivityTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountActivityTotals) ProtoMessage() {}
+
+func (x *AccountActivityTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountActivityTotals.ProtoReflect.Descriptor instead.
+func (*AccountActivityTotals) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AccountActivityTotals) GetByCurrency() []*ActivityTotal {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
+func (x *AccountActivityTotals) GetByCountry() []*ActivityTotal {
+	if x != nil {
+		return x.ByCountry
+	}
+	return nil
+}
+
+func (x *AccountActivityTotals) GetByChannel() []*ActivityTotal {
+	if x != nil {
+		return x.ByChannel
+	}
+	return nil
+}
+
+func (x *AccountActivityTotals) GetByPeriod() []*ActivityTotal {
+	if x != nil {
+		return x.ByPeriod
+	}
+	return nil
+}
+
+// Число и сумма операций одного направления в одной группе
+type ActivityTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"` // sent, received
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTotal) Reset() {
+	*x = ActivityTotal{}
+	mi := &file_api_proto_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTotal) ProtoMessage() {}
+
+func (x *ActivityTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTotal.ProtoReflect.Descriptor instead.
+func (*ActivityTotal) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ActivityTotal) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ActivityTotal) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ActivityTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ActivityTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ActivityTotal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Текущие значения счетчиков Redis по счету
+type AccountVelocity struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DailyCount           int64                  `protobuf:"varint,1,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	FlowWindow           string                 `protobuf:"bytes,2,opt,name=flow_window,json=flowWindow,proto3" json:"flow_window,omitempty"`
+	Inflow               float64                `protobuf:"fixed64,3,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow              float64                `protobuf:"fixed64,4,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	InflowCount          int64                  `protobuf:"varint,5,opt,name=inflow_count,json=inflowCount,proto3" json:"inflow_count,omitempty"`
+	OutflowCount         int64                  `protobuf:"varint,6,opt,name=outflow_count,json=outflowCount,proto3" json:"outflow_count,omitempty"`
+	FanWindow            string                 `protobuf:"bytes,7,opt,name=fan_window,json=fanWindow,proto3" json:"fan_window,omitempty"`
+	FanIn                int64                  `protobuf:"varint,8,opt,name=fan_in,json=fanIn,proto3" json:"fan_in,omitempty"`
+	FanOut               int64                  `protobuf:"varint,9,opt,name=fan_out,json=fanOut,proto3" json:"fan_out,omitempty"`
+	BaselineTransactions int64                  `protobuf:"varint,10,opt,name=baseline_transactions,json=baselineTransactions,proto3" json:"baseline_transactions,omitempty"` // Операций в поведенческом профиле
+	BaselineMeanAmount   float64                `protobuf:"fixed64,11,opt,name=baseline_mean_amount,json=baselineMeanAmount,proto3" json:"baseline_mean_amount,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AccountVelocity) Reset() {
+	*x = AccountVelocity{}
+	mi := &file_api_proto_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountVelocity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountVelocity) ProtoMessage() {}
+
+func (x *AccountVelocity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountVelocity.ProtoReflect.Descriptor instead.
+func (*AccountVelocity) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AccountVelocity) GetDailyCount() int64 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetFlowWindow() string {
+	if x != nil {
+		return x.FlowWindow
+	}
+	return ""
+}
+
+func (x *AccountVelocity) GetInflow() float64 {
+	if x != nil {
+		return x.Inflow
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetOutflow() float64 {
+	if x != nil {
+		return x.Outflow
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetInflowCount() int64 {
+	if x != nil {
+		return x.InflowCount
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetOutflowCount() int64 {
+	if x != nil {
+		return x.OutflowCount
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetFanWindow() string {
+	if x != nil {
+		return x.FanWindow
+	}
+	return ""
+}
+
+func (x *AccountVelocity) GetFanIn() int64 {
+	if x != nil {
+		return x.FanIn
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetFanOut() int64 {
+	if x != nil {
+		return x.FanOut
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetBaselineTransactions() int64 {
+	if x != nil {
+		return x.BaselineTransactions
+	}
+	return 0
+}
+
+func (x *AccountVelocity) GetBaselineMeanAmount() float64 {
+	if x != nil {
+		return x.BaselineMeanAmount
+	}
+	return 0
+}
+
+// Запись черного или санкционного списка
+type AccountListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListType      string                 `protobuf:"bytes,2,opt,name=list_type,json=listType,proto3" json:"list_type,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	AddedBy       string                 `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountListEntry) Reset() {
+	*x = AccountListEntry{}
+	mi := &file_api_proto_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountListEntry) ProtoMessage() {}
+
+func (x *AccountListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountListEntry.ProtoReflect.Descriptor instead.
+func (*AccountListEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountListEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountListEntry) GetListType() string {
+	if x != nil {
+		return x.ListType
+	}
+	return ""
+}
+
+func (x *AccountListEntry) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *AccountListEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountListEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountListEntry) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *AccountListEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_api_proto_account_proto protoreflect.FileDescriptor
+
+const file_api_proto_account_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/proto/account.proto\x12\vtransaction\"\x80\x02\n" +
+	"\bCustomer\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x18\n" +
+	"\asegment\x18\x03 \x01(\tR\asegment\x12\x1c\n" +
+	"\tresidency\x18\x04 \x01(\tR\tresidency\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12&\n" +
+	"\x0fkyc_risk_rating\x18\x06 \x01(\tR\rkycRiskRating\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x8d\x02\n" +
+	"\aAccount\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\topened_at\x18\x04 \x01(\tR\bopenedAt\x12+\n" +
+	"\x11declared_turnover\x18\x05 \x01(\x01R\x10declaredTurnover\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"s\n" +
+	"\x0eAccountProfile\x12.\n" +
+	"\aaccount\x18\x01 \x01(\v2\x14.transaction.AccountR\aaccount\x121\n" +
+	"\bcustomer\x18\x02 \x01(\v2\x15.transaction.CustomerR\bcustomer\"5\n" +
+	"\x12GetCustomerRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\":\n" +
+	"\x11GetAccountRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\"6\n" +
+	"\x13ListAccountsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"H\n" +
+	"\x14ListAccountsResponse\x120\n" +
+	"\baccounts\x18\x01 \x03(\v2\x14.transaction.AccountR\baccounts\"\x94\x01\n" +
+	"\x19GetAccountActivityRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xe2\x02\n" +
+	"\x0fAccountActivity\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x123\n" +
+	"\x04sent\x18\x02 \x03(\v2\x1f.transaction.AccountTransactionR\x04sent\x12;\n" +
+	"\breceived\x18\x03 \x03(\v2\x1f.transaction.AccountTransactionR\breceived\x12:\n" +
+	"\x06totals\x18\x04 \x01(\v2\".transaction.AccountActivityTotalsR\x06totals\x128\n" +
+	"\bvelocity\x18\x05 \x01(\v2\x1c.transaction.AccountVelocityR\bvelocity\x12@\n" +
+	"\flist_entries\x18\x06 \x03(\v2\x1d.transaction.AccountListEntryR\vlistEntries\"\xf0\x03\n" +
+	"\x12AccountTransaction\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12)\n" +
+	"\x10transaction_type\x18\x06 \x01(\tR\x0ftransactionType\x121\n" +
+	"\x14counterparty_account\x18\a \x01(\tR\x13counterpartyAccount\x121\n" +
+	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x1c\n" +
+	"\ttimestamp\x18\n" +
+	" \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\f \x01(\x05R\triskScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\r \x01(\tR\triskLevel\x12\x14\n" +
+	"\x05flags\x18\x0e \x03(\tR\x05flags\"\x83\x02\n" +
+	"\x15AccountActivityTotals\x12;\n" +
+	"\vby_currency\x18\x01 \x03(\v2\x1a.transaction.ActivityTotalR\n" +
+	"byCurrency\x129\n" +
+	"\n" +
+	"by_country\x18\x02 \x03(\v2\x1a.transaction.ActivityTotalR\tbyCountry\x129\n" +
+	"\n" +
+	"by_channel\x18\x03 \x03(\v2\x1a.transaction.ActivityTotalR\tbyChannel\x127\n" +
+	"\tby_period\x18\x04 \x03(\v2\x1a.transaction.ActivityTotalR\bbyPeriod\"\x89\x01\n" +
+	"\rActivityTotal\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"\x83\x03\n" +
+	"\x0fAccountVelocity\x12\x1f\n" +
+	"\vdaily_count\x18\x01 \x01(\x03R\n" +
+	"dailyCount\x12\x1f\n" +
+	"\vflow_window\x18\x02 \x01(\tR\n" +
+	"flowWindow\x12\x16\n" +
+	"\x06inflow\x18\x03 \x01(\x01R\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\x04 \x01(\x01R\aoutflow\x12!\n" +
+	"\finflow_count\x18\x05 \x01(\x03R\vinflowCount\x12#\n" +
+	"\routflow_count\x18\x06 \x01(\x03R\foutflowCount\x12\x1d\n" +
+	"\n" +
+	"fan_window\x18\a \x01(\tR\tfanWindow\x12\x15\n" +
+	"\x06fan_in\x18\b \x01(\x03R\x05fanIn\x12\x17\n" +
+	"\afan_out\x18\t \x01(\x03R\x06fanOut\x123\n" +
+	"\x15baseline_transactions\x18\n" +
+	" \x01(\x03R\x14baselineTransactions\x120\n" +
+	"\x14baseline_mean_amount\x18\v \x01(\x01R\x12baselineMeanAmount\"\xcc\x01\n" +
+	"\x10AccountListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tlist_type\x18\x02 \x01(\tR\blistType\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x19\n" +
+	"\badded_by\x18\x06 \x01(\tR\aaddedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt2\xcc\x03\n" +
+	"\x0eAccountService\x12<\n" +
+	"\fSaveCustomer\x12\x15.transaction.Customer\x1a\x15.transaction.Customer\x12E\n" +
+	"\vGetCustomer\x12\x1f.transaction.GetCustomerRequest\x1a\x15.transaction.Customer\x129\n" +
+	"\vSaveAccount\x12\x14.transaction.Account\x1a\x14.transaction.Account\x12I\n" +
+	"\n" +
+	"GetAccount\x12\x1e.transaction.GetAccountRequest\x1a\x1b.transaction.AccountProfile\x12S\n" +
+	"\fListAccounts\x12 .transaction.ListAccountsRequest\x1a!.transaction.ListAccountsResponse\x12Z\n" +
+	"\x12GetAccountActivity\x12&.transaction.GetAccountActivityRequest\x1a\x1c.transaction.AccountActivityB'Z%bank-aml-system/api/proto;transactionb\x06proto3"
+
+var (
+	file_api_proto_account_proto_rawDescOnce sync.Once
+	file_api_proto_account_proto_rawDescData []byte
+)
+
+func file_api_proto_account_proto_rawDescGZIP() []byte {
+	file_api_proto_account_proto_rawDescOnce.Do(func() {
+		file_api_proto_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_account_proto_rawDesc), len(file_api_proto_account_proto_rawDesc)))
+	})
+	return file_api_proto_account_proto_rawDescData
+}
+
+var file_api_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_proto_account_proto_goTypes = []any{
+	(*Customer)(nil),                  // 0: transaction.Customer
+	(*Account)(nil),                   // 1: transaction.Account
+	(*AccountProfile)(nil),            // 2: transaction.AccountProfile
+	(*GetCustomerRequest)(nil),        // 3: transaction.GetCustomerRequest
+	(*GetAccountRequest)(nil),         // 4: transaction.GetAccountRequest
+	(*ListAccountsRequest)(nil),       // 5: transaction.ListAccountsRequest
+	(*ListAccountsResponse)(nil),      // 6: transaction.ListAccountsResponse
+	(*GetAccountActivityRequest)(nil), // 7: transaction.GetAccountActivityRequest
+	(*AccountActivity)(nil),           // 8: transaction.AccountActivity
+	(*AccountTransaction)(nil),        // 9: transaction.AccountTransaction
+	(*AccountActivityTotals)(nil),     // 10: transaction.AccountActivityTotals
+	(*ActivityTotal)(nil),             // 11: transaction.ActivityTotal
+	(*AccountVelocity)(nil),           // 12: transaction.AccountVelocity
+	(*AccountListEntry)(nil),          // 13: transaction.AccountListEntry
+}
+var file_api_proto_account_proto_depIdxs = []int32{
+	1,  // 0: transaction.AccountProfile.account:type_name -> transaction.Account
+	0,  // 1: transaction.AccountProfile.customer:type_name -> transaction.Customer
+	1,  // 2: transaction.ListAccountsResponse.accounts:type_name -> transaction.Account
+	9,  // 3: transaction.AccountActivity.sent:type_name -> transaction.AccountTransaction
+	9,  // 4: transaction.AccountActivity.received:type_name -> transaction.AccountTransaction
+	10, // 5: transaction.AccountActivity.totals:type_name -> transaction.AccountActivityTotals
+	12, // 6: transaction.AccountActivity.velocity:type_name -> transaction.AccountVelocity
+	13, // 7: transaction.AccountActivity.list_entries:type_name -> transaction.AccountListEntry
+	11, // 8: transaction.AccountActivityTotals.by_currency:type_name -> transaction.ActivityTotal
+	11, // 9: transaction.AccountActivityTotals.by_country:type_name -> transaction.ActivityTotal
+	11, // 10: transaction.AccountActivityTotals.by_channel:type_name -> transaction.ActivityTotal
+	11, // 11: transaction.AccountActivityTotals.by_period:type_name -> transaction.ActivityTotal
+	0,  // 12: transaction.AccountService.SaveCustomer:input_type -> transaction.Customer
+	3,  // 13: transaction.AccountService.GetCustomer:input_type -> transaction.GetCustomerRequest
+	1,  // 14: transaction.AccountService.SaveAccount:input_type -> transaction.Account
+	4,  // 15: transaction.AccountService.GetAccount:input_type -> transaction.GetAccountRequest
+	5,  // 16: transaction.AccountService.ListAccounts:input_type -> transaction.ListAccountsRequest
+	7,  // 17: transaction.AccountService.GetAccountActivity:input_type -> transaction.GetAccountActivityRequest
+	0,  // 18: transaction.AccountService.SaveCustomer:output_type -> transaction.Customer
+	0,  // 19: transaction.AccountService.GetCustomer:output_type -> transaction.Customer
+	1,  // 20: transaction.AccountService.SaveAccount:output_type -> transaction.Account
+	2,  // 21: transaction.AccountService.GetAccount:output_type -> transaction.AccountProfile
+	6,  // 22: transaction.AccountService.ListAccounts:output_type -> transaction.ListAccountsResponse
+	8,  // 23: transaction.AccountService.GetAccountActivity:output_type -> transaction.AccountActivity
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_account_proto_init() }
+func file_api_proto_account_proto_init() {
+	if File_api_proto_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_account_proto_rawDesc), len(file_api_proto_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_account_proto_goTypes,
+		DependencyIndexes: file_api_proto_account_proto_depIdxs,
+		MessageInfos:      file_api_proto_account_proto_msgTypes,
+	}.Build()
+	File_api_proto_account_proto = out.File
+	file_api_proto_account_proto_goTypes = nil
+	file_api_proto_account_proto_depIdxs = nil
+}
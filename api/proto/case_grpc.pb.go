@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v4.25.1
+// source: api/proto/case.proto
+
+package transaction
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CaseService_ListAlerts_FullMethodName        = "/transaction.CaseService/ListAlerts"
+	CaseService_ListCases_FullMethodName         = "/transaction.CaseService/ListCases"
+	CaseService_GetCase_FullMethodName           = "/transaction.CaseService/GetCase"
+	CaseService_UpdateCase_FullMethodName        = "/transaction.CaseService/UpdateCase"
+	CaseService_AddCaseNote_FullMethodName       = "/transaction.CaseService/AddCaseNote"
+	CaseService_AddCaseAttachment_FullMethodName = "/transaction.CaseService/AddCaseAttachment"
+)
+
+// CaseServiceClient is the client API for CaseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Case Service для работы аналитиков с алертами и кейсами через gRPC
+// Автор изменения (actor, author, added_by) - пользователь из метаданных; другое значение в запросе отклоняется
+type CaseServiceClient interface {
+	// Список алертов
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	// Список кейсов
+	ListCases(ctx context.Context, in *ListCasesRequest, opts ...grpc.CallOption) (*ListCasesResponse, error)
+	// Кейс вместе с алертами, заметками и вложениями
+	GetCase(ctx context.Context, in *GetCaseRequest, opts ...grpc.CallOption) (*CaseDetails, error)
+	// Изменение статуса, исполнителя или решения по кейсу
+	UpdateCase(ctx context.Context, in *UpdateCaseRequest, opts ...grpc.CallOption) (*Case, error)
+	// Добавление заметки к кейсу
+	AddCaseNote(ctx context.Context, in *CaseNote, opts ...grpc.CallOption) (*CaseNote, error)
+	// Добавление ссылки на документ к кейсу
+	AddCaseAttachment(ctx context.Context, in *CaseAttachment, opts ...grpc.CallOption) (*CaseAttachment, error)
+}
+
+type caseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseServiceClient(cc grpc.ClientConnInterface) CaseServiceClient {
+	return &caseServiceClient{cc}
+}
+
+func (c *caseServiceClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, CaseService_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) ListCases(ctx context.Context, in *ListCasesRequest, opts ...grpc.CallOption) (*ListCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCasesResponse)
+	err := c.cc.Invoke(ctx, CaseService_ListCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) GetCase(ctx context.Context, in *GetCaseRequest, opts ...grpc.CallOption) (*CaseDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseDetails)
+	err := c.cc.Invoke(ctx, CaseService_GetCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) UpdateCase(ctx context.Context, in *UpdateCaseRequest, opts ...grpc.CallOption) (*Case, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Case)
+	err := c.cc.Invoke(ctx, CaseService_UpdateCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) AddCaseNote(ctx context.Context, in *CaseNote, opts ...grpc.CallOption) (*CaseNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseNote)
+	err := c.cc.Invoke(ctx, CaseService_AddCaseNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) AddCaseAttachment(ctx context.Context, in *CaseAttachment, opts ...grpc.CallOption) (*CaseAttachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseAttachment)
+	err := c.cc.Invoke(ctx, CaseService_AddCaseAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseServiceServer is the server API for CaseService service.
+// All implementations must embed UnimplementedCaseServiceServer
+// for forward compatibility.
+//
+// Case Service для работы аналитиков с алертами и кейсами через gRPC
+// Автор изменения (actor, author, added_by) - пользователь из метаданных; другое значение в запросе отклоняется
+type CaseServiceServer interface {
+	// Список алертов
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	// Список кейсов
+	ListCases(context.Context, *ListCasesRequest) (*ListCasesResponse, error)
+	// Кейс вместе с алертами, заметками и вложениями
+	GetCase(context.Context, *GetCaseRequest) (*CaseDetails, error)
+	// Изменение статуса, исполнителя или решения по кейсу
+	UpdateCase(context.Context, *UpdateCaseRequest) (*Case, error)
+	// Добавление заметки к кейсу
+	AddCaseNote(context.Context, *CaseNote) (*CaseNote, error)
+	// Добавление ссылки на документ к кейсу
+	AddCaseAttachment(context.Context, *CaseAttachment) (*CaseAttachment, error)
+	mustEmbedUnimplementedCaseServiceServer()
+}
+
+// UnimplementedCaseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseServiceServer struct{}
+
+func (UnimplementedCaseServiceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedCaseServiceServer) ListCases(context.Context, *ListCasesRequest) (*ListCasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCases not implemented")
+}
+func (UnimplementedCaseServiceServer) GetCase(context.Context, *GetCaseRequest) (*CaseDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCase not implemented")
+}
+func (UnimplementedCaseServiceServer) UpdateCase(context.Context, *UpdateCaseRequest) (*Case, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCase not implemented")
+}
+func (UnimplementedCaseServiceServer) AddCaseNote(context.Context, *CaseNote) (*CaseNote, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCaseNote not implemented")
+}
+func (UnimplementedCaseServiceServer) AddCaseAttachment(context.Context, *CaseAttachment) (*CaseAttachment, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCaseAttachment not implemented")
+}
+func (UnimplementedCaseServiceServer) mustEmbedUnimplementedCaseServiceServer() {}
+func (UnimplementedCaseServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCaseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseServiceServer will
+// result in compilation errors.
+type UnsafeCaseServiceServer interface {
+	mustEmbedUnimplementedCaseServiceServer()
+}
+
+func RegisterCaseServiceServer(s grpc.ServiceRegistrar, srv CaseServiceServer) {
+	// If the following call panics, it indicates UnimplementedCaseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseService_ServiceDesc, srv)
+}
+
+func _CaseService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_ListAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_ListCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).ListCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_ListCases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).ListCases(ctx, req.(*ListCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_GetCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).GetCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_GetCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).GetCase(ctx, req.(*GetCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_UpdateCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).UpdateCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_UpdateCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).UpdateCase(ctx, req.(*UpdateCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_AddCaseNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaseNote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).AddCaseNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_AddCaseNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).AddCaseNote(ctx, req.(*CaseNote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_AddCaseAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaseAttachment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).AddCaseAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseService_AddCaseAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).AddCaseAttachment(ctx, req.(*CaseAttachment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseService_ServiceDesc is the grpc.ServiceDesc for CaseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "transaction.CaseService",
+	HandlerType: (*CaseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAlerts",
+			Handler:    _CaseService_ListAlerts_Handler,
+		},
+		{
+			MethodName: "ListCases",
+			Handler:    _CaseService_ListCases_Handler,
+		},
+		{
+			MethodName: "GetCase",
+			Handler:    _CaseService_GetCase_Handler,
+		},
+		{
+			MethodName: "UpdateCase",
+			Handler:    _CaseService_UpdateCase_Handler,
+		},
+		{
+			MethodName: "AddCaseNote",
+			Handler:    _CaseService_AddCaseNote_Handler,
+		},
+		{
+			MethodName: "AddCaseAttachment",
+			Handler:    _CaseService_AddCaseAttachment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/case.proto",
+}
@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: api/proto/decision.proto
+
+package transaction
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Текущее решение по транзакции
+type TransactionDecision struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingId    string                 `protobuf:"bytes,1,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	Decision        string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"` // pending, approved, held, released, blocked, reported
+	Actor           string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PendingApproval *DecisionApproval      `protobuf:"bytes,6,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransactionDecision) Reset() {
+	*x = TransactionDecision{}
+	mi := &file_api_proto_decision_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDecision) ProtoMessage() {}
+
+func (x *TransactionDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDecision.ProtoReflect.Descriptor instead.
+func (*TransactionDecision) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransactionDecision) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *TransactionDecision) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *TransactionDecision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TransactionDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransactionDecision) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TransactionDecision) GetPendingApproval() *DecisionApproval {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return nil
+}
+
+// Запрос на изменение решения, ожидающий подтверждения вторым сотрудником
+type DecisionApproval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProcessingId  string                 `protobuf:"bytes,2,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	FromDecision  string                 `protobuf:"bytes,3,opt,name=from_decision,json=fromDecision,proto3" json:"from_decision,omitempty"`
+	ToDecision    string                 `protobuf:"bytes,4,opt,name=to_decision,json=toDecision,proto3" json:"to_decision,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, approved, rejected, expired
+	ResolvedBy    string                 `protobuf:"bytes,8,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	Comment       string                 `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    string                 `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecisionApproval) Reset() {
+	*x = DecisionApproval{}
+	mi := &file_api_proto_decision_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionApproval) ProtoMessage() {}
+
+func (x *DecisionApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionApproval.ProtoReflect.Descriptor instead.
+func (*DecisionApproval) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecisionApproval) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecisionApproval) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetFromDecision() string {
+	if x != nil {
+		return x.FromDecision
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetToDecision() string {
+	if x != nil {
+		return x.ToDecision
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DecisionApproval) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+// Запись истории решений
+type DecisionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProcessingId  string                 `protobuf:"bytes,2,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	FromDecision  string                 `protobuf:"bytes,3,opt,name=from_decision,json=fromDecision,proto3" json:"from_decision,omitempty"`
+	ToDecision    string                 `protobuf:"bytes,4,opt,name=to_decision,json=toDecision,proto3" json:"to_decision,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,8,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecisionChange) Reset() {
+	*x = DecisionChange{}
+	mi := &file_api_proto_decision_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionChange) ProtoMessage() {}
+
+func (x *DecisionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionChange.ProtoReflect.Descriptor instead.
+func (*DecisionChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DecisionChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecisionChange) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetFromDecision() string {
+	if x != nil {
+		return x.FromDecision
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetToDecision() string {
+	if x != nil {
+		return x.ToDecision
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DecisionChange) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+type GetTransactionDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingId  string                 `protobuf:"bytes,1,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionDecisionRequest) Reset() {
+	*x = GetTransactionDecisionRequest{}
+	mi := &file_api_proto_decision_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionDecisionRequest) ProtoMessage() {}
+
+func (x *GetTransactionDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionDecisionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTransactionDecisionRequest) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+type TransactionDecisionDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decision      *TransactionDecision   `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"`
+	History       []*DecisionChange      `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionDecisionDetails) Reset() {
+	*x = TransactionDecisionDetails{}
+	mi := &file_api_proto_decision_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionDecisionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionDecisionDetails) ProtoMessage() {}
+
+func (x *TransactionDecisionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionDecisionDetails.ProtoReflect.Descriptor instead.
+func (*TransactionDecisionDetails) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransactionDecisionDetails) GetDecision() *TransactionDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+func (x *TransactionDecisionDetails) GetHistory() []*DecisionChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type UpdateTransactionDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingId  string                 `protobuf:"bytes,1,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	Decision      string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionDecisionRequest) Reset() {
+	*x = UpdateTransactionDecisionRequest{}
+	mi := &file_api_proto_decision_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionDecisionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionDecisionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTransactionDecisionRequest) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionDecisionRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *UpdateTransactionDecisionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UpdateTransactionDecisionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListDecisionApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProcessingId  string                 `protobuf:"bytes,2,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDecisionApprovalsRequest) Reset() {
+	*x = ListDecisionApprovalsRequest{}
+	mi := &file_api_proto_decision_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDecisionApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecisionApprovalsRequest) ProtoMessage() {}
+
+func (x *ListDecisionApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecisionApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListDecisionApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDecisionApprovalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDecisionApprovalsRequest) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *ListDecisionApprovalsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDecisionApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*DecisionApproval    `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDecisionApprovalsResponse) Reset() {
+	*x = ListDecisionApprovalsResponse{}
+	mi := &file_api_proto_decision_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDecisionApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDecisionApprovalsResponse) ProtoMessage() {}
+
+func (x *ListDecisionApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDecisionApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListDecisionApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDecisionApprovalsResponse) GetApprovals() []*DecisionApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ApproveDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    int64                  `protobuf:"varint,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDecisionRequest) Reset() {
+	*x = ApproveDecisionRequest{}
+	mi := &file_api_proto_decision_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDecisionRequest) ProtoMessage() {}
+
+func (x *ApproveDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDecisionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApproveDecisionRequest) GetApprovalId() int64 {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return 0
+}
+
+type RejectDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    int64                  `protobuf:"varint,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectDecisionRequest) Reset() {
+	*x = RejectDecisionRequest{}
+	mi := &file_api_proto_decision_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDecisionRequest) ProtoMessage() {}
+
+func (x *RejectDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_decision_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDecisionRequest.ProtoReflect.Descriptor instead.
+func (*RejectDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_decision_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectDecisionRequest) GetApprovalId() int64 {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return 0
+}
+
+func (x *RejectDecisionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+var File_api_proto_decision_proto protoreflect.FileDescriptor
+
+const file_api_proto_decision_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/proto/decision.proto\x12\vtransaction\"\xed\x01\n" +
+	"\x13TransactionDecision\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12\x1a\n" +
+	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12H\n" +
+	"\x10pending_approval\x18\x06 \x01(\v2\x1d.transaction.DecisionApprovalR\x0fpendingApproval\"\xfa\x02\n" +
+	"\x10DecisionApproval\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rprocessing_id\x18\x02 \x01(\tR\fprocessingId\x12#\n" +
+	"\rfrom_decision\x18\x03 \x01(\tR\ffromDecision\x12\x1f\n" +
+	"\vto_decision\x18\x04 \x01(\tR\n" +
+	"toDecision\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\b \x01(\tR\n" +
+	"resolvedBy\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\f \x01(\tR\n" +
+	"resolvedAt\"\xf9\x01\n" +
+	"\x0eDecisionChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rprocessing_id\x18\x02 \x01(\tR\fprocessingId\x12#\n" +
+	"\rfrom_decision\x18\x03 \x01(\tR\ffromDecision\x12\x1f\n" +
+	"\vto_decision\x18\x04 \x01(\tR\n" +
+	"toDecision\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vapproved_by\x18\b \x01(\tR\n" +
+	"approvedBy\"D\n" +
+	"\x1dGetTransactionDecisionRequest\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\"\x91\x01\n" +
+	"\x1aTransactionDecisionDetails\x12<\n" +
+	"\bdecision\x18\x01 \x01(\v2 .transaction.TransactionDecisionR\bdecision\x125\n" +
+	"\ahistory\x18\x02 \x03(\v2\x1b.transaction.DecisionChangeR\ahistory\"\x91\x01\n" +
+	" UpdateTransactionDecisionRequest\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12\x1a\n" +
+	"\bdecision\x18\x02 \x01(\tR\bdecision\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"q\n" +
+	"\x1cListDecisionApprovalsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12#\n" +
+	"\rprocessing_id\x18\x02 \x01(\tR\fprocessingId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\\\n" +
+	"\x1dListDecisionApprovalsResponse\x12;\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x1d.transaction.DecisionApprovalR\tapprovals\"9\n" +
+	"\x16ApproveDecisionRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\x03R\n" +
+	"approvalId\"R\n" +
+	"\x15RejectDecisionRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\x03R\n" +
+	"approvalId\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment2\x8d\x04\n" +
+	"\x0fDecisionService\x12m\n" +
+	"\x16GetTransactionDecision\x12*.transaction.GetTransactionDecisionRequest\x1a'.transaction.TransactionDecisionDetails\x12l\n" +
+	"\x19UpdateTransactionDecision\x12-.transaction.UpdateTransactionDecisionRequest\x1a .transaction.TransactionDecision\x12n\n" +
+	"\x15ListDecisionApprovals\x12).transaction.ListDecisionApprovalsRequest\x1a*.transaction.ListDecisionApprovalsResponse\x12X\n" +
+	"\x0fApproveDecision\x12#.transaction.ApproveDecisionRequest\x1a .transaction.TransactionDecision\x12S\n" +
+	"\x0eRejectDecision\x12\".transaction.RejectDecisionRequest\x1a\x1d.transaction.DecisionApprovalB'Z%bank-aml-system/api/proto;transactionb\x06proto3"
+
+var (
+	file_api_proto_decision_proto_rawDescOnce sync.Once
+	file_api_proto_decision_proto_rawDescData []byte
+)
+
+func file_api_proto_decision_proto_rawDescGZIP() []byte {
+	file_api_proto_decision_proto_rawDescOnce.Do(func() {
+		file_api_proto_decision_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_decision_proto_rawDesc), len(file_api_proto_decision_proto_rawDesc)))
+	})
+	return file_api_proto_decision_proto_rawDescData
+}
+
+var file_api_proto_decision_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_decision_proto_goTypes = []any{
+	(*TransactionDecision)(nil),              // 0: transaction.TransactionDecision
+	(*DecisionApproval)(nil),                 // 1: transaction.DecisionApproval
+	(*DecisionChange)(nil),                   // 2: transaction.DecisionChange
+	(*GetTransactionDecisionRequest)(nil),    // 3: transaction.GetTransactionDecisionRequest
+	(*TransactionDecisionDetails)(nil),       // 4: transaction.TransactionDecisionDetails
+	(*UpdateTransactionDecisionRequest)(nil), // 5: transaction.UpdateTransactionDecisionRequest
+	(*ListDecisionApprovalsRequest)(nil),     // 6: transaction.ListDecisionApprovalsRequest
+	(*ListDecisionApprovalsResponse)(nil),    // 7: transaction.ListDecisionApprovalsResponse
+	(*ApproveDecisionRequest)(nil),           // 8: transaction.ApproveDecisionRequest
+	(*RejectDecisionRequest)(nil),            // 9: transaction.RejectDecisionRequest
+}
+var file_api_proto_decision_proto_depIdxs = []int32{
+	1, // 0: transaction.TransactionDecision.pending_approval:type_name -> transaction.DecisionApproval
+	0, // 1: transaction.TransactionDecisionDetails.decision:type_name -> transaction.TransactionDecision
+	2, // 2: transaction.TransactionDecisionDetails.history:type_name -> transaction.DecisionChange
+	1, // 3: transaction.ListDecisionApprovalsResponse.approvals:type_name -> transaction.DecisionApproval
+	3, // 4: transaction.DecisionService.GetTransactionDecision:input_type -> transaction.GetTransactionDecisionRequest
+	5, // 5: transaction.DecisionService.UpdateTransactionDecision:input_type -> transaction.UpdateTransactionDecisionRequest
+	6, // 6: transaction.DecisionService.ListDecisionApprovals:input_type -> transaction.ListDecisionApprovalsRequest
+	8, // 7: transaction.DecisionService.ApproveDecision:input_type -> transaction.ApproveDecisionRequest
+	9, // 8: transaction.DecisionService.RejectDecision:input_type -> transaction.RejectDecisionRequest
+	4, // 9: transaction.DecisionService.GetTransactionDecision:output_type -> transaction.TransactionDecisionDetails
+	0, // 10: transaction.DecisionService.UpdateTransactionDecision:output_type -> transaction.TransactionDecision
+	7, // 11: transaction.DecisionService.ListDecisionApprovals:output_type -> transaction.ListDecisionApprovalsResponse
+	0, // 12: transaction.DecisionService.ApproveDecision:output_type -> transaction.TransactionDecision
+	1, // 13: transaction.DecisionService.RejectDecision:output_type -> transaction.DecisionApproval
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_decision_proto_init() }
+func file_api_proto_decision_proto_init() {
+	if File_api_proto_decision_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_decision_proto_rawDesc), len(file_api_proto_decision_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_decision_proto_goTypes,
+		DependencyIndexes: file_api_proto_decision_proto_depIdxs,
+		MessageInfos:      file_api_proto_decision_proto_msgTypes,
+	}.Build()
+	File_api_proto_decision_proto = out.File
+	file_api_proto_decision_proto_goTypes = nil
+	file_api_proto_decision_proto_depIdxs = nil
+}
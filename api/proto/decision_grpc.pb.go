@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v4.25.1
+// source: api/proto/decision.proto
+
+package transaction
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DecisionService_GetTransactionDecision_FullMethodName    = "/transaction.DecisionService/GetTransactionDecision"
+	DecisionService_UpdateTransactionDecision_FullMethodName = "/transaction.DecisionService/UpdateTransactionDecision"
+	DecisionService_ListDecisionApprovals_FullMethodName     = "/transaction.DecisionService/ListDecisionApprovals"
+	DecisionService_ApproveDecision_FullMethodName           = "/transaction.DecisionService/ApproveDecision"
+	DecisionService_RejectDecision_FullMethodName            = "/transaction.DecisionService/RejectDecision"
+)
+
+// DecisionServiceClient is the client API for DecisionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Decision Service для получения и изменения решений по транзакциям (core banking)
+type DecisionServiceClient interface {
+	// Текущее решение по транзакции вместе с историей
+	GetTransactionDecision(ctx context.Context, in *GetTransactionDecisionRequest, opts ...grpc.CallOption) (*TransactionDecisionDetails, error)
+	// Изменение решения с проверкой допустимости перехода
+	// Блокировка и разблокировка возвращают текущее решение с pending_approval
+	// Инициатор - пользователь из метаданных; actor, не совпадающий с ним, отклоняется
+	UpdateTransactionDecision(ctx context.Context, in *UpdateTransactionDecisionRequest, opts ...grpc.CallOption) (*TransactionDecision, error)
+	// Запросы на подтверждение решений вторым сотрудником
+	ListDecisionApprovals(ctx context.Context, in *ListDecisionApprovalsRequest, opts ...grpc.CallOption) (*ListDecisionApprovalsResponse, error)
+	// Подтверждение запроса; пользователь передается в метаданных x-user-id и x-user-roles
+	ApproveDecision(ctx context.Context, in *ApproveDecisionRequest, opts ...grpc.CallOption) (*TransactionDecision, error)
+	// Отклонение запроса инициатором или сотрудником с ролью approver или supervisor
+	RejectDecision(ctx context.Context, in *RejectDecisionRequest, opts ...grpc.CallOption) (*DecisionApproval, error)
+}
+
+type decisionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDecisionServiceClient(cc grpc.ClientConnInterface) DecisionServiceClient {
+	return &decisionServiceClient{cc}
+}
+
+func (c *decisionServiceClient) GetTransactionDecision(ctx context.Context, in *GetTransactionDecisionRequest, opts ...grpc.CallOption) (*TransactionDecisionDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionDecisionDetails)
+	err := c.cc.Invoke(ctx, DecisionService_GetTransactionDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) UpdateTransactionDecision(ctx context.Context, in *UpdateTransactionDecisionRequest, opts ...grpc.CallOption) (*TransactionDecision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionDecision)
+	err := c.cc.Invoke(ctx, DecisionService_UpdateTransactionDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) ListDecisionApprovals(ctx context.Context, in *ListDecisionApprovalsRequest, opts ...grpc.CallOption) (*ListDecisionApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDecisionApprovalsResponse)
+	err := c.cc.Invoke(ctx, DecisionService_ListDecisionApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) ApproveDecision(ctx context.Context, in *ApproveDecisionRequest, opts ...grpc.CallOption) (*TransactionDecision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionDecision)
+	err := c.cc.Invoke(ctx, DecisionService_ApproveDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) RejectDecision(ctx context.Context, in *RejectDecisionRequest, opts ...grpc.CallOption) (*DecisionApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecisionApproval)
+	err := c.cc.Invoke(ctx, DecisionService_RejectDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DecisionServiceServer is the server API for DecisionService service.
+// All implementations must embed UnimplementedDecisionServiceServer
+// for forward compatibility.
+//
+// Decision Service для получения и изменения решений по транзакциям (core banking)
+type DecisionServiceServer interface {
+	// Текущее решение по транзакции вместе с историей
+	GetTransactionDecision(context.Context, *GetTransactionDecisionRequest) (*TransactionDecisionDetails, error)
+	// Изменение решения с проверкой допустимости перехода
+	// Блокировка и разблокировка возвращают текущее решение с pending_approval
+	// Инициатор - пользователь из метаданных; actor, не совпадающий с ним, отклоняется
+	UpdateTransactionDecision(context.Context, *UpdateTransactionDecisionRequest) (*TransactionDecision, error)
+	// Запросы на подтверждение решений вторым сотрудником
+	ListDecisionApprovals(context.Context, *ListDecisionApprovalsRequest) (*ListDecisionApprovalsResponse, error)
+	// Подтверждение запроса; пользователь передается в метаданных x-user-id и x-user-roles
+	ApproveDecision(context.Context, *ApproveDecisionRequest) (*TransactionDecision, error)
+	// Отклонение запроса инициатором или сотрудником с ролью approver или supervisor
+	RejectDecision(context.Context, *RejectDecisionRequest) (*DecisionApproval, error)
+	mustEmbedUnimplementedDecisionServiceServer()
+}
+
+// UnimplementedDecisionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDecisionServiceServer struct{}
+
+func (UnimplementedDecisionServiceServer) GetTransactionDecision(context.Context, *GetTransactionDecisionRequest) (*TransactionDecisionDetails, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionDecision not implemented")
+}
+func (UnimplementedDecisionServiceServer) UpdateTransactionDecision(context.Context, *UpdateTransactionDecisionRequest) (*TransactionDecision, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTransactionDecision not implemented")
+}
+func (UnimplementedDecisionServiceServer) ListDecisionApprovals(context.Context, *ListDecisionApprovalsRequest) (*ListDecisionApprovalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDecisionApprovals not implemented")
+}
+func (UnimplementedDecisionServiceServer) ApproveDecision(context.Context, *ApproveDecisionRequest) (*TransactionDecision, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveDecision not implemented")
+}
+func (UnimplementedDecisionServiceServer) RejectDecision(context.Context, *RejectDecisionRequest) (*DecisionApproval, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectDecision not implemented")
+}
+func (UnimplementedDecisionServiceServer) mustEmbedUnimplementedDecisionServiceServer() {}
+func (UnimplementedDecisionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeDecisionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DecisionServiceServer will
+// result in compilation errors.
+type UnsafeDecisionServiceServer interface {
+	mustEmbedUnimplementedDecisionServiceServer()
+}
+
+func RegisterDecisionServiceServer(s grpc.ServiceRegistrar, srv DecisionServiceServer) {
+	// If the following call panics, it indicates UnimplementedDecisionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DecisionService_ServiceDesc, srv)
+}
+
+func _DecisionService_GetTransactionDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).GetTransactionDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_GetTransactionDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).GetTransactionDecision(ctx, req.(*GetTransactionDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_UpdateTransactionDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).UpdateTransactionDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_UpdateTransactionDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).UpdateTransactionDecision(ctx, req.(*UpdateTransactionDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_ListDecisionApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDecisionApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).ListDecisionApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_ListDecisionApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).ListDecisionApprovals(ctx, req.(*ListDecisionApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_ApproveDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).ApproveDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_ApproveDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).ApproveDecision(ctx, req.(*ApproveDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_RejectDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).RejectDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_RejectDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).RejectDecision(ctx, req.(*RejectDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DecisionService_ServiceDesc is the grpc.ServiceDesc for DecisionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DecisionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "transaction.DecisionService",
+	HandlerType: (*DecisionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionDecision",
+			Handler:    _DecisionService_GetTransactionDecision_Handler,
+		},
+		{
+			MethodName: "UpdateTransactionDecision",
+			Handler:    _DecisionService_UpdateTransactionDecision_Handler,
+		},
+		{
+			MethodName: "ListDecisionApprovals",
+			Handler:    _DecisionService_ListDecisionApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveDecision",
+			Handler:    _DecisionService_ApproveDecision_Handler,
+		},
+		{
+			MethodName: "RejectDecision",
+			Handler:    _DecisionService_RejectDecision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/decision.proto",
+}
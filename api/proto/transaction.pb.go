@@ -36,6 +36,7 @@ type AnalyzeTransactionRequest struct {
 	UserId              string                 `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	BranchId            string                 `protobuf:"bytes,11,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
 	Timestamp           string                 `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CounterpartyBic     string                 `protobuf:"bytes,13,opt,name=counterparty_bic,json=counterpartyBic,proto3" json:"counterparty_bic,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -154,6 +155,13 @@ func (x *AnalyzeTransactionRequest) GetTimestamp() string {
 	return ""
 }
 
+func (x *AnalyzeTransactionRequest) GetCounterpartyBic() string {
+	if x != nil {
+		return x.CounterpartyBic
+	}
+	return ""
+}
+
 // Ответ на анализ транзакции
 type AnalyzeTransactionResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -164,6 +172,7 @@ type AnalyzeTransactionResponse struct {
 	Recommendation string                 `protobuf:"bytes,5,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
 	AnalyzedAt     string                 `protobuf:"bytes,6,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
 	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Evidence       map[string]*Evidence   `protobuf:"bytes,8,rep,name=evidence,proto3" json:"evidence,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -247,6 +256,172 @@ func (x *AnalyzeTransactionResponse) GetStatus() string {
 	return ""
 }
 
+func (x *AnalyzeTransactionResponse) GetEvidence() map[string]*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// Ответ потокового анализа на один запрос
+type AnalyzeTransactionStreamResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	TransactionId string                      `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Result        *AnalyzeTransactionResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // Нет, если транзакция не обработана
+	Error         *StreamError                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`   // Причина, по которой транзакция не обработана
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeTransactionStreamResponse) Reset() {
+	*x = AnalyzeTransactionStreamResponse{}
+	mi := &file_api_proto_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeTransactionStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeTransactionStreamResponse) ProtoMessage() {}
+
+func (x *AnalyzeTransactionStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeTransactionStreamResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeTransactionStreamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnalyzeTransactionStreamResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AnalyzeTransactionStreamResponse) GetResult() *AnalyzeTransactionResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AnalyzeTransactionStreamResponse) GetError() *StreamError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Ошибка обработки одного сообщения потока
+type StreamError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // Код статуса gRPC (google.golang.org/grpc/codes)
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamError) Reset() {
+	*x = StreamError{}
+	mi := &file_api_proto_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamError) ProtoMessage() {}
+
+func (x *StreamError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamError.ProtoReflect.Descriptor instead.
+func (*StreamError) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StreamError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Факты, на которых основан флаг (например, контрагенты fan_in / fan_out)
+type Evidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Evidence) Reset() {
+	*x = Evidence{}
+	mi := &file_api_proto_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evidence) ProtoMessage() {}
+
+func (x *Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Evidence) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // Запрос на получение статуса транзакции
 type GetTransactionStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -257,7 +432,7 @@ type GetTransactionStatusRequest struct {
 
 func (x *GetTransactionStatusRequest) Reset() {
 	*x = GetTransactionStatusRequest{}
-	mi := &file_api_proto_transaction_proto_msgTypes[2]
+	mi := &file_api_proto_transaction_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +444,7 @@ func (x *GetTransactionStatusRequest) String() string {
 func (*GetTransactionStatusRequest) ProtoMessage() {}
 
 func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_transaction_proto_msgTypes[2]
+	mi := &file_api_proto_transaction_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +457,7 @@ func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_transaction_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTransactionStatusRequest) GetProcessingId() string {
@@ -302,13 +477,14 @@ type GetTransactionStatusResponse struct {
 	RiskLevel         string                 `protobuf:"bytes,5,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
 	Flags             []string               `protobuf:"bytes,6,rep,name=flags,proto3" json:"flags,omitempty"`
 	AnalysisTimestamp string                 `protobuf:"bytes,7,opt,name=analysis_timestamp,json=analysisTimestamp,proto3" json:"analysis_timestamp,omitempty"`
+	Evidence          map[string]*Evidence   `protobuf:"bytes,8,rep,name=evidence,proto3" json:"evidence,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetTransactionStatusResponse) Reset() {
 	*x = GetTransactionStatusResponse{}
-	mi := &file_api_proto_transaction_proto_msgTypes[3]
+	mi := &file_api_proto_transaction_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +496,7 @@ func (x *GetTransactionStatusResponse) String() string {
 func (*GetTransactionStatusResponse) ProtoMessage() {}
 
 func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_transaction_proto_msgTypes[3]
+	mi := &file_api_proto_transaction_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +509,7 @@ func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_transaction_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTransactionStatusResponse) GetProcessingId() string {
@@ -385,6 +561,13 @@ func (x *GetTransactionStatusResponse) GetAnalysisTimestamp() string {
 	return ""
 }
 
+func (x *GetTransactionStatusResponse) GetEvidence() map[string]*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
 // Запрос на генерацию случайной транзакции
 type GenerateRandomTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -394,7 +577,7 @@ type GenerateRandomTransactionRequest struct {
 
 func (x *GenerateRandomTransactionRequest) Reset() {
 	*x = GenerateRandomTransactionRequest{}
-	mi := &file_api_proto_transaction_proto_msgTypes[4]
+	mi := &file_api_proto_transaction_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +589,7 @@ func (x *GenerateRandomTransactionRequest) String() string {
 func (*GenerateRandomTransactionRequest) ProtoMessage() {}
 
 func (x *GenerateRandomTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_transaction_proto_msgTypes[4]
+	mi := &file_api_proto_transaction_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +602,7 @@ func (x *GenerateRandomTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateRandomTransactionRequest.ProtoReflect.Descriptor instead.
 func (*GenerateRandomTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_transaction_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{7}
 }
 
 // Ответ с сгенерированной транзакцией
@@ -442,7 +625,7 @@ type GenerateRandomTransactionResponse struct {
 
 func (x *GenerateRandomTransactionResponse) Reset() {
 	*x = GenerateRandomTransactionResponse{}
-	mi := &file_api_proto_transaction_proto_msgTypes[5]
+	mi := &file_api_proto_transaction_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +637,7 @@ func (x *GenerateRandomTransactionResponse) String() string {
 func (*GenerateRandomTransactionResponse) ProtoMessage() {}
 
 func (x *GenerateRandomTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_transaction_proto_msgTypes[5]
+	mi := &file_api_proto_transaction_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +650,7 @@ func (x *GenerateRandomTransactionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GenerateRandomTransactionResponse.ProtoReflect.Descriptor instead.
 func (*GenerateRandomTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_transaction_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GenerateRandomTransactionResponse) GetTransactionId() string {
@@ -547,66 +730,785 @@ func (x *GenerateRandomTransactionResponse) GetBranchId() string {
 	return ""
 }
 
-var File_api_proto_transaction_proto protoreflect.FileDescriptor
+// Запрос на поиск транзакций; пустые поля не ограничивают выборку
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Counterparty  string                 `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"` // Счет контрагента
+	RiskLevel     string                 `protobuf:"bytes,3,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	MinScore      *int32                 `protobuf:"varint,4,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`
+	MaxScore      *int32                 `protobuf:"varint,5,opt,name=max_score,json=maxScore,proto3,oneof" json:"max_score,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Country       string                 `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"` // Страна контрагента
+	Channel       string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	From          string                 `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`                   // Время операции, RFC3339, включительно
+	To            string                 `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`                       // Время операции, RFC3339, не включительно
+	SortBy        string                 `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // created_at, timestamp, amount, risk_score
+	Order         string                 `protobuf:"bytes,13,opt,name=order,proto3" json:"order,omitempty"`                 // asc, desc
+	Cursor        string                 `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`               // next_cursor предыдущей страницы
+	Limit         int32                  `protobuf:"varint,15,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_proto_transaction_proto_rawDesc = "" +
-	"\n" +
-	"\x1bapi/proto/transaction.proto\x12\vtransaction\"\xc9\x03\n" +
-	"\x19AnalyzeTransactionRequest\x12%\n" +
-	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12%\n" +
-	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12)\n" +
-	"\x10transaction_type\x18\x05 \x01(\tR\x0ftransactionType\x121\n" +
-	"\x14counterparty_account\x18\x06 \x01(\tR\x13counterpartyAccount\x12+\n" +
-	"\x11counterparty_bank\x18\a \x01(\tR\x10counterpartyBank\x121\n" +
-	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
-	"\achannel\x18\t \x01(\tR\achannel\x12\x17\n" +
-	"\auser_id\x18\n" +
-	" \x01(\tR\x06userId\x12\x1b\n" +
-	"\tbranch_id\x18\v \x01(\tR\bbranchId\x12\x1c\n" +
-	"\ttimestamp\x18\f \x01(\tR\ttimestamp\"\xf6\x01\n" +
-	"\x1aAnalyzeTransactionResponse\x12#\n" +
-	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12\x1d\n" +
-	"\n" +
-	"risk_score\x18\x02 \x01(\x05R\triskScore\x12\x1d\n" +
-	"\n" +
-	"risk_level\x18\x03 \x01(\tR\triskLevel\x12\x14\n" +
-	"\x05flags\x18\x04 \x03(\tR\x05flags\x12&\n" +
-	"\x0erecommendation\x18\x05 \x01(\tR\x0erecommendation\x12\x1f\n" +
-	"\vanalyzed_at\x18\x06 \x01(\tR\n" +
-	"analyzedAt\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\"B\n" +
-	"\x1bGetTransactionStatusRequest\x12#\n" +
-	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\"\x85\x02\n" +
-	"\x1cGetTransactionStatusResponse\x12#\n" +
-	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12%\n" +
-	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
-	"\n" +
-	"risk_score\x18\x04 \x01(\x05R\triskScore\x12\x1d\n" +
-	"\n" +
-	"risk_level\x18\x05 \x01(\tR\triskLevel\x12\x14\n" +
-	"\x05flags\x18\x06 \x03(\tR\x05flags\x12-\n" +
-	"\x12analysis_timestamp\x18\a \x01(\tR\x11analysisTimestamp\"\"\n" +
-	" GenerateRandomTransactionRequest\"\xb3\x03\n" +
-	"!GenerateRandomTransactionResponse\x12%\n" +
-	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12%\n" +
-	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12)\n" +
-	"\x10transaction_type\x18\x05 \x01(\tR\x0ftransactionType\x121\n" +
-	"\x14counterparty_account\x18\x06 \x01(\tR\x13counterpartyAccount\x12+\n" +
-	"\x11counterparty_bank\x18\a \x01(\tR\x10counterpartyBank\x121\n" +
-	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
-	"\achannel\x18\t \x01(\tR\achannel\x12\x17\n" +
-	"\auser_id\x18\n" +
-	" \x01(\tR\x06userId\x12\x1b\n" +
-	"\tbranch_id\x18\v \x01(\tR\bbranchId2\xe4\x02\n" +
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTransactionsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetMinScore() int32 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetMaxScore() int32 {
+	if x != nil && x.MaxScore != nil {
+		return *x.MaxScore
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Страница результатов поиска транзакций
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*TransactionSummary  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Пусто на последней странице
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_api_proto_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*TransactionSummary {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Транзакция в результатах поиска
+type TransactionSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ProcessingId        string                 `protobuf:"bytes,1,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	TransactionId       string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountNumber       string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Amount              float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionType     string                 `protobuf:"bytes,6,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	CounterpartyAccount string                 `protobuf:"bytes,7,opt,name=counterparty_account,json=counterpartyAccount,proto3" json:"counterparty_account,omitempty"`
+	CounterpartyCountry string                 `protobuf:"bytes,8,opt,name=counterparty_country,json=counterpartyCountry,proto3" json:"counterparty_country,omitempty"`
+	Channel             string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Timestamp           string                 `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status              string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	RiskScore           int32                  `protobuf:"varint,12,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	RiskLevel           string                 `protobuf:"bytes,13,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	AnalysisTimestamp   string                 `protobuf:"bytes,14,opt,name=analysis_timestamp,json=analysisTimestamp,proto3" json:"analysis_timestamp,omitempty"`
+	Flags               []string               `protobuf:"bytes,15,rep,name=flags,proto3" json:"flags,omitempty"`
+	Evidence            map[string]*Evidence   `protobuf:"bytes,16,rep,name=evidence,proto3" json:"evidence,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt           string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TransactionSummary) Reset() {
+	*x = TransactionSummary{}
+	mi := &file_api_proto_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSummary) ProtoMessage() {}
+
+func (x *TransactionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSummary.ProtoReflect.Descriptor instead.
+func (*TransactionSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransactionSummary) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionSummary) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetCounterpartyAccount() string {
+	if x != nil {
+		return x.CounterpartyAccount
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetCounterpartyCountry() string {
+	if x != nil {
+		return x.CounterpartyCountry
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetRiskScore() int32 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *TransactionSummary) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetAnalysisTimestamp() string {
+	if x != nil {
+		return x.AnalysisTimestamp
+	}
+	return ""
+}
+
+func (x *TransactionSummary) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *TransactionSummary) GetEvidence() map[string]*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *TransactionSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Условия подписки на изменения транзакций; пустые поля не ограничивают поток
+type WatchTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"` // Счет отправителя или контрагента
+	RiskLevel     string                 `protobuf:"bytes,2,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`             // События без анализа не передаются
+	MinScore      *int32                 `protobuf:"varint,3,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`         // События без анализа не передаются
+	Types         []string               `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`                                      // received, analyzed, alert_raised, decision_changed
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`       // Токен последнего полученного события; пусто - только новые события
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTransactionsRequest) Reset() {
+	*x = WatchTransactionsRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTransactionsRequest) ProtoMessage() {}
+
+func (x *WatchTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchTransactionsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *WatchTransactionsRequest) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *WatchTransactionsRequest) GetMinScore() int32 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+func (x *WatchTransactionsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchTransactionsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Событие изменения транзакции
+type TransactionUpdate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken         string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Type                string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ProcessingId        string                 `protobuf:"bytes,3,opt,name=processing_id,json=processingId,proto3" json:"processing_id,omitempty"`
+	TransactionId       string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountNumber       string                 `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	CounterpartyAccount string                 `protobuf:"bytes,6,opt,name=counterparty_account,json=counterpartyAccount,proto3" json:"counterparty_account,omitempty"`
+	Amount              float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status              string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	RiskScore           *int32                 `protobuf:"varint,10,opt,name=risk_score,json=riskScore,proto3,oneof" json:"risk_score,omitempty"` // Нет, если транзакция еще не проанализирована
+	RiskLevel           string                 `protobuf:"bytes,11,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Decision            string                 `protobuf:"bytes,12,opt,name=decision,proto3" json:"decision,omitempty"`               // Для decision_changed
+	AlertId             int64                  `protobuf:"varint,13,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"` // Для alert_raised
+	CaseId              int64                  `protobuf:"varint,14,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TransactionUpdate) Reset() {
+	*x = TransactionUpdate{}
+	mi := &file_api_proto_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionUpdate) ProtoMessage() {}
+
+func (x *TransactionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionUpdate.ProtoReflect.Descriptor instead.
+func (*TransactionUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactionUpdate) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetProcessingId() string {
+	if x != nil {
+		return x.ProcessingId
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetCounterpartyAccount() string {
+	if x != nil {
+		return x.CounterpartyAccount
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetRiskScore() int32 {
+	if x != nil && x.RiskScore != nil {
+		return *x.RiskScore
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *TransactionUpdate) GetAlertId() int64 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetCaseId() int64 {
+	if x != nil {
+		return x.CaseId
+	}
+	return 0
+}
+
+func (x *TransactionUpdate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_api_proto_transaction_proto protoreflect.FileDescriptor
+
+const file_api_proto_transaction_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/proto/transaction.proto\x12\vtransaction\"\xf4\x03\n" +
+	"\x19AnalyzeTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12)\n" +
+	"\x10transaction_type\x18\x05 \x01(\tR\x0ftransactionType\x121\n" +
+	"\x14counterparty_account\x18\x06 \x01(\tR\x13counterpartyAccount\x12+\n" +
+	"\x11counterparty_bank\x18\a \x01(\tR\x10counterpartyBank\x121\n" +
+	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbranch_id\x18\v \x01(\tR\bbranchId\x12\x1c\n" +
+	"\ttimestamp\x18\f \x01(\tR\ttimestamp\x12)\n" +
+	"\x10counterparty_bic\x18\r \x01(\tR\x0fcounterpartyBic\"\x9d\x03\n" +
+	"\x1aAnalyzeTransactionResponse\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\x02 \x01(\x05R\triskScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x03 \x01(\tR\triskLevel\x12\x14\n" +
+	"\x05flags\x18\x04 \x03(\tR\x05flags\x12&\n" +
+	"\x0erecommendation\x18\x05 \x01(\tR\x0erecommendation\x12\x1f\n" +
+	"\vanalyzed_at\x18\x06 \x01(\tR\n" +
+	"analyzedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12Q\n" +
+	"\bevidence\x18\b \x03(\v25.transaction.AnalyzeTransactionResponse.EvidenceEntryR\bevidence\x1aR\n" +
+	"\rEvidenceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.transaction.EvidenceR\x05value:\x028\x01\"\xba\x01\n" +
+	" AnalyzeTransactionStreamResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12?\n" +
+	"\x06result\x18\x02 \x01(\v2'.transaction.AnalyzeTransactionResponseR\x06result\x12.\n" +
+	"\x05error\x18\x03 \x01(\v2\x18.transaction.StreamErrorR\x05error\";\n" +
+	"\vStreamError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\"\n" +
+	"\bEvidence\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"B\n" +
+	"\x1bGetTransactionStatusRequest\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\"\xae\x03\n" +
+	"\x1cGetTransactionStatusResponse\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\x04 \x01(\x05R\triskScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x05 \x01(\tR\triskLevel\x12\x14\n" +
+	"\x05flags\x18\x06 \x03(\tR\x05flags\x12-\n" +
+	"\x12analysis_timestamp\x18\a \x01(\tR\x11analysisTimestamp\x12S\n" +
+	"\bevidence\x18\b \x03(\v27.transaction.GetTransactionStatusResponse.EvidenceEntryR\bevidence\x1aR\n" +
+	"\rEvidenceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.transaction.EvidenceR\x05value:\x028\x01\"\"\n" +
+	" GenerateRandomTransactionRequest\"\xb3\x03\n" +
+	"!GenerateRandomTransactionResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12)\n" +
+	"\x10transaction_type\x18\x05 \x01(\tR\x0ftransactionType\x121\n" +
+	"\x14counterparty_account\x18\x06 \x01(\tR\x13counterpartyAccount\x12+\n" +
+	"\x11counterparty_bank\x18\a \x01(\tR\x10counterpartyBank\x121\n" +
+	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x17\n" +
+	"\auser_id\x18\n" +
+	" \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbranch_id\x18\v \x01(\tR\bbranchId\"\xcc\x03\n" +
+	"\x17ListTransactionsRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\"\n" +
+	"\fcounterparty\x18\x02 \x01(\tR\fcounterparty\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x03 \x01(\tR\triskLevel\x12 \n" +
+	"\tmin_score\x18\x04 \x01(\x05H\x00R\bminScore\x88\x01\x01\x12 \n" +
+	"\tmax_score\x18\x05 \x01(\x05H\x01R\bmaxScore\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x12\n" +
+	"\x04from\x18\n" +
+	" \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\v \x01(\tR\x02to\x12\x17\n" +
+	"\asort_by\x18\f \x01(\tR\x06sortBy\x12\x14\n" +
+	"\x05order\x18\r \x01(\tR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x0e \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x0f \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"_min_scoreB\f\n" +
+	"\n" +
+	"_max_score\"\x80\x01\n" +
+	"\x18ListTransactionsResponse\x12C\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1f.transaction.TransactionSummaryR\ftransactions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xdd\x05\n" +
+	"\x12TransactionSummary\x12#\n" +
+	"\rprocessing_id\x18\x01 \x01(\tR\fprocessingId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12)\n" +
+	"\x10transaction_type\x18\x06 \x01(\tR\x0ftransactionType\x121\n" +
+	"\x14counterparty_account\x18\a \x01(\tR\x13counterpartyAccount\x121\n" +
+	"\x14counterparty_country\x18\b \x01(\tR\x13counterpartyCountry\x12\x18\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x1c\n" +
+	"\ttimestamp\x18\n" +
+	" \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\f \x01(\x05R\triskScore\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\r \x01(\tR\triskLevel\x12-\n" +
+	"\x12analysis_timestamp\x18\x0e \x01(\tR\x11analysisTimestamp\x12\x14\n" +
+	"\x05flags\x18\x0f \x03(\tR\x05flags\x12I\n" +
+	"\bevidence\x18\x10 \x03(\v2-.transaction.TransactionSummary.EvidenceEntryR\bevidence\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x1aR\n" +
+	"\rEvidenceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.transaction.EvidenceR\x05value:\x028\x01\"\xc9\x01\n" +
+	"\x18WatchTransactionsRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x02 \x01(\tR\triskLevel\x12 \n" +
+	"\tmin_score\x18\x03 \x01(\x05H\x00R\bminScore\x88\x01\x01\x12\x14\n" +
+	"\x05types\x18\x04 \x03(\tR\x05types\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeTokenB\f\n" +
+	"\n" +
+	"_min_score\"\xfd\x03\n" +
+	"\x11TransactionUpdate\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rprocessing_id\x18\x03 \x01(\tR\fprocessingId\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12%\n" +
+	"\x0eaccount_number\x18\x05 \x01(\tR\raccountNumber\x121\n" +
+	"\x14counterparty_account\x18\x06 \x01(\tR\x13counterpartyAccount\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\"\n" +
+	"\n" +
+	"risk_score\x18\n" +
+	" \x01(\x05H\x00R\triskScore\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\v \x01(\tR\triskLevel\x12\x1a\n" +
+	"\bdecision\x18\f \x01(\tR\bdecision\x12\x19\n" +
+	"\balert_id\x18\r \x01(\x03R\aalertId\x12\x17\n" +
+	"\acase_id\x18\x0e \x01(\x03R\x06caseId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAtB\r\n" +
+	"\v_risk_score2\x9a\x05\n" +
 	"\x12TransactionService\x12e\n" +
-	"\x12AnalyzeTransaction\x12&.transaction.AnalyzeTransactionRequest\x1a'.transaction.AnalyzeTransactionResponse\x12k\n" +
+	"\x12AnalyzeTransaction\x12&.transaction.AnalyzeTransactionRequest\x1a'.transaction.AnalyzeTransactionResponse\x12u\n" +
+	"\x18AnalyzeTransactionStream\x12&.transaction.AnalyzeTransactionRequest\x1a-.transaction.AnalyzeTransactionStreamResponse(\x010\x01\x12k\n" +
 	"\x14GetTransactionStatus\x12(.transaction.GetTransactionStatusRequest\x1a).transaction.GetTransactionStatusResponse\x12z\n" +
-	"\x19GenerateRandomTransaction\x12-.transaction.GenerateRandomTransactionRequest\x1a..transaction.GenerateRandomTransactionResponseB'Z%bank-aml-system/api/proto;transactionb\x06proto3"
+	"\x19GenerateRandomTransaction\x12-.transaction.GenerateRandomTransactionRequest\x1a..transaction.GenerateRandomTransactionResponse\x12_\n" +
+	"\x10ListTransactions\x12$.transaction.ListTransactionsRequest\x1a%.transaction.ListTransactionsResponse\x12\\\n" +
+	"\x11WatchTransactions\x12%.transaction.WatchTransactionsRequest\x1a\x1e.transaction.TransactionUpdate0\x01B'Z%bank-aml-system/api/proto;transactionb\x06proto3"
 
 var (
 	file_api_proto_transaction_proto_rawDescOnce sync.Once
@@ -620,27 +1522,53 @@ func file_api_proto_transaction_proto_rawDescGZIP() []byte {
 	return file_api_proto_transaction_proto_rawDescData
 }
 
-var file_api_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_api_proto_transaction_proto_goTypes = []any{
 	(*AnalyzeTransactionRequest)(nil),         // 0: transaction.AnalyzeTransactionRequest
 	(*AnalyzeTransactionResponse)(nil),        // 1: transaction.AnalyzeTransactionResponse
-	(*GetTransactionStatusRequest)(nil),       // 2: transaction.GetTransactionStatusRequest
-	(*GetTransactionStatusResponse)(nil),      // 3: transaction.GetTransactionStatusResponse
-	(*GenerateRandomTransactionRequest)(nil),  // 4: transaction.GenerateRandomTransactionRequest
-	(*GenerateRandomTransactionResponse)(nil), // 5: transaction.GenerateRandomTransactionResponse
+	(*AnalyzeTransactionStreamResponse)(nil),  // 2: transaction.AnalyzeTransactionStreamResponse
+	(*StreamError)(nil),                       // 3: transaction.StreamError
+	(*Evidence)(nil),                          // 4: transaction.Evidence
+	(*GetTransactionStatusRequest)(nil),       // 5: transaction.GetTransactionStatusRequest
+	(*GetTransactionStatusResponse)(nil),      // 6: transaction.GetTransactionStatusResponse
+	(*GenerateRandomTransactionRequest)(nil),  // 7: transaction.GenerateRandomTransactionRequest
+	(*GenerateRandomTransactionResponse)(nil), // 8: transaction.GenerateRandomTransactionResponse
+	(*ListTransactionsRequest)(nil),           // 9: transaction.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),          // 10: transaction.ListTransactionsResponse
+	(*TransactionSummary)(nil),                // 11: transaction.TransactionSummary
+	(*WatchTransactionsRequest)(nil),          // 12: transaction.WatchTransactionsRequest
+	(*TransactionUpdate)(nil),                 // 13: transaction.TransactionUpdate
+	nil,                                       // 14: transaction.AnalyzeTransactionResponse.EvidenceEntry
+	nil,                                       // 15: transaction.GetTransactionStatusResponse.EvidenceEntry
+	nil,                                       // 16: transaction.TransactionSummary.EvidenceEntry
 }
 var file_api_proto_transaction_proto_depIdxs = []int32{
-	0, // 0: transaction.TransactionService.AnalyzeTransaction:input_type -> transaction.AnalyzeTransactionRequest
-	2, // 1: transaction.TransactionService.GetTransactionStatus:input_type -> transaction.GetTransactionStatusRequest
-	4, // 2: transaction.TransactionService.GenerateRandomTransaction:input_type -> transaction.GenerateRandomTransactionRequest
-	1, // 3: transaction.TransactionService.AnalyzeTransaction:output_type -> transaction.AnalyzeTransactionResponse
-	3, // 4: transaction.TransactionService.GetTransactionStatus:output_type -> transaction.GetTransactionStatusResponse
-	5, // 5: transaction.TransactionService.GenerateRandomTransaction:output_type -> transaction.GenerateRandomTransactionResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	14, // 0: transaction.AnalyzeTransactionResponse.evidence:type_name -> transaction.AnalyzeTransactionResponse.EvidenceEntry
+	1,  // 1: transaction.AnalyzeTransactionStreamResponse.result:type_name -> transaction.AnalyzeTransactionResponse
+	3,  // 2: transaction.AnalyzeTransactionStreamResponse.error:type_name -> transaction.StreamError
+	15, // 3: transaction.GetTransactionStatusResponse.evidence:type_name -> transaction.GetTransactionStatusResponse.EvidenceEntry
+	11, // 4: transaction.ListTransactionsResponse.transactions:type_name -> transaction.TransactionSummary
+	16, // 5: transaction.TransactionSummary.evidence:type_name -> transaction.TransactionSummary.EvidenceEntry
+	4,  // 6: transaction.AnalyzeTransactionResponse.EvidenceEntry.value:type_name -> transaction.Evidence
+	4,  // 7: transaction.GetTransactionStatusResponse.EvidenceEntry.value:type_name -> transaction.Evidence
+	4,  // 8: transaction.TransactionSummary.EvidenceEntry.value:type_name -> transaction.Evidence
+	0,  // 9: transaction.TransactionService.AnalyzeTransaction:input_type -> transaction.AnalyzeTransactionRequest
+	0,  // 10: transaction.TransactionService.AnalyzeTransactionStream:input_type -> transaction.AnalyzeTransactionRequest
+	5,  // 11: transaction.TransactionService.GetTransactionStatus:input_type -> transaction.GetTransactionStatusRequest
+	7,  // 12: transaction.TransactionService.GenerateRandomTransaction:input_type -> transaction.GenerateRandomTransactionRequest
+	9,  // 13: transaction.TransactionService.ListTransactions:input_type -> transaction.ListTransactionsRequest
+	12, // 14: transaction.TransactionService.WatchTransactions:input_type -> transaction.WatchTransactionsRequest
+	1,  // 15: transaction.TransactionService.AnalyzeTransaction:output_type -> transaction.AnalyzeTransactionResponse
+	2,  // 16: transaction.TransactionService.AnalyzeTransactionStream:output_type -> transaction.AnalyzeTransactionStreamResponse
+	6,  // 17: transaction.TransactionService.GetTransactionStatus:output_type -> transaction.GetTransactionStatusResponse
+	8,  // 18: transaction.TransactionService.GenerateRandomTransaction:output_type -> transaction.GenerateRandomTransactionResponse
+	10, // 19: transaction.TransactionService.ListTransactions:output_type -> transaction.ListTransactionsResponse
+	13, // 20: transaction.TransactionService.WatchTransactions:output_type -> transaction.TransactionUpdate
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_transaction_proto_init() }
@@ -648,13 +1576,16 @@ func file_api_proto_transaction_proto_init() {
 	if File_api_proto_transaction_proto != nil {
 		return
 	}
+	file_api_proto_transaction_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_proto_transaction_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_proto_transaction_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_transaction_proto_rawDesc), len(file_api_proto_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
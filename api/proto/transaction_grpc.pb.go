@@ -20,8 +20,11 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	TransactionService_AnalyzeTransaction_FullMethodName        = "/transaction.TransactionService/AnalyzeTransaction"
+	TransactionService_AnalyzeTransactionStream_FullMethodName  = "/transaction.TransactionService/AnalyzeTransactionStream"
 	TransactionService_GetTransactionStatus_FullMethodName      = "/transaction.TransactionService/GetTransactionStatus"
 	TransactionService_GenerateRandomTransaction_FullMethodName = "/transaction.TransactionService/GenerateRandomTransaction"
+	TransactionService_ListTransactions_FullMethodName          = "/transaction.TransactionService/ListTransactions"
+	TransactionService_WatchTransactions_FullMethodName         = "/transaction.TransactionService/WatchTransactions"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -32,10 +35,18 @@ const (
 type TransactionServiceClient interface {
 	// Анализ транзакции на предмет рисков
 	AnalyzeTransaction(ctx context.Context, in *AnalyzeTransactionRequest, opts ...grpc.CallOption) (*AnalyzeTransactionResponse, error)
+	// Потоковый анализ: результаты приходят по мере готовности и сопоставляются с запросами по transaction_id
+	// Ошибка отдельной транзакции возвращается в ответе и не закрывает поток
+	AnalyzeTransactionStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse], error)
 	// Получение статуса транзакции
 	GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error)
 	// Генерация случайной транзакции
 	GenerateRandomTransaction(ctx context.Context, in *GenerateRandomTransactionRequest, opts ...grpc.CallOption) (*GenerateRandomTransactionResponse, error)
+	// Поиск транзакций с фильтрами, сортировкой и курсорной пагинацией
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Подписка на изменения транзакций: прием, завершение анализа, алерты и решения
+	// После обрыва клиент передает resume_token последнего полученного события и получает пропущенные
+	WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionUpdate], error)
 }
 
 type transactionServiceClient struct {
@@ -56,6 +67,19 @@ func (c *transactionServiceClient) AnalyzeTransaction(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *transactionServiceClient) AnalyzeTransactionStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_AnalyzeTransactionStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_AnalyzeTransactionStreamClient = grpc.BidiStreamingClient[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]
+
 func (c *transactionServiceClient) GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTransactionStatusResponse)
@@ -76,6 +100,35 @@ func (c *transactionServiceClient) GenerateRandomTransaction(ctx context.Context
 	return out, nil
 }
 
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[1], TransactionService_WatchTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTransactionsRequest, TransactionUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_WatchTransactionsClient = grpc.ServerStreamingClient[TransactionUpdate]
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -84,10 +137,18 @@ func (c *transactionServiceClient) GenerateRandomTransaction(ctx context.Context
 type TransactionServiceServer interface {
 	// Анализ транзакции на предмет рисков
 	AnalyzeTransaction(context.Context, *AnalyzeTransactionRequest) (*AnalyzeTransactionResponse, error)
+	// Потоковый анализ: результаты приходят по мере готовности и сопоставляются с запросами по transaction_id
+	// Ошибка отдельной транзакции возвращается в ответе и не закрывает поток
+	AnalyzeTransactionStream(grpc.BidiStreamingServer[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]) error
 	// Получение статуса транзакции
 	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
 	// Генерация случайной транзакции
 	GenerateRandomTransaction(context.Context, *GenerateRandomTransactionRequest) (*GenerateRandomTransactionResponse, error)
+	// Поиск транзакций с фильтрами, сортировкой и курсорной пагинацией
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Подписка на изменения транзакций: прием, завершение анализа, алерты и решения
+	// После обрыва клиент передает resume_token последнего полученного события и получает пропущенные
+	WatchTransactions(*WatchTransactionsRequest, grpc.ServerStreamingServer[TransactionUpdate]) error
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -101,12 +162,21 @@ type UnimplementedTransactionServiceServer struct{}
 func (UnimplementedTransactionServiceServer) AnalyzeTransaction(context.Context, *AnalyzeTransactionRequest) (*AnalyzeTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AnalyzeTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) AnalyzeTransactionStream(grpc.BidiStreamingServer[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method AnalyzeTransactionStream not implemented")
+}
 func (UnimplementedTransactionServiceServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionStatus not implemented")
 }
 func (UnimplementedTransactionServiceServer) GenerateRandomTransaction(context.Context, *GenerateRandomTransactionRequest) (*GenerateRandomTransactionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GenerateRandomTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) WatchTransactions(*WatchTransactionsRequest, grpc.ServerStreamingServer[TransactionUpdate]) error {
+	return status.Error(codes.Unimplemented, "method WatchTransactions not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -146,6 +216,13 @@ func _TransactionService_AnalyzeTransaction_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_AnalyzeTransactionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TransactionServiceServer).AnalyzeTransactionStream(&grpc.GenericServerStream[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_AnalyzeTransactionStreamServer = grpc.BidiStreamingServer[AnalyzeTransactionRequest, AnalyzeTransactionStreamResponse]
+
 func _TransactionService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionStatusRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +259,35 @@ func _TransactionService_GenerateRandomTransaction_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_WatchTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).WatchTransactions(m, &grpc.GenericServerStream[WatchTransactionsRequest, TransactionUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_WatchTransactionsServer = grpc.ServerStreamingServer[TransactionUpdate]
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,7 +307,23 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateRandomTransaction",
 			Handler:    _TransactionService_GenerateRandomTransaction_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _TransactionService_ListTransactions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AnalyzeTransactionStream",
+			Handler:       _TransactionService_AnalyzeTransactionStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchTransactions",
+			Handler:       _TransactionService_WatchTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/transaction.proto",
 }
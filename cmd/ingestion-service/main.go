@@ -7,4 +7,14 @@ import "bank-aml-system/internal/bootstrap/ingestion"
 // @description Система противодействия отмыванию денег и мошенничеству
 // @host localhost:8080
 // @BasePath /api/v1
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API-ключ из AUTH_API_KEYS
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description JWT в виде "Bearer <token>"; роли - в claim AUTH_JWT_ROLES_CLAIM
+// @security ApiKeyAuth
+// @security BearerAuth
 func main() { ingestion.StartIngestionService() }
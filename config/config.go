@@ -1,18 +1,31 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	DB     DBConfig
-	Redis  RedisConfig
-	Kafka  KafkaConfig
-	Server ServerConfig
+	DB         DBConfig
+	Redis      RedisConfig
+	Kafka      KafkaConfig
+	Server     ServerConfig
+	Rules      RulesConfig
+	Decisions  DecisionConfig
+	GoAML      GoAMLConfig
+	Reporting  ReportingConfig
+	Blacklist  BlacklistConfig
+	Watch      WatchConfig
+	Auth       AuthConfig
+	GRPC       GRPCConfig
+	RateLimit  RateLimitConfig
+	Validation ValidationConfig
 }
 
 type DBConfig struct {
@@ -29,6 +42,7 @@ type KafkaConfig struct {
 	Brokers            []string
 	TransactionTopic   string
 	AnalyzedTopic      string
+	DecisionTopic      string
 	ConsumerGroupID    string
 }
 
@@ -36,6 +50,165 @@ type ServerConfig struct {
 	IngestionPort      int
 	FraudDetectionPort int
 	GRPCPort          int
+
+	GRPCStreamConcurrency int // Число транзакций потока AnalyzeTransactionStream, анализируемых одновременно
+}
+
+// GRPCConfig содержит настройки транспорта и обработки вызовов gRPC-сервера
+// TLS включается, если заданы сертификат и ключ сервера; CA клиентов включает взаимную аутентификацию (mTLS)
+type GRPCConfig struct {
+	TLSCertFile  string // Сертификат сервера (PEM)
+	TLSKeyFile   string // Закрытый ключ сервера (PEM)
+	ClientCAFile string // CA, которым подписаны сертификаты клиентов; задан - сертификат клиента обязателен
+
+	CAFile         string // CA для проверки сертификата сервера встроенным клиентом REST -> gRPC; пусто - системные CA
+	ClientCertFile string // Сертификат встроенного клиента для mTLS
+	ClientKeyFile  string // Закрытый ключ встроенного клиента
+	ServerName     string // Имя сервера для проверки сертификата; пусто - имя из адреса
+
+	DefaultTimeout  time.Duration            // Срок выполнения унарного вызова, если для метода не задан свой; 0 - без ограничения
+	MethodTimeouts  map[string]time.Duration // Сроки выполнения отдельных методов (в том числе потоковых), например AnalyzeTransaction=10s
+	ShutdownTimeout time.Duration            // Сколько ждать завершения вызовов при остановке, прежде чем закрыть соединения
+}
+
+// RateLimitConfig содержит ограничения частоты запросов клиентов к операциям приема (token bucket)
+// Операции общие для REST и gRPC: transactions, batch, generate
+type RateLimitConfig struct {
+	Enabled      bool
+	Limits       map[string]RateLimit // Ограничения операций для каждого клиента, например transactions=50/s:100
+	ClientLimits map[string]RateLimit // Ограничения отдельных клиентов вида client/operation, заменяют общие
+}
+
+// ValidationConfig содержит ограничения времени операции при проверке входящих транзакций
+type ValidationConfig struct {
+	MaxFutureSkew time.Duration // Насколько время операции может опережать часы сервиса; 0 - не проверяется
+	MaxAge        time.Duration // Наибольший возраст операции; 0 - не проверяется
+}
+
+// RateLimit - скорость пополнения корзины и ее емкость (наибольший всплеск запросов)
+type RateLimit struct {
+	Rate  float64 // Запросов в секунду
+	Burst int
+}
+
+// DecisionConfig содержит настройки решений по транзакциям
+type DecisionConfig struct {
+	ApprovalTTL time.Duration // Срок, в течение которого блокировку или разблокировку должен подтвердить второй сотрудник
+
+	HoldSLA           time.Duration // Срок проверки приостановленной операции аналитиком
+	HoldExpiryAction  string        // Действие по истечении срока: release (разрешить) или escalate (эскалировать кейс)
+	HoldCheckInterval time.Duration // Период проверки истекших сроков планировщиком fraud-detection-service
+}
+
+// GoAMLConfig содержит реквизиты отчитывающейся организации для сообщений goAML
+type GoAMLConfig struct {
+	RentityID          int    // Идентификатор организации, присвоенный регулятором
+	RentityBranch      string // Подразделение, направляющее сообщения
+	InstitutionName    string
+	InstitutionSwift   string
+	CountryCodeLocal   string             // Страна банка (ISO 3166-1 alpha-2)
+	CurrencyCodeLocal  string             // Валюта сумм amount_local (ISO 4217)
+	ExchangeRates      map[string]float64 // Курсы валют к местной валюте, например USD=90.5
+	ExpectedCurrencies []string           // Валюты операций банка; при отсутствии курса для них при запуске выводится предупреждение
+	SchemaPath         string             // Официальная схема goAML (XSD) регулятора; пусто - только предварительная проверка
+	XMLLintPath        string             // Валидатор xmllint (libxml2) для проверки по SchemaPath
+
+	ReportingPersonFirstName string // Ответственный сотрудник (MLRO)
+	ReportingPersonLastName  string
+	ReportingPersonEmail     string
+}
+
+// ReportingConfig содержит настройки обязательного контроля операций с наличными
+// Правила применяются ко всем операциям независимо от оценки риска
+type ReportingConfig struct {
+	Enabled        bool
+	CashThreshold  float64  // Пороговая сумма в местной валюте (GOAML_CURRENCY_LOCAL)
+	CashTypes      []string // Типы транзакций, считающиеся операциями с наличными
+	AggregateDaily bool     // Суммировать операции с наличными клиента за календарный день (UTC)
+}
+
+// BlacklistConfig содержит настройки ретроспективной проверки при добавлении записи в черный или санкционный список
+type BlacklistConfig struct {
+	RetroLookback time.Duration // Глубина поиска прошлых операций по новой записи
+}
+
+// WatchConfig содержит настройки подписок на изменения транзакций
+type WatchConfig struct {
+	PollInterval time.Duration // Период чтения ленты изменений для каждой подписки
+	Retention    time.Duration // Срок хранения событий ленты; после него переподключение с resume token невозможно
+}
+
+// AuthConfig содержит настройки аутентификации и авторизации REST и gRPC API
+// Ключи проверяются локально: API-ключи из конфигурации, подпись JWT - общим секретом (HS*) или открытым ключом (RS*)
+type AuthConfig struct {
+	Enabled          bool     // false - пользователь берется из заголовков X-User-ID и X-User-Roles, которые проставляет шлюз
+	APIKeys          []string // Ключи вида subject:role1|role2:key
+	JWTSecret        string   // Общий секрет для токенов HS256, HS384, HS512
+	JWTPublicKeyFile string   // PEM-файл открытого ключа RSA для токенов RS256, RS384, RS512
+	JWTIssuer        string   // Ожидаемый iss; пусто - не проверяется
+	JWTAudience      string   // Ожидаемый aud; пусто - не проверяется
+	JWTRolesClaim    string   // Claim со списком ролей
+	AllowedOrigins   []string // Источники, которым разрешены запросы из браузера (CORS); * - любой источник без передачи учетных данных
+}
+
+// RulesConfig содержит настройки дополнительных правил анализа рисков
+// Нулевое значение отключает все дополнительные правила
+type RulesConfig struct {
+	Account     AccountRulesConfig
+	Baseline    BaselineRulesConfig
+	Beneficiary BeneficiaryRulesConfig
+	PassThrough PassThroughRulesConfig
+	Cycle       CycleRulesConfig
+	Fan         FanRulesConfig
+}
+
+// AccountRulesConfig содержит настройки правил на основе реестра счетов
+type AccountRulesConfig struct {
+	Enabled            bool
+	NewAccountDays     int     // Счет считается новым в течение N дней после открытия
+	TurnoverMultiplier float64 // Допустимое превышение заявленного месячного оборота
+}
+
+// BaselineRulesConfig содержит настройки правил отклонения от поведенческого профиля счета
+type BaselineRulesConfig struct {
+	Enabled            bool
+	WarmupTransactions int     // Минимум операций в профиле, прежде чем правила начнут срабатывать
+	ZScoreThreshold    float64 // Порог z-оценки суммы операции
+	MinCategoryShare   float64 // Доля канала/часа в истории, ниже которой они считаются нетипичными
+}
+
+// BeneficiaryRulesConfig содержит настройки правил первого платежа новому получателю
+type BeneficiaryRulesConfig struct {
+	Enabled     bool
+	LargeAmount float64       // Сумма, начиная с которой платеж новому получателю считается крупным
+	RapidWindow time.Duration // Окно после первого платежа, в котором крупный платеж подозрителен
+}
+
+// AccountFlowsRetention - сколько Redis хранит потоки счета (limits:account:*:flows); окно правила не может быть больше
+const AccountFlowsRetention = 7 * 24 * time.Hour
+
+// PassThroughRulesConfig содержит настройки правила транзитного движения средств (дропы)
+type PassThroughRulesConfig struct {
+	Enabled      bool
+	Window       time.Duration // Окно сопоставления входящих и исходящих потоков, не больше AccountFlowsRetention
+	MinInflow    float64       // Минимальный входящий поток, с которого правило имеет смысл
+	OutflowRatio float64       // Доля выведенных средств от поступивших, начиная с которой поток считается транзитным
+}
+
+// CycleRulesConfig содержит настройки поиска круговых потоков средств между счетами
+type CycleRulesConfig struct {
+	Enabled         bool
+	MaxLength       int           // Максимальное число переводов в цикле
+	Window          time.Duration // Скользящее окно графа переводов
+	AmountTolerance float64       // Допустимое относительное отклонение сумм переводов в цикле
+}
+
+// FanRulesConfig содержит настройки правил веерных поступлений и выплат
+type FanRulesConfig struct {
+	Enabled         bool
+	Window          time.Duration // Окно подсчета различных контрагентов
+	FanInThreshold  int64         // Число различных отправителей, начиная с которого счет считается воронкой
+	FanOutThreshold int64         // Число различных получателей, начиная с которого выплаты считаются веерными
 }
 
 func Load() *Config {
@@ -44,7 +217,7 @@ func Load() *Config {
 		log.Println("No .env file found, using environment variables")
 	}
 
-	return &Config{
+	cfg := &Config{
 		DB: DBConfig{
 			DBPath: getEnv("DB_PATH", "./data/bank_aml.db"),
 		},
@@ -57,14 +230,142 @@ func Load() *Config {
 			Brokers:            []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 			TransactionTopic:   getEnv("KAFKA_TRANSACTION_TOPIC", "bank.transactions.received"),
 			AnalyzedTopic:      getEnv("KAFKA_ANALYZED_TOPIC", "bank.transactions.analyzed"),
+			DecisionTopic:      getEnv("KAFKA_DECISION_TOPIC", "bank.transactions.decisions"),
 			ConsumerGroupID:    getEnv("KAFKA_CONSUMER_GROUP", "fraud-detection-group"),
 		},
 		Server: ServerConfig{
 			IngestionPort:      getEnvAsInt("INGESTION_SERVICE_PORT", 8080),
 			FraudDetectionPort: getEnvAsInt("FRAUD_DETECTION_SERVICE_PORT", 8081),
 			GRPCPort:          getEnvAsInt("GRPC_PORT", 50051),
+
+			GRPCStreamConcurrency: getEnvAsInt("GRPC_STREAM_CONCURRENCY", 8),
+		},
+		Decisions: DecisionConfig{
+			ApprovalTTL: getEnvAsDuration("DECISION_APPROVAL_TTL", 24*time.Hour),
+
+			HoldSLA:           getEnvAsDuration("DECISION_HOLD_SLA", 4*time.Hour),
+			HoldExpiryAction:  getEnv("DECISION_HOLD_EXPIRY_ACTION", "escalate"),
+			HoldCheckInterval: getEnvAsDuration("DECISION_HOLD_CHECK_INTERVAL", 30*time.Second),
+		},
+		GoAML: GoAMLConfig{
+			RentityID:          getEnvAsInt("GOAML_RENTITY_ID", 0),
+			RentityBranch:      getEnv("GOAML_RENTITY_BRANCH", ""),
+			InstitutionName:    getEnv("GOAML_INSTITUTION_NAME", ""),
+			InstitutionSwift:   getEnv("GOAML_INSTITUTION_SWIFT", ""),
+			CountryCodeLocal:   getEnv("GOAML_COUNTRY_LOCAL", "RU"),
+			CurrencyCodeLocal:  getEnv("GOAML_CURRENCY_LOCAL", "RUB"),
+			ExchangeRates:      getEnvAsRates("GOAML_EXCHANGE_RATES"),
+			ExpectedCurrencies: getEnvAsList("GOAML_EXPECTED_CURRENCIES", []string{"USD", "EUR", "GBP", "CHF", "JPY"}),
+			SchemaPath:         getEnv("GOAML_XSD_PATH", ""),
+			XMLLintPath:        getEnv("GOAML_XMLLINT", "xmllint"),
+
+			ReportingPersonFirstName: getEnv("GOAML_REPORTING_FIRST_NAME", ""),
+			ReportingPersonLastName:  getEnv("GOAML_REPORTING_LAST_NAME", ""),
+			ReportingPersonEmail:     getEnv("GOAML_REPORTING_EMAIL", ""),
+		},
+		Reporting: ReportingConfig{
+			Enabled:        getEnvAsBool("REPORTING_CASH_ENABLED", true),
+			CashThreshold:  getEnvAsFloat("REPORTING_CASH_THRESHOLD", 1000000),
+			CashTypes:      getEnvAsList("REPORTING_CASH_TYPES", []string{"deposit", "withdrawal"}),
+			AggregateDaily: getEnvAsBool("REPORTING_CASH_AGGREGATE_DAILY", true),
+		},
+		Blacklist: BlacklistConfig{
+			RetroLookback: getEnvAsDuration("BLACKLIST_RETRO_LOOKBACK", 90*24*time.Hour),
+		},
+		Watch: WatchConfig{
+			PollInterval: getEnvAsDuration("WATCH_POLL_INTERVAL", time.Second),
+			Retention:    getEnvAsDuration("WATCH_RETENTION", 72*time.Hour),
+		},
+		GRPC: GRPCConfig{
+			TLSCertFile:     getEnv("GRPC_TLS_CERT_FILE", ""),
+			TLSKeyFile:      getEnv("GRPC_TLS_KEY_FILE", ""),
+			ClientCAFile:    getEnv("GRPC_TLS_CLIENT_CA_FILE", ""),
+			CAFile:          getEnv("GRPC_TLS_CA_FILE", ""),
+			ClientCertFile:  getEnv("GRPC_TLS_CLIENT_CERT_FILE", ""),
+			ClientKeyFile:   getEnv("GRPC_TLS_CLIENT_KEY_FILE", ""),
+			ServerName:      getEnv("GRPC_TLS_SERVER_NAME", ""),
+			DefaultTimeout:  getEnvAsDuration("GRPC_DEFAULT_TIMEOUT", 30*time.Second),
+			MethodTimeouts:  getEnvAsDurations("GRPC_METHOD_TIMEOUTS"),
+			ShutdownTimeout: getEnvAsDuration("GRPC_SHUTDOWN_TIMEOUT", 10*time.Second),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:      getEnvAsBool("RATE_LIMIT_ENABLED", true),
+			Limits:       getEnvAsRateLimits("RATE_LIMITS", "transactions=50/s:100,batch=1/s:5,generate=10/s:20"),
+			ClientLimits: getEnvAsRateLimits("RATE_LIMIT_CLIENTS", ""),
+		},
+		Validation: ValidationConfig{
+			MaxFutureSkew: getEnvAsDuration("VALIDATION_MAX_FUTURE_SKEW", 5*time.Minute),
+			MaxAge:        getEnvAsDuration("VALIDATION_MAX_TRANSACTION_AGE", 365*24*time.Hour),
+		},
+		Auth: AuthConfig{
+			Enabled:          getEnvAsBool("AUTH_ENABLED", true),
+			APIKeys:          getEnvAsList("AUTH_API_KEYS", nil),
+			JWTSecret:        getEnv("AUTH_JWT_SECRET", ""),
+			JWTPublicKeyFile: getEnv("AUTH_JWT_PUBLIC_KEY_FILE", ""),
+			JWTIssuer:        getEnv("AUTH_JWT_ISSUER", ""),
+			JWTAudience:      getEnv("AUTH_JWT_AUDIENCE", ""),
+			JWTRolesClaim:    getEnv("AUTH_JWT_ROLES_CLAIM", "roles"),
+			AllowedOrigins:   getEnvAsList("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
+		},
+		Rules: RulesConfig{
+			Account: AccountRulesConfig{
+				Enabled:            getEnvAsBool("RULES_ACCOUNT_ENABLED", true),
+				NewAccountDays:     getEnvAsInt("RULES_NEW_ACCOUNT_DAYS", 30),
+				TurnoverMultiplier: getEnvAsFloat("RULES_TURNOVER_MULTIPLIER", 1.5),
+			},
+			Baseline: BaselineRulesConfig{
+				Enabled:            getEnvAsBool("RULES_BASELINE_ENABLED", true),
+				WarmupTransactions: getEnvAsInt("RULES_BASELINE_WARMUP", 20),
+				ZScoreThreshold:    getEnvAsFloat("RULES_BASELINE_ZSCORE", 3.0),
+				MinCategoryShare:   getEnvAsFloat("RULES_BASELINE_MIN_SHARE", 0.05),
+			},
+			Beneficiary: BeneficiaryRulesConfig{
+				Enabled:     getEnvAsBool("RULES_BENEFICIARY_ENABLED", true),
+				LargeAmount: getEnvAsFloat("RULES_BENEFICIARY_LARGE_AMOUNT", 500000),
+				RapidWindow: getEnvAsDuration("RULES_BENEFICIARY_RAPID_WINDOW", 60*time.Minute),
+			},
+			PassThrough: PassThroughRulesConfig{
+				Enabled:      getEnvAsBool("RULES_PASS_THROUGH_ENABLED", true),
+				Window:       getEnvAsBoundedDuration("RULES_PASS_THROUGH_WINDOW", 60*time.Minute, AccountFlowsRetention),
+				MinInflow:    getEnvAsFloat("RULES_PASS_THROUGH_MIN_INFLOW", 100000),
+				OutflowRatio: getEnvAsFloat("RULES_PASS_THROUGH_RATIO", 0.8),
+			},
+			Cycle: CycleRulesConfig{
+				Enabled:         getEnvAsBool("RULES_CYCLE_ENABLED", true),
+				MaxLength:       getEnvAsInt("RULES_CYCLE_MAX_LENGTH", 4),
+				Window:          getEnvAsDuration("RULES_CYCLE_WINDOW", 72*time.Hour),
+				AmountTolerance: getEnvAsFloat("RULES_CYCLE_AMOUNT_TOLERANCE", 0.1),
+			},
+			Fan: FanRulesConfig{
+				Enabled:         getEnvAsBool("RULES_FAN_ENABLED", true),
+				Window:          getEnvAsDuration("RULES_FAN_WINDOW", 24*time.Hour),
+				FanInThreshold:  int64(getEnvAsInt("RULES_FAN_IN_THRESHOLD", 10)),
+				FanOutThreshold: int64(getEnvAsInt("RULES_FAN_OUT_THRESHOLD", 10)),
+			},
 		},
 	}
+
+	warnMissingExchangeRates(cfg.GoAML)
+	return cfg
+}
+
+// warnMissingExchangeRates предупреждает о валютах из GOAML_EXPECTED_CURRENCIES без курса:
+// операции с наличными в них попадают в очередь контроля без пересчета, а сообщения goAML по ним не формируются
+func warnMissingExchangeRates(cfg GoAMLConfig) {
+	var missing []string
+	for _, currency := range cfg.ExpectedCurrencies {
+		currency = strings.ToUpper(currency)
+		if currency == strings.ToUpper(cfg.CurrencyCodeLocal) {
+			continue
+		}
+		if _, ok := cfg.ExchangeRates[currency]; !ok {
+			missing = append(missing, currency)
+		}
+	}
+	if len(missing) > 0 {
+		log.Printf("WARNING: GOAML_EXCHANGE_RATES has no rate for %s: cash transactions in these currencies are queued as cash_unconverted and goAML reports on them fail",
+			strings.Join(missing, ", "))
+	}
 }
 
 func getEnv(key, defaultValue string) string {
@@ -86,3 +387,192 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return value
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.ParseBool(strings.TrimSpace(valueStr))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(valueStr), 64)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(strings.TrimSpace(valueStr))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsBoundedDuration читает длительность и ограничивает ее сверху: данные старше max не хранятся,
+// и более длинное окно молча видело бы только их часть
+func getEnvAsBoundedDuration(key string, defaultValue, max time.Duration) time.Duration {
+	value := getEnvAsDuration(key, defaultValue)
+	if value > max {
+		log.Printf("Warning: %s=%s exceeds data retention %s; using %s", key, value, max, max)
+		return max
+	}
+	return value
+}
+
+// getEnvAsList разбирает список значений через запятую
+func getEnvAsList(key string, defaultValue []string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return defaultValue
+	}
+	return values
+}
+
+// getEnvAsRates разбирает курсы валют вида "USD=90.5,EUR=98.1"; некорректные пары пропускаются с предупреждением
+func getEnvAsRates(key string) map[string]float64 {
+	rates := make(map[string]float64)
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			log.Printf("Warning: %s: ignoring malformed entry %q, expected CODE=rate", key, pair)
+			continue
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate <= 0 {
+			log.Printf("Warning: %s: ignoring %q: rate must be a positive number", key, pair)
+			continue
+		}
+		rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+	}
+	return rates
+}
+
+// getEnvAsDurations разбирает сроки вида "AnalyzeTransaction=10s,ListTransactions=5s"; некорректные пары пропускаются
+func getEnvAsDurations(key string) map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || duration < 0 {
+			continue
+		}
+		durations[strings.TrimSpace(name)] = duration
+	}
+	return durations
+}
+
+// rateLimitPeriods - единицы скорости в ограничениях частоты запросов
+var rateLimitPeriods = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+}
+
+// getEnvAsRateLimits разбирает ограничения вида "transactions=50/s:100,batch=600/h:10"
+// Скорость задается в запросах за секунду, минуту, час или сутки (без единицы - в секунду), после двоеточия - емкость корзины.
+// Без емкости она равна числу запросов за единицу времени. Некорректная запись не отключает ограничение:
+// в лог пишется предупреждение и для операции остается значение по умолчанию
+func getEnvAsRateLimits(key, defaultValue string) map[string]RateLimit {
+	defaults := parseRateLimits(key, defaultValue)
+	value := os.Getenv(key)
+	if value == "" {
+		return defaults
+	}
+
+	limits := make(map[string]RateLimit)
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, spec, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			log.Printf("Warning: %s: ignoring malformed entry %q, expected name=rate/unit:burst", key, pair)
+			continue
+		}
+		limit, err := parseRateLimit(spec)
+		if err != nil {
+			if fallback, ok := defaults[name]; ok {
+				log.Printf("Warning: %s: invalid limit for %s: %v; using default %.4g/s:%d", key, name, err, fallback.Rate, fallback.Burst)
+				limits[name] = fallback
+			} else {
+				log.Printf("Warning: %s: invalid limit for %s: %v; no override applied", key, name, err)
+			}
+			continue
+		}
+		limits[name] = limit
+	}
+	return limits
+}
+
+// parseRateLimits разбирает значение по умолчанию; некорректная запись в нем пропускается с предупреждением
+func parseRateLimits(key, value string) map[string]RateLimit {
+	limits := make(map[string]RateLimit)
+	for _, pair := range strings.Split(value, ",") {
+		name, spec, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		limit, err := parseRateLimit(spec)
+		if err != nil {
+			log.Printf("Warning: %s: invalid default limit for %s: %v", key, name, err)
+			continue
+		}
+		limits[strings.TrimSpace(name)] = limit
+	}
+	return limits
+}
+
+// parseRateLimit разбирает одно ограничение вида "50/s:100"
+func parseRateLimit(spec string) (RateLimit, error) {
+	rateSpec, burstSpec, hasBurst := strings.Cut(strings.TrimSpace(spec), ":")
+	countSpec, unit, hasUnit := strings.Cut(rateSpec, "/")
+	period := time.Second
+	if hasUnit {
+		var ok bool
+		if period, ok = rateLimitPeriods[strings.TrimSpace(unit)]; !ok {
+			return RateLimit{}, fmt.Errorf("unknown unit %q, expected s, m, h or d", strings.TrimSpace(unit))
+		}
+	}
+	count, err := strconv.ParseFloat(strings.TrimSpace(countSpec), 64)
+	if err != nil || count <= 0 {
+		return RateLimit{}, fmt.Errorf("rate %q must be a positive number", strings.TrimSpace(countSpec))
+	}
+	burst := int(count)
+	if hasBurst {
+		if burst, err = strconv.Atoi(strings.TrimSpace(burstSpec)); err != nil || burst < 1 {
+			return RateLimit{}, fmt.Errorf("burst %q must be a positive integer", strings.TrimSpace(burstSpec))
+		}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return RateLimit{Rate: count / period.Seconds(), Burst: burst}, nil
+}
@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/transactions": {
-            "get": {
-                "description": "Возвращает список всех транзакций с пагинацией",
+        "/accounts": {
+            "post": {
+                "description": "Создает счет клиента или обновляет существующий (дата открытия, заявленный месячный оборот)",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,24 +25,162 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "accounts"
+                ],
+                "summary": "Зарегистрировать счет",
+                "parameters": [
+                    {
+                        "description": "Данные счета",
+                        "name": "account",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Account"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Счет сохранен",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Account"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Customer Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{account_number}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Получить счет",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Номер счета",
+                        "name": "account_number",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Счет и владелец",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AccountProfile"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{account_number}/activity": {
+            "get": {
+                "description": "Последние операции, где счет - отправитель или контрагент, итоги по валютам, странам контрагентов, каналам и периодам,\nтекущие счетчики Redis (операции за сутки, потоки, различные контрагенты, профиль) и записи черного и санкционного списков по счету или имени владельца.\nИтоги считаются по всем операциям периода; суммы в разных валютах не складываются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
                 ],
-                "summary": "Получить список транзакций",
+                "summary": "Получить активность счета",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Номер счета",
+                        "name": "account_number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода по времени операции (YYYY-MM-DD или RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода по времени операции, не включительно (YYYY-MM-DD включает весь день)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "day",
+                        "description": "Группировка итогов по периодам (day, week, month)",
+                        "name": "period",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "default": 100,
-                        "description": "Лимит результатов (максимум 500)",
+                        "description": "Число последних операций в каждом направлении (максимум 500)",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список транзакций",
+                        "description": "Активность счета",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AccountActivity"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
@@ -55,35 +193,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Принимает транзакцию и отправляет её на анализ рисков через REST API. Транзакция сохраняется в БД и отправляется в Kafka для асинхронной обработки fraud-сервисом.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/accounts/{account_number}/flow-cycles": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "investigations"
                 ],
-                "summary": "Отправить транзакцию на анализ (REST)",
+                "summary": "Получить круговые потоки счета",
                 "parameters": [
                     {
-                        "description": "Данные транзакции",
-                        "name": "transaction",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingRequest"
-                        }
+                        "type": "string",
+                        "description": "Номер счета",
+                        "name": "account_number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Транзакция принята на обработку",
+                    "200": {
+                        "description": "Список циклов",
                         "schema": {
-                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -105,22 +247,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Удаляет все транзакции из базы данных",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/alerts": {
+            "get": {
+                "description": "Возвращает алерты, созданные по результатам анализа (high / require_verification), начиная с последних",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "cases"
+                ],
+                "summary": "Получить алерты",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Статус алерта (open, closed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Номер счета",
+                        "name": "account_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Очистить все транзакции",
                 "responses": {
                     "200": {
-                        "description": "Транзакции очищены",
+                        "description": "Список алертов",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -138,33 +300,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/transactions/generate": {
+        "/blacklist": {
             "get": {
-                "description": "Генерирует случайную транзакцию для тестирования",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Записи начиная с последних; число совпадений ретроспективной проверки - в match_count",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "blacklist"
+                ],
+                "summary": "Получить записи черного и санкционного списков",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Список (blacklist, sanctions)",
+                        "name": "list_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Сгенерировать случайную транзакцию",
                 "responses": {
                     "200": {
-                        "description": "Сгенерированная транзакция",
+                        "description": "Список записей",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/transactions/grpc": {
+            },
             "post": {
-                "description": "Принимает транзакцию и отправляет её на анализ через gRPC-сервис. Транзакция автоматически сохраняется в БД, отправляется в Kafka, обрабатывается fraud-сервисом и возвращается с результатами анализа рисков (risk_score, risk_level, flags).",
+                "description": "Счет из записи сразу учитывается при анализе новых операций. Операции за период ретроспективной проверки (BLACKLIST_RETRO_LOOKBACK),\nв которых счет участвует как счет клиента или контрагента, а также операции по счетам клиентов с совпавшим именем, попадают в совпадения записи; по ним создаются алерты, а если алерт по операции уже был, совпадение дописывается в него и закрытый кейс открывается повторно",
                 "consumes": [
                     "application/json"
                 ],
@@ -172,30 +362,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "blacklist"
                 ],
-                "summary": "Отправить транзакцию через gRPC",
+                "summary": "Добавить запись в черный или санкционный список",
                 "parameters": [
                     {
-                        "description": "Данные транзакции",
-                        "name": "transaction",
+                        "description": "Запись списка (нужен account_number или name)",
+                        "name": "entry",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingRequest"
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BlacklistEntryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Транзакция успешно обработана через gRPC",
+                        "description": "Запись добавлена и проверена",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BlacklistEntry"
                         }
                     },
                     "400": {
-                        "description": "Bad Request - неверный формат данных",
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -203,8 +392,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error - ошибка обработки",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -212,8 +401,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable - gRPC клиент недоступен",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -224,37 +413,324 @@ const docTemplate = `{
                 }
             }
         },
-        "/transactions/{processing_id}": {
+        "/blacklist/{entry_id}": {
             "get": {
-                "description": "Возвращает детальную информацию о транзакции и её анализе рисков",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "blacklist"
                 ],
-                "summary": "Получить статус транзакции",
+                "summary": "Получить запись списка",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID обработки транзакции",
-                        "name": "processing_id",
+                        "type": "integer",
+                        "description": "ID записи",
+                        "name": "entry_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Статус транзакции",
+                        "description": "Запись с совпадениями",
                         "schema": {
-                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionStatusResponse"
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BlacklistEntry"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cases": {
+            "get": {
+                "description": "Возвращает кейсы с фильтрацией по статусу, исполнителю и счету, начиная с последних обновленных",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Получить кейсы",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Статус кейса (open, assigned, investigating, escalated, closed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Исполнитель",
+                        "name": "assigned_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Номер счета",
+                        "name": "account_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список кейсов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{case_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Получить кейс",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кейс",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Назначает аналитика и переводит кейс по статусам open → assigned → investigating → escalated → closed. Для закрытия нужно решение",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Обновить кейс",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Изменения",
+                        "name": "update",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кейс обновлен",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Case"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Transition Not Allowed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{case_id}/attachments": {
+            "post": {
+                "description": "Документ хранится во внешней системе, к кейсу прикладывается ссылка на него",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Приложить документ к кейсу",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Вложение",
+                        "name": "attachment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseAttachment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Вложение добавлено",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseAttachment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -273,10 +749,3463 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "bank-aml-system_internal_models.ProcessingRequest": {
+        },
+        "/cases/{case_id}/notes": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Добавить заметку к кейсу",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Заметка",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseNote"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Заметка добавлена",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.CaseNote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{case_id}/sar-reports": {
+            "post": {
+                "description": "Собирает сообщение (организация, операции, стороны и счета) по операциям алертов кейса и проверяет его: предварительно по встроенной упрощенной схеме (не подтверждает соответствие официальной схеме goAML), затем по XSD регулятора из GOAML_XSD_PATH, если она задана.\nКейс должен быть эскалирован или закрыт с решением sar_filed. Если сообщение не проходит проверку, возвращается 422 со списком несоответствий",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sar"
+                ],
+                "summary": "Сформировать сообщение goAML по кейсу",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Обоснование и принятые меры",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.SARReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Сообщение сформировано",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.SARReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Сообщение не прошло предварительную проверку или проверку по схеме регулятора",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/customers": {
+            "get": {
+                "description": "Возвращает зарегистрированных клиентов, начиная с последних",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Получить список клиентов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список клиентов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создает клиента или обновляет данные существующего (сегмент, резидентство, рейтинг риска KYC)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Зарегистрировать клиента",
+                "parameters": [
+                    {
+                        "description": "Данные клиента",
+                        "name": "customer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Customer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Клиент сохранен",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{customer_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Получить клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента",
+                        "name": "customer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Клиент",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Customer"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{customer_id}/accounts": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "accounts"
+                ],
+                "summary": "Получить счета клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента",
+                        "name": "customer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Счета клиента",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/decision-approvals": {
+            "get": {
+                "description": "Запросы на блокировку и разблокировку, ожидающие второго сотрудника. Неподтвержденные в срок запросы получают статус expired",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Получить запросы на подтверждение",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Статус запроса (pending, approved, rejected, expired)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Processing ID транзакции",
+                        "name": "processing_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список запросов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/decision-approvals/{approval_id}/approve": {
+            "post": {
+                "description": "Подтверждающий определяется по API-ключу или JWT (без аутентификации - по заголовкам X-User-ID и X-User-Roles); требуется роль approver или supervisor и отличие от инициатора",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Подтвердить решение",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID запроса на подтверждение",
+                        "name": "approval_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Пользователь (только при AUTH_ENABLED=false)",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Роли пользователя через запятую (только при AUTH_ENABLED=false)",
+                        "name": "X-User-Roles",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Решение применено",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDecision"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Запрос уже обработан или просрочен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/decision-approvals/{approval_id}/reject": {
+            "post": {
+                "description": "Отклонить может сотрудник с ролью approver или supervisor либо сам инициатор (отзыв запроса)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Отклонить решение",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID запроса на подтверждение",
+                        "name": "approval_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Пользователь (только при AUTH_ENABLED=false)",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Роли пользователя через запятую (только при AUTH_ENABLED=false)",
+                        "name": "X-User-Roles",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Причина отклонения",
+                        "name": "rejection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.ApprovalRejection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Запрос отклонен",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.DecisionApproval"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Запрос уже обработан или просрочен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/dispositions": {
+            "post": {
+                "description": "Сохраняет заключение (true_positive, false_positive, inconclusive) по транзакции (processing_id) или алерту (alert_id). Повторное заключение заменяет предыдущее",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feedback"
+                ],
+                "summary": "Записать заключение аналитика",
+                "parameters": [
+                    {
+                        "description": "Заключение",
+                        "name": "disposition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Disposition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Заключение сохранено",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Disposition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/dispositions/{processing_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feedback"
+                ],
+                "summary": "Получить заключение по транзакции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID транзакции",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заключение",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Disposition"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/flow-cycles": {
+            "get": {
+                "description": "Возвращает обнаруженные циклы A → B → ... → A с полным путем переводов, начиная с последних",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "investigations"
+                ],
+                "summary": "Получить круговые потоки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список циклов",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/holds": {
+            "get": {
+                "description": "Операции, приостановленные по рекомендации require_verification, ожидают решения аналитика до expires_at.\nПо истечении срока операция разрешается (release) или ее кейс эскалируется (escalate) в зависимости от политики. Список упорядочен по сроку",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Получить сроки проверки приостановленных операций",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Статус (active, resolved, released, escalated)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список сроков",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/mandatory-reports": {
+            "get": {
+                "description": "Операции с наличными на сумму не ниже порога (cash_threshold), операции клиента за день, в сумме превысившие порог (cash_daily_aggregate), и операции в валюте без заданного курса (cash_unconverted), независимо от оценки риска",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reporting"
+                ],
+                "summary": "Получить очередь обязательного контроля",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Дата операций (YYYY-MM-DD, UTC)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус (queued, exported)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Правило (cash_threshold, cash_daily_aggregate, cash_unconverted)",
+                        "name": "rule",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Очередь",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/mandatory-reports/export": {
+            "post": {
+                "description": "Формирует файл со всеми операциями за день (по умолчанию - вчера, UTC) и отмечает ожидавшие выгрузки записи как выгруженные",
+                "produces": [
+                    "text/csv",
+                    "application/xml"
+                ],
+                "tags": [
+                    "reporting"
+                ],
+                "summary": "Выгрузить операции за день",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Дата операций (YYYY-MM-DD, UTC)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Формат (csv, xml)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Файл выгрузки",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/rule-precision": {
+            "get": {
+                "description": "Для каждого флага и версии правил: число срабатываний, заключения аналитиков, precision = TP / (TP + FP) и конверсия алертов в SAR.\nГраницы принимаются в формате YYYY-MM-DD (to включительно) или RFC3339 (to не включительно); по умолчанию последние 30 дней",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feedback"
+                ],
+                "summary": "Отчет о точности правил",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Начало периода",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Отчет",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.PrecisionReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sar-reports": {
+            "get": {
+                "description": "Возвращает сформированные сообщения с их статусом и номерами, начиная с последних",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sar"
+                ],
+                "summary": "Получить сообщения goAML",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID кейса",
+                        "name": "case_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус (generated, submitted, accepted, rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список сообщений",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sar-reports/{report_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sar"
+                ],
+                "summary": "Получить сообщение goAML",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сообщения",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сообщение",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.SARReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Допустимые переходы: generated -> submitted -> accepted | rejected. Для accepted обязателен номер, присвоенный порталом регулятора",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sar"
+                ],
+                "summary": "Обновить статус сообщения goAML",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сообщения",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый статус",
+                        "name": "update",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.SARReportUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сообщение обновлено",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.SARReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sar-reports/{report_id}/xml": {
+            "get": {
+                "produces": [
+                    "application/xml"
+                ],
+                "tags": [
+                    "sar"
+                ],
+                "summary": "Скачать XML сообщения goAML",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сообщения",
+                        "name": "report_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "XML сообщения",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "description": "Возвращает транзакции, подходящие под все заданные условия. Порядок задается sort и order; при равных значениях записи упорядочены по внутреннему ID.\nДля следующей страницы передайте next_cursor из ответа в cursor с теми же условиями, sort и order; на последней странице next_cursor отсутствует",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Поиск транзакций",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Счет клиента",
+                        "name": "account_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Счет контрагента",
+                        "name": "counterparty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Уровень риска (low, medium, high)",
+                        "name": "risk_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная оценка риска",
+                        "name": "min_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Максимальная оценка риска",
+                        "name": "max_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статус обработки (pending_review, reviewed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Валюта",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Страна контрагента",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Канал",
+                        "name": "channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода по времени операции (YYYY-MM-DD или RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода по времени операции, не включительно (YYYY-MM-DD включает весь день)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Поле сортировки (created_at, timestamp, amount, risk_score)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Направление сортировки (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Лимит результатов (максимум 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница транзакций",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Принимает транзакцию и отправляет её на анализ рисков через REST API. Транзакция сохраняется в БД и отправляется в Kafka для асинхронной обработки fraud-сервисом.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Отправить транзакцию на анализ (REST)",
+                "parameters": [
+                    {
+                        "description": "Данные транзакции",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Транзакция принята на обработку",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - некорректный JSON или ошибки проверки по полям (fields)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаляет все транзакции вместе с кейсами, алертами, решениями, анализами и отчетами по ним. Реестр клиентов и счетов и черный список сохраняются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Очистить все транзакции",
+                "responses": {
+                    "200": {
+                        "description": "Транзакции очищены",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/batch": {
+            "post": {
+                "description": "Принимает JSON-массив транзакций или NDJSON (одна транзакция на строку, пустые строки пропускаются); формат определяется по первому символу тела.\nКаждая запись проверяется отдельно по тем же правилам, что и в POST /transactions. Корректные записи сохраняются в БД и отправляются в Kafka частями;\nрезультат возвращается по каждой записи в исходном порядке: accepted, rejected (ошибка разбора или проверки, ошибки проверки - в fields по полям) или failed (ошибка сохранения или отправки)",
+                "consumes": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Отправить пакет транзакций на анализ",
+                "parameters": [
+                    {
+                        "description": "Транзакции (JSON-массив или NDJSON)",
+                        "name": "transactions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingRequest"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат по каждой записи",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BatchIngestionResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - пустой пакет или тело не является JSON-массивом или NDJSON",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large - больше 10000 записей",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/generate": {
+            "get": {
+                "description": "Генерирует случайную транзакцию для тестирования",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Сгенерировать случайную транзакцию",
+                "responses": {
+                    "200": {
+                        "description": "Сгенерированная транзакция",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/grpc": {
+            "post": {
+                "description": "Принимает транзакцию и отправляет её на анализ через gRPC-сервис. Транзакция автоматически сохраняется в БД, отправляется в Kafka, обрабатывается fraud-сервисом и возвращается с результатами анализа рисков (risk_score, risk_level, flags).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Отправить транзакцию через gRPC",
+                "parameters": [
+                    {
+                        "description": "Данные транзакции",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.ProcessingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Транзакция успешно обработана через gRPC",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request - неверный формат данных или ошибки проверки по полям (fields)",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - ошибка обработки",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable - gRPC клиент недоступен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/rescore": {
+            "post": {
+                "description": "Отбираются уже проанализированные операции по времени операции [from, to), текущему уровню риска и счету, начиная с последних (по умолчанию 100, максимум 1000).\nКаждая операция получает новую версию анализа; ошибки по отдельным операциям перечисляются в failed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analyses"
+                ],
+                "summary": "Повторно оценить операции по фильтру",
+                "parameters": [
+                    {
+                        "description": "Фильтр и автор повторной оценки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BatchRescoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Итог повторной оценки",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.BatchRescoreResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Анализатор недоступен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/watch": {
+            "get": {
+                "description": "Поток text/event-stream: событие на прием транзакции (received), завершение анализа (analyzed), создание алерта (alert_raised) и изменение решения (decision_changed).\nПоле id каждого события - resume token. После обрыва клиент переподключается с заголовком Last-Event-ID (EventSource делает это сам) или параметром resume_token и получает пропущенные события.\nБез токена передаются только события после подключения. Токен старше срока хранения ленты отклоняется с 410",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Подписка на изменения транзакций (SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Счет отправителя или контрагента",
+                        "name": "account_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Уровень риска (low, medium, high); события без анализа не передаются",
+                        "name": "risk_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальный риск-скор; события без анализа не передаются",
+                        "name": "min_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Типы событий через запятую (received, analyzed, alert_raised, decision_changed)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Токен последнего полученного события",
+                        "name": "resume_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Токен подписки из POST /transactions/watch/tokens, если учетные данные нельзя передать заголовком",
+                        "name": "stream_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Токен последнего полученного события; имеет приоритет над resume_token",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionUpdate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Resume token expired",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/watch/tokens": {
+            "post": {
+                "description": "Токен передается в параметре stream_token запроса /transactions/watch, так как EventSource не умеет передавать заголовки.\nТокен действует минуту и проверяется только при подключении; после обрыва клиент запрашивает новый. Выдается, только если аутентификация включена",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Токен подписки на изменения транзакций",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.StreamToken"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{processing_id}": {
+            "get": {
+                "description": "Возвращает детальную информацию о транзакции и её анализе рисков",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Получить статус транзакции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID обработки транзакции",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Статус транзакции",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionStatusResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{processing_id}/analyses": {
+            "get": {
+                "description": "Версии по возрастанию номера: первая - анализ при поступлении (source=ingestion), следующие - повторные оценки (source=rescore)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analyses"
+                ],
+                "summary": "Получить историю версий анализа операции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История версий",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{processing_id}/decision": {
+            "get": {
+                "description": "Возвращает текущее решение (pending, approved, held, released, blocked, reported) и историю изменений",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Получить решение по транзакции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID транзакции",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Решение и история",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.DecisionDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Допустимые переходы: pending → approved/held/blocked, held → released/blocked/reported, blocked → released/reported, approved/released → reported.\nКаждое изменение записывается в историю и публикуется в Kafka для core banking.\nБлокировка (blocked) и разблокировка (released) вступают в силу только после подтверждения вторым сотрудником с ролью approver или supervisor: возвращается 202 с pending_approval.\nИнициатор определяется по API-ключу или JWT (без аутентификации - по заголовку X-User-ID); actor, не совпадающий с ним, отклоняется с 403.\nБез пользователя запроса инициатором считается actor, но блокировку и разблокировку запросить нельзя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "decisions"
+                ],
+                "summary": "Изменить решение по транзакции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID транзакции",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Пользователь (только при AUTH_ENABLED=false)",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Новое решение",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.DecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Решение изменено",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDecision"
+                        }
+                    },
+                    "202": {
+                        "description": "Решение ожидает подтверждения",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDecision"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Переход недопустим",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{processing_id}/details": {
+            "get": {
+                "description": "Операция со всеми сохраненными полями (счет, контрагент, банк и страна контрагента, канал, пользователь, отделение, исходное время),\nтекущий результат и все версии анализа, решение, алерт, таймер приостановки, заключение аналитика и хронология событий по времени",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Получить полные данные об операции",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Полные данные об операции",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDetail"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{processing_id}/rescore": {
+            "post": {
+                "description": "Операция оценивается текущим набором правил по текущему состоянию профилей счета; профили при этом не изменяются.\nРезультат сохраняется новой версией анализа и становится текущим, предыдущие версии остаются в истории. В ответе - сравнение с предыдущей версией",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analyses"
+                ],
+                "summary": "Повторно оценить операцию",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Processing ID",
+                        "name": "processing_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Кто и зачем запросил повторную оценку",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.RescoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат повторной оценки",
+                        "schema": {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.RescoreResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Операция еще не проанализирована",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Анализатор недоступен",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "bank-aml-system_internal_models.Account": {
+            "type": "object",
+            "required": [
+                "account_number",
+                "customer_id"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "declared_turnover": {
+                    "description": "Заявленный месячный оборот",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "opened_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountActivity": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "sent": {
+                    "description": "Последние операции, где счет - отправитель",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.TransactionSummary"
+                    }
+                },
+                "received": {
+                    "description": "Последние операции, где счет - контрагент",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.TransactionSummary"
+                    }
+                },
+                "totals": {
+                    "description": "Итоги по всем операциям периода, а не только по показанным",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AccountActivityTotals"
+                        }
+                    ]
+                },
+                "velocity": {
+                    "description": "Нет, если Redis недоступен",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AccountVelocity"
+                        }
+                    ]
+                },
+                "list_entries": {
+                    "description": "Записи черного и санкционного списков по счету или имени владельца",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.BlacklistEntry"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountActivityTotals": {
+            "type": "object",
+            "properties": {
+                "by_currency": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.ActivityTotal"
+                    }
+                },
+                "by_country": {
+                    "description": "Страна контрагента",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.ActivityTotal"
+                    }
+                },
+                "by_channel": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.ActivityTotal"
+                    }
+                },
+                "by_period": {
+                    "description": "Дата, неделя (YYYY-Www) или месяц по местному времени операции",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.ActivityTotal"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountBaseline": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "amount_sum": {
+                    "type": "number"
+                },
+                "amount_sum_squares": {
+                    "type": "number"
+                },
+                "countries": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "channels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "hours": {
+                    "description": "Час суток (00-23) -> количество операций",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "counterparties": {
+                    "description": "Счет контрагента -> количество операций",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountFlowSummary": {
+            "type": "object",
+            "properties": {
+                "inflow": {
+                    "type": "number"
+                },
+                "outflow": {
+                    "type": "number"
+                },
+                "inflow_count": {
+                    "type": "integer"
+                },
+                "outflow_count": {
+                    "type": "integer"
+                },
+                "last_inflow_at": {
+                    "type": "string"
+                },
+                "first_inflow_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountProfile": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.Account"
+                },
+                "customer": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.Customer"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AccountVelocity": {
+            "type": "object",
+            "properties": {
+                "daily_count": {
+                    "description": "Операций за последние сутки",
+                    "type": "integer"
+                },
+                "flow_window": {
+                    "type": "string"
+                },
+                "flows": {
+                    "description": "Входящие и исходящие потоки за окно",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AccountFlowSummary"
+                        }
+                    ]
+                },
+                "fan_window": {
+                    "description": "Окно подсчета различных контрагентов",
+                    "type": "string"
+                },
+                "fan_in": {
+                    "description": "Различных отправителей за окно (оценка)",
+                    "type": "integer"
+                },
+                "fan_out": {
+                    "description": "Различных получателей за окно (оценка)",
+                    "type": "integer"
+                },
+                "baseline": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.AccountBaseline"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.ActivityTotal": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Значение разреза; пусто, если поле операции не заполнено",
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.Alert": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "case_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "type": "string"
+                },
+                "risk_level": {
+                    "type": "string"
+                },
+                "risk_score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.AnalysisVersion": {
+            "type": "object",
+            "properties": {
+                "analyzed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "evidence": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "risk_level": {
+                    "type": "string"
+                },
+                "risk_score": {
+                    "type": "integer"
+                },
+                "ruleset_version": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.ApprovalRejection": {
+            "type": "object",
+            "required": [
+                "comment"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BatchIngestionResult": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "accepted": {
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.BatchRecordResult"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BatchRecordResult": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "integer"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "processing_id": {
+                    "description": "Есть и у failed, если запись сохранена, но не отправлена в Kafka",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "fields": {
+                    "description": "Ошибки проверки по полям записи",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.FieldError"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BatchRescoreRequest": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "risk_level": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high"
+                    ]
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BatchRescoreResult": {
+            "type": "object",
+            "properties": {
+                "changed": {
+                    "description": "Число операций, у которых изменились оценка или флаги",
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.RescoreFailure"
+                    }
+                },
+                "rescored": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.RescoreResult"
+                    }
+                },
+                "selected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BlacklistEntry": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "added_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "list_type": {
+                    "type": "string"
+                },
+                "lookback_from": {
+                    "description": "Начало периода ретроспективной проверки",
+                    "type": "string"
+                },
+                "match_count": {
+                    "type": "integer"
+                },
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.BlacklistMatch"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "screened_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BlacklistEntryRequest": {
+            "type": "object",
+            "required": [
+                "list_type",
+                "reason"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "added_by": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "list_type": {
+                    "type": "string",
+                    "enum": [
+                        "blacklist",
+                        "sanctions"
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.BlacklistMatch": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "alert_id": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "case_id": {
+                    "type": "integer"
+                },
+                "counterparty_account": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "entry_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matched_on": {
+                    "type": "string"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transaction_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.Case": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "alert_count": {
+                    "type": "integer"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_risk_score": {
+                    "type": "integer"
+                },
+                "resolution": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.CaseAttachment": {
+            "type": "object",
+            "required": [
+                "name",
+                "reference"
+            ],
+            "properties": {
+                "added_by": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "case_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.CaseDetails": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.Alert"
+                    }
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.CaseAttachment"
+                    }
+                },
+                "case": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.Case"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.CaseNote"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.CaseNote": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "author": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "case_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.CaseUpdate": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "assigned_to": {
+                    "type": "string"
+                },
+                "resolution": {
+                    "type": "string",
+                    "enum": [
+                        "false_positive",
+                        "no_further_action",
+                        "sar_filed"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "assigned",
+                        "investigating",
+                        "escalated",
+                        "closed"
+                    ]
+                }
+            }
+        },
+        "bank-aml-system_internal_models.Customer": {
+            "type": "object",
+            "required": [
+                "customer_id",
+                "full_name",
+                "kyc_risk_rating",
+                "residency",
+                "segment"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "kyc_risk_rating": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high"
+                    ]
+                },
+                "residency": {
+                    "type": "string",
+                    "enum": [
+                        "resident",
+                        "non_resident"
+                    ]
+                },
+                "segment": {
+                    "type": "string",
+                    "enum": [
+                        "retail",
+                        "sme",
+                        "corporate"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.DecisionApproval": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "from_decision": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolved_by": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "to_decision": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.DecisionChange": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "approved_by": {
+                    "description": "Второй сотрудник, подтвердивший решение",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_decision": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_decision": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.DecisionDetails": {
+            "type": "object",
+            "properties": {
+                "decision": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDecision"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.DecisionChange"
+                    }
+                }
+            }
+        },
+        "bank-aml-system_internal_models.DecisionRequest": {
+            "type": "object",
+            "required": [
+                "decision",
+                "reason"
+            ],
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string",
+                    "enum": [
+                        "approved",
+                        "held",
+                        "released",
+                        "blocked",
+                        "reported"
+                    ]
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.Disposition": {
+            "type": "object",
+            "required": [
+                "disposition"
+            ],
+            "properties": {
+                "alert_id": {
+                    "type": "integer"
+                },
+                "analyst": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "disposition": {
+                    "type": "string",
+                    "enum": [
+                        "true_positive",
+                        "false_positive",
+                        "inconclusive"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.FlagPrecision": {
+            "type": "object",
+            "properties": {
+                "alert_to_sar": {
+                    "type": "number"
+                },
+                "alerts": {
+                    "description": "Транзакций с флагом, по которым создан алерт",
+                    "type": "integer"
+                },
+                "false_positives": {
+                    "type": "integer"
+                },
+                "flag": {
+                    "type": "string"
+                },
+                "hits": {
+                    "description": "Транзакций с этим флагом",
+                    "type": "integer"
+                },
+                "inconclusive": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "description": "TP / (TP + FP); nil, пока нет заключений",
+                    "type": "number"
+                },
+                "ruleset_version": {
+                    "type": "string"
+                },
+                "sars": {
+                    "description": "Из них в кейсах, закрытых с направлением SAR",
+                    "type": "integer"
+                },
+                "true_positives": {
+                    "description": "Подтверждено аналитиком",
+                    "type": "integer"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.PrecisionReport": {
+            "type": "object",
+            "properties": {
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.FlagPrecision"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.ProcessingRequest": {
+            "type": "object",
+            "required": [
+                "account_number",
+                "amount",
+                "currency",
+                "transaction_id",
+                "transaction_type"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "branch_id": {
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string",
+                    "enum": [
+                        "online",
+                        "mobile",
+                        "branch",
+                        "atm",
+                        "card"
+                    ]
+                },
+                "counterparty_account": {
+                    "type": "string"
+                },
+                "counterparty_bank": {
+                    "description": "Наименование банка контрагента",
+                    "type": "string"
+                },
+                "counterparty_bic": {
+                    "description": "BIC (SWIFT) банка контрагента",
+                    "type": "string"
+                },
+                "counterparty_country": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "Не задано - время приема",
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "transaction_type": {
+                    "type": "string",
+                    "enum": [
+                        "transfer",
+                        "international_transfer",
+                        "withdrawal",
+                        "deposit",
+                        "payment"
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.ProcessingResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.RescoreFailure": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "processing_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.RescoreRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "requested_by": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.RescoreResult": {
+            "type": "object",
+            "properties": {
+                "added_flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "current": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.AnalysisVersion"
+                },
+                "previous": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.AnalysisVersion"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "removed_flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score_delta": {
+                    "type": "integer"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.SARReport": {
+            "type": "object",
+            "properties": {
+                "case_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reference_number": {
+                    "description": "Номер сообщения в системе банка (entity_reference)",
+                    "type": "string"
+                },
+                "regulator_reference": {
+                    "description": "Номер, присвоенный порталом регулятора",
+                    "type": "string"
+                },
+                "report_code": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.SARReportRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "action": {
+                    "description": "Принятые банком меры",
+                    "type": "string"
+                },
+                "actor": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Обоснование подозрений для регулятора",
+                    "type": "string"
+                },
+                "report_code": {
+                    "type": "string",
+                    "enum": [
+                        "STR",
+                        "SAR"
+                    ]
+                }
+            }
+        },
+        "bank-aml-system_internal_models.SARReportUpdate": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "actor": {
+                    "description": "По умолчанию - пользователь запроса",
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "regulator_reference": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "submitted",
+                        "accepted",
+                        "rejected"
+                    ]
+                }
+            }
+        },
+        "bank-aml-system_internal_models.StreamToken": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.Transaction": {
             "type": "object",
             "required": [
                 "account_number",
@@ -296,12 +4225,24 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "channel": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "online",
+                        "mobile",
+                        "branch",
+                        "atm",
+                        "card"
+                    ]
                 },
                 "counterparty_account": {
                     "type": "string"
                 },
                 "counterparty_bank": {
+                    "description": "Наименование банка контрагента",
+                    "type": "string"
+                },
+                "counterparty_bic": {
+                    "description": "BIC (SWIFT) банка контрагента",
                     "type": "string"
                 },
                 "counterparty_country": {
@@ -311,33 +4252,176 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "timestamp": {
+                    "description": "Не задано - время приема",
                     "type": "string"
                 },
                 "transaction_id": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 64
                 },
                 "transaction_type": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "transfer",
+                        "international_transfer",
+                        "withdrawal",
+                        "deposit",
+                        "payment"
+                    ]
                 },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "bank-aml-system_internal_models.ProcessingResponse": {
+        "bank-aml-system_internal_models.TransactionDecision": {
             "type": "object",
             "properties": {
-                "message": {
+                "actor": {
+                    "type": "string"
+                },
+                "decision": {
+                    "type": "string"
+                },
+                "pending_approval": {
+                    "description": "Ожидающий подтверждения запрос на изменение решения",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.DecisionApproval"
+                        }
+                    ]
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.TransactionDetail": {
+            "type": "object",
+            "properties": {
+                "alert": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.Alert"
+                },
+                "analyses": {
+                    "description": "Все сохраненные версии анализа, начиная с первой",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.AnalysisVersion"
+                    }
+                },
+                "analysis": {
+                    "description": "Текущий результат анализа; нет, пока анализ не выполнен",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.AnalysisVersion"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decision": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.TransactionDecision"
+                },
+                "disposition": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.Disposition"
+                },
+                "hold": {
+                    "$ref": "#/definitions/bank-aml-system_internal_models.TransactionHold"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timeline": {
+                    "description": "События по операции в порядке времени",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.TransactionEvent"
+                    }
+                },
+                "transaction": {
+                    "description": "Операция в том виде, в каком она была принята",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/bank-aml-system_internal_models.Transaction"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.TransactionEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "details": {
+                    "description": "Причина или комментарий",
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.TransactionHold": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "expiry_action": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "processing_id": {
                     "type": "string"
                 },
+                "resolved_at": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 }
             }
         },
+        "bank-aml-system_internal_models.TransactionPage": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bank-aml-system_internal_models.TransactionSummary"
+                    }
+                }
+            }
+        },
         "bank-aml-system_internal_models.TransactionStatusResponse": {
             "type": "object",
             "properties": {
@@ -350,6 +4434,74 @@ const docTemplate = `{
                 "currency": {
                     "type": "string"
                 },
+                "evidence": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "processing_id": {
+                    "type": "string"
+                },
+                "risk_level": {
+                    "type": "string"
+                },
+                "risk_score": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.TransactionSummary": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "analysis_timestamp": {
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string"
+                },
+                "counterparty_account": {
+                    "type": "string"
+                },
+                "counterparty_country": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "evidence": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
                 "flags": {
                     "type": "array",
                     "items": {
@@ -368,12 +4520,94 @@ const docTemplate = `{
                 "status": {
                     "type": "string"
                 },
+                "timestamp": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "transaction_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "bank-aml-system_internal_models.TransactionUpdate": {
+            "type": "object",
+            "properties": {
+                "resume_token": {
+                    "description": "Передается при переподключении, чтобы продолжить ленту после этого события",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "received, analyzed, alert_raised или decision_changed",
+                    "type": "string"
+                },
+                "processing_id": {
+                    "type": "string"
+                },
                 "transaction_id": {
                     "type": "string"
+                },
+                "account_number": {
+                    "type": "string"
+                },
+                "counterparty_account": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "risk_score": {
+                    "type": "integer"
+                },
+                "risk_level": {
+                    "type": "string"
+                },
+                "decision": {
+                    "description": "Для decision_changed",
+                    "type": "string"
+                },
+                "alert_id": {
+                    "description": "Для alert_raised",
+                    "type": "integer"
+                },
+                "case_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
                 }
             }
         }
-    }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API-ключ из AUTH_API_KEYS",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT в виде \"Bearer <token>\"; роли - в claim AUTH_JWT_ROLES_CLAIM",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    },
+    "security": [
+        {
+            "ApiKeyAuth": []
+        },
+        {
+            "BearerAuth": []
+        }
+    ]
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
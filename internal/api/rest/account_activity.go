@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"net/http"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountActivityHandlers содержит обработчики активности счета
+type AccountActivityHandlers struct {
+	activityService services.AccountActivityService
+}
+
+// NewAccountActivityHandlers создает обработчики активности счета
+func NewAccountActivityHandlers(activityService services.AccountActivityService) *AccountActivityHandlers {
+	return &AccountActivityHandlers{activityService: activityService}
+}
+
+// RegisterRoutes регистрирует маршруты активности счета
+func (h *AccountActivityHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/accounts/:account_number/activity", h.GetAccountActivity)
+}
+
+// GetAccountActivity возвращает активность счета для расследования
+// @Summary Получить активность счета
+// @Description Последние операции, где счет - отправитель или контрагент, итоги по валютам, странам контрагентов, каналам и периодам,
+// @Description текущие счетчики Redis (операции за сутки, потоки, различные контрагенты, профиль) и записи черного и санкционного списков по счету или имени владельца.
+// @Description Итоги считаются по всем операциям периода; суммы в разных валютах не складываются
+// @Tags accounts
+// @Produce json
+// @Param account_number path string true "Номер счета"
+// @Param from query string false "Начало периода по времени операции (YYYY-MM-DD или RFC3339)"
+// @Param to query string false "Конец периода по времени операции, не включительно (YYYY-MM-DD включает весь день)"
+// @Param period query string false "Группировка итогов по периодам (day, week, month)" default(day)
+// @Param limit query int false "Число последних операций в каждом направлении (максимум 500)" default(100)
+// @Success 200 {object} models.AccountActivity "Активность счета"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /accounts/{account_number}/activity [get]
+func (h *AccountActivityHandlers) GetAccountActivity(c *gin.Context) {
+	req := &models.AccountActivityRequest{
+		AccountNumber: c.Param("account_number"),
+		Period:        c.Query("period"),
+		Limit:         parseListLimit(c),
+	}
+	if value := c.Query("from"); value != "" {
+		from, err := parseReportBound(value, false)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.From = &from
+	}
+	if value := c.Query("to"); value != "" {
+		to, err := parseReportBound(value, true)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.To = &to
+	}
+
+	activity, err := h.activityService.GetAccountActivity(req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get account activity")
+		return
+	}
+
+	c.JSON(http.StatusOK, activity)
+}
@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newAccountActivityTestRouter() (*gin.Engine, *servicemocks.MockAccountActivityService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockAccountActivityService)
+	router := gin.New()
+	NewAccountActivityHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+	return router, mockService
+}
+
+func TestAccountActivityHandlers_GetAccountActivity(t *testing.T) {
+	router, mockService := newAccountActivityTestRouter()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mockService.On("GetAccountActivity", &models.AccountActivityRequest{
+		AccountNumber: "ACC-1", From: &from, To: &to, Period: models.ActivityPeriodWeek, Limit: 20,
+	}).Return(&models.AccountActivity{
+		AccountNumber: "ACC-1",
+		Sent:          []*models.TransactionSummary{},
+		Received:      []*models.TransactionSummary{},
+		Totals: &models.AccountActivityTotals{
+			ByPeriod: []*models.ActivityTotal{{Direction: models.ActivityDirectionSent, Key: "2024-W02", Currency: "RUB", Count: 3, Amount: 4500}},
+		},
+		ListEntries: []*models.BlacklistEntry{},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/accounts/ACC-1/activity?from=2024-01-01&to=2024-01-31&period=week&limit=20", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"key":"2024-W02"`)
+	assert.NotContains(t, w.Body.String(), `"velocity"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestAccountActivityHandlers_GetAccountActivity_InvalidBound(t *testing.T) {
+	router, mockService := newAccountActivityTestRouter()
+
+	req := httptest.NewRequest("GET", "/api/v1/accounts/ACC-1/activity?from=yesterday", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetAccountActivity", mock.Anything)
+}
+
+func TestAccountActivityHandlers_GetAccountActivity_Errors(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		code int
+	}{
+		"invalid period": {fmt.Errorf("%w: unknown period \"year\"", services.ErrInvalidInput), http.StatusBadRequest},
+		"storage":        {errors.New("database is locked"), http.StatusInternalServerError},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			router, mockService := newAccountActivityTestRouter()
+			mockService.On("GetAccountActivity", mock.Anything).Return(nil, tc.err)
+
+			req := httptest.NewRequest("GET", "/api/v1/accounts/ACC-1/activity?period=year", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
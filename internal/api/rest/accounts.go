@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"net/http"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountHandlers содержит обработчики реестра клиентов и счетов
+type AccountHandlers struct {
+	accountService services.AccountService
+}
+
+// NewAccountHandlers создает обработчики реестра клиентов и счетов
+func NewAccountHandlers(accountService services.AccountService) *AccountHandlers {
+	return &AccountHandlers{accountService: accountService}
+}
+
+// RegisterRoutes регистрирует маршруты реестра
+func (h *AccountHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.POST("/customers", h.SaveCustomer)
+	api.GET("/customers", h.ListCustomers)
+	api.GET("/customers/:customer_id", h.GetCustomer)
+	api.GET("/customers/:customer_id/accounts", h.ListAccounts)
+	api.POST("/accounts", h.SaveAccount)
+	api.GET("/accounts/:account_number", h.GetAccount)
+}
+
+// SaveCustomer регистрирует или обновляет клиента
+// @Summary Зарегистрировать клиента
+// @Description Создает клиента или обновляет данные существующего (сегмент, резидентство, рейтинг риска KYC)
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param customer body models.Customer true "Данные клиента"
+// @Success 201 {object} models.Customer "Клиент сохранен"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /customers [post]
+func (h *AccountHandlers) SaveCustomer(c *gin.Context) {
+	var customer models.Customer
+	if err := c.ShouldBindJSON(&customer); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	saved, err := h.accountService.SaveCustomer(&customer)
+	if err != nil {
+		respondServiceError(c, err, "Failed to save customer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// ListCustomers возвращает список клиентов
+// @Summary Получить список клиентов
+// @Description Возвращает зарегистрированных клиентов, начиная с последних
+// @Tags accounts
+// @Produce json
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список клиентов"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /customers [get]
+func (h *AccountHandlers) ListCustomers(c *gin.Context) {
+	customers, err := h.accountService.ListCustomers(parseListLimit(c))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get customers")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"customers": customers})
+}
+
+// GetCustomer возвращает клиента по customer_id
+// @Summary Получить клиента
+// @Tags accounts
+// @Produce json
+// @Param customer_id path string true "ID клиента"
+// @Success 200 {object} models.Customer "Клиент"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /customers/{customer_id} [get]
+func (h *AccountHandlers) GetCustomer(c *gin.Context) {
+	customer, err := h.accountService.GetCustomer(c.Param("customer_id"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get customer")
+		return
+	}
+	if customer == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Customer not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, customer)
+}
+
+// ListAccounts возвращает счета клиента
+// @Summary Получить счета клиента
+// @Tags accounts
+// @Produce json
+// @Param customer_id path string true "ID клиента"
+// @Success 200 {object} map[string]interface{} "Счета клиента"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /customers/{customer_id}/accounts [get]
+func (h *AccountHandlers) ListAccounts(c *gin.Context) {
+	accounts, err := h.accountService.ListAccounts(c.Param("customer_id"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get accounts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"accounts": accounts})
+}
+
+// SaveAccount регистрирует или обновляет счет
+// @Summary Зарегистрировать счет
+// @Description Создает счет клиента или обновляет существующий (дата открытия, заявленный месячный оборот)
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param account body models.Account true "Данные счета"
+// @Success 201 {object} models.Account "Счет сохранен"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 404 {object} map[string]string "Customer Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /accounts [post]
+func (h *AccountHandlers) SaveAccount(c *gin.Context) {
+	var account models.Account
+	if err := c.ShouldBindJSON(&account); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	saved, err := h.accountService.SaveAccount(&account)
+	if err != nil {
+		respondServiceError(c, err, "Failed to save account")
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// GetAccount возвращает счет вместе с данными владельца
+// @Summary Получить счет
+// @Tags accounts
+// @Produce json
+// @Param account_number path string true "Номер счета"
+// @Success 200 {object} models.AccountProfile "Счет и владелец"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /accounts/{account_number} [get]
+func (h *AccountHandlers) GetAccount(c *gin.Context) {
+	profile, err := h.accountService.GetAccount(c.Param("account_number"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get account")
+		return
+	}
+	if profile == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Account not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAccountTestRouter(handlers *AccountHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestAccountHandlers_SaveCustomer_Success(t *testing.T) {
+	mockService := new(servicemocks.MockAccountService)
+	router := setupAccountTestRouter(NewAccountHandlers(mockService))
+
+	customer := models.Customer{
+		CustomerID:    "CUST-1",
+		FullName:      "Иванов Иван",
+		Segment:       "retail",
+		Residency:     "resident",
+		KYCRiskRating: "medium",
+	}
+	mockService.On("SaveCustomer", mock.AnythingOfType("*models.Customer")).Return(&customer, nil)
+
+	body, _ := json.Marshal(customer)
+	req := httptest.NewRequest("POST", "/api/v1/customers", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAccountHandlers_SaveCustomer_InvalidKYCRating(t *testing.T) {
+	mockService := new(servicemocks.MockAccountService)
+	router := setupAccountTestRouter(NewAccountHandlers(mockService))
+
+	body := []byte(`{"customer_id":"CUST-1","full_name":"Иванов","segment":"retail","residency":"resident","kyc_risk_rating":"extreme"}`)
+	req := httptest.NewRequest("POST", "/api/v1/customers", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "SaveCustomer", mock.Anything)
+}
+
+func TestAccountHandlers_SaveAccount_UnknownCustomer(t *testing.T) {
+	mockService := new(servicemocks.MockAccountService)
+	router := setupAccountTestRouter(NewAccountHandlers(mockService))
+
+	mockService.On("SaveAccount", mock.AnythingOfType("*models.Account")).
+		Return(nil, fmt.Errorf("%w: customer CUST-404", services.ErrNotFound))
+
+	body := []byte(`{"account_number":"ACC123456","customer_id":"CUST-404","declared_turnover":100000}`)
+	req := httptest.NewRequest("POST", "/api/v1/accounts", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAccountHandlers_GetAccount_Success(t *testing.T) {
+	mockService := new(servicemocks.MockAccountService)
+	router := setupAccountTestRouter(NewAccountHandlers(mockService))
+
+	profile := &models.AccountProfile{
+		Account:  models.Account{AccountNumber: "ACC123456", CustomerID: "CUST-1"},
+		Customer: models.Customer{CustomerID: "CUST-1", KYCRiskRating: "high"},
+	}
+	mockService.On("GetAccount", "ACC123456").Return(profile, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/accounts/ACC123456", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var result models.AccountProfile
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, "high", result.Customer.KYCRiskRating)
+	mockService.AssertExpectations(t)
+}
+
+func TestAccountHandlers_GetAccount_NotFound(t *testing.T) {
+	mockService := new(servicemocks.MockAccountService)
+	router := setupAccountTestRouter(NewAccountHandlers(mockService))
+
+	mockService.On("GetAccount", "ACC000").Return(nil, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/accounts/ACC000", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
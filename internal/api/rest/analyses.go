@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"net/http"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnalysisHandlers содержит обработчики повторной оценки и истории версий анализа
+type AnalysisHandlers struct {
+	analysisService services.AnalysisService
+}
+
+// NewAnalysisHandlers создает обработчики повторной оценки и истории версий анализа
+func NewAnalysisHandlers(analysisService services.AnalysisService) *AnalysisHandlers {
+	return &AnalysisHandlers{analysisService: analysisService}
+}
+
+// RegisterRoutes регистрирует маршруты повторной оценки
+func (h *AnalysisHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.POST("/transactions/rescore", h.RescoreBatch)
+	api.POST("/transactions/:processing_id/rescore", h.Rescore)
+	api.GET("/transactions/:processing_id/analyses", h.ListVersions)
+}
+
+// Rescore повторно оценивает операцию
+// @Summary Повторно оценить операцию
+// @Description Операция оценивается текущим набором правил по текущему состоянию профилей счета; профили при этом не изменяются.
+// @Description Результат сохраняется новой версией анализа и становится текущим, предыдущие версии остаются в истории. В ответе - сравнение с предыдущей версией
+// @Tags analyses
+// @Accept json
+// @Produce json
+// @Param processing_id path string true "Processing ID"
+// @Param request body models.RescoreRequest true "Кто и зачем запросил повторную оценку"
+// @Success 200 {object} models.RescoreResult "Результат повторной оценки"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Операция еще не проанализирована"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Failure 503 {object} map[string]string "Анализатор недоступен"
+// @Router /transactions/{processing_id}/rescore [post]
+func (h *AnalysisHandlers) Rescore(c *gin.Context) {
+	var req models.RescoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "requested_by", &req.RequestedBy) {
+		return
+	}
+
+	result, err := h.analysisService.Rescore(c.Param("processing_id"), &req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to rescore transaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RescoreBatch повторно оценивает операции по фильтру
+// @Summary Повторно оценить операции по фильтру
+// @Description Отбираются уже проанализированные операции по времени операции [from, to), текущему уровню риска и счету, начиная с последних (по умолчанию 100, максимум 1000).
+// @Description Каждая операция получает новую версию анализа; ошибки по отдельным операциям перечисляются в failed
+// @Tags analyses
+// @Accept json
+// @Produce json
+// @Param request body models.BatchRescoreRequest true "Фильтр и автор повторной оценки"
+// @Success 200 {object} models.BatchRescoreResult "Итог повторной оценки"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Failure 503 {object} map[string]string "Анализатор недоступен"
+// @Router /transactions/rescore [post]
+func (h *AnalysisHandlers) RescoreBatch(c *gin.Context) {
+	var req models.BatchRescoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "requested_by", &req.RequestedBy) {
+		return
+	}
+
+	result, err := h.analysisService.RescoreBatch(&req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to rescore transactions")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListVersions возвращает историю версий анализа операции
+// @Summary Получить историю версий анализа операции
+// @Description Версии по возрастанию номера: первая - анализ при поступлении (source=ingestion), следующие - повторные оценки (source=rescore)
+// @Tags analyses
+// @Produce json
+// @Param processing_id path string true "Processing ID"
+// @Success 200 {object} map[string]interface{} "История версий"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/{processing_id}/analyses [get]
+func (h *AnalysisHandlers) ListVersions(c *gin.Context) {
+	processingID := c.Param("processing_id")
+	versions, err := h.analysisService.ListVersions(processingID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get analysis versions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"processing_id": processingID, "versions": versions})
+}
@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newAnalysisTestRouter() (*gin.Engine, *servicemocks.MockAnalysisService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockAnalysisService)
+	router := gin.New()
+	NewAnalysisHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+	return router, mockService
+}
+
+func TestAnalysisHandlers_Rescore(t *testing.T) {
+	router, mockService := newAnalysisTestRouter()
+
+	mockService.On("Rescore", "proc-1", &models.RescoreRequest{RequestedBy: "analyst-1", Reason: "rules update"}).
+		Return(&models.RescoreResult{
+			ProcessingID: "proc-1",
+			Current:      &models.AnalysisVersion{Version: 2, RiskScore: 70},
+			ScoreDelta:   30,
+		}, nil)
+
+	body := `{"requested_by":"analyst-1","reason":"rules update"}`
+	req := httptest.NewRequest("POST", "/api/v1/transactions/proc-1/rescore", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"score_delta":30`)
+	mockService.AssertExpectations(t)
+}
+
+func TestAnalysisHandlers_Rescore_AnalyzerUnavailable(t *testing.T) {
+	router, mockService := newAnalysisTestRouter()
+
+	mockService.On("Rescore", "proc-1", mock.Anything).
+		Return(nil, fmt.Errorf("%w: risk analyzer is not configured", services.ErrUnavailable))
+
+	req := httptest.NewRequest("POST", "/api/v1/transactions/proc-1/rescore", bytes.NewBufferString(`{"requested_by":"analyst-1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestAnalysisHandlers_RescoreBatch_RequiresRequestedBy(t *testing.T) {
+	router, mockService := newAnalysisTestRouter()
+
+	req := httptest.NewRequest("POST", "/api/v1/transactions/rescore", bytes.NewBufferString(`{"risk_level":"high"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "RescoreBatch", mock.Anything)
+}
+
+func TestAnalysisHandlers_ListVersions(t *testing.T) {
+	router, mockService := newAnalysisTestRouter()
+
+	mockService.On("ListVersions", "proc-1").Return([]*models.AnalysisVersion{
+		{ProcessingID: "proc-1", Version: 1, Source: models.AnalysisSourceIngestion},
+		{ProcessingID: "proc-1", Version: 2, Source: models.AnalysisSourceRescore},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/proc-1/analyses", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"source":"rescore"`)
+	mockService.AssertExpectations(t)
+}
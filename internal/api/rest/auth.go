@@ -0,0 +1,180 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"bank-aml-system/internal/auth"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+// HeaderAPIKey - заголовок с API-ключом; JWT передается в заголовке Authorization: Bearer <token>
+const HeaderAPIKey = "X-API-Key"
+
+// identityContextKey - ключ gin.Context, под которым хранится аутентифицированный пользователь
+const identityContextKey = "auth.identity"
+
+// Роли с доступом к маршрутам
+var (
+	ingestRoles     = []string{models.RoleIngest}
+	statusRoles     = []string{models.RoleIngest, models.RoleAnalyst}
+	analystRoles    = []string{models.RoleAnalyst}
+	approverRoles   = []string{models.RoleSupervisor, models.RoleApprover}
+	supervisorRoles = []string{models.RoleSupervisor}
+	adminRoles      = []string{models.RoleAdmin}
+)
+
+// publicRoutes - маршруты, доступные без аутентификации
+var publicRoutes = map[string]bool{
+	"GET /health":       true,
+	"GET /swagger/*any": true,
+}
+
+// routeRoles - роли, которым доступен маршрут (метод и шаблон пути gin); достаточно одной из них
+// Старшие роли включают младшие (admin -> supervisor -> analyst), поэтому указывается минимальная роль.
+// Маршрут, которого нет в таблице, доступен только администратору
+var routeRoles = map[string][]string{
+	// Прием транзакций
+	"POST /api/v1/transactions":                       ingestRoles,
+	"POST /api/v1/transactions/batch":                 ingestRoles,
+	"POST /api/v1/transactions/grpc":                  ingestRoles,
+	"GET /api/v1/transactions/generate":               ingestRoles,
+	"GET /api/v1/transactions/:processing_id":         statusRoles,
+	"DELETE /api/v1/transactions":                     adminRoles,
+	"GET /api/v1/transactions":                        analystRoles,
+	"GET /api/v1/transactions/watch":                  analystRoles,
+	"POST /api/v1/transactions/watch/tokens":          analystRoles,
+	"GET /api/v1/transactions/:processing_id/details": analystRoles,
+
+	// Повторный анализ
+	"POST /api/v1/transactions/:processing_id/rescore": analystRoles,
+	"GET /api/v1/transactions/:processing_id/analyses": analystRoles,
+	"POST /api/v1/transactions/rescore":                supervisorRoles,
+
+	// Решения по транзакциям
+	"GET /api/v1/transactions/:processing_id/decision":     analystRoles,
+	"POST /api/v1/transactions/:processing_id/decision":    analystRoles,
+	"GET /api/v1/decision-approvals":                       analystRoles,
+	"POST /api/v1/decision-approvals/:approval_id/approve": approverRoles,
+	"POST /api/v1/decision-approvals/:approval_id/reject":  approverRoles,
+
+	// Приостановленные операции
+	"GET /api/v1/holds": analystRoles,
+
+	// Реестр клиентов и счетов ведут системы-источники
+	"POST /api/v1/customers":                           ingestRoles,
+	"POST /api/v1/accounts":                            ingestRoles,
+	"GET /api/v1/customers":                            analystRoles,
+	"GET /api/v1/customers/:customer_id":               analystRoles,
+	"GET /api/v1/customers/:customer_id/accounts":      analystRoles,
+	"GET /api/v1/accounts/:account_number":             analystRoles,
+	"GET /api/v1/accounts/:account_number/activity":    analystRoles,
+	"GET /api/v1/accounts/:account_number/flow-cycles": analystRoles,
+	"GET /api/v1/flow-cycles":                          analystRoles,
+
+	// Алерты и кейсы
+	"GET /api/v1/alerts":                      analystRoles,
+	"GET /api/v1/cases":                       analystRoles,
+	"GET /api/v1/cases/:case_id":              analystRoles,
+	"PATCH /api/v1/cases/:case_id":            analystRoles,
+	"POST /api/v1/cases/:case_id/notes":       analystRoles,
+	"POST /api/v1/cases/:case_id/attachments": analystRoles,
+	"POST /api/v1/dispositions":               analystRoles,
+	"GET /api/v1/dispositions/:processing_id": analystRoles,
+	"GET /api/v1/reports/rule-precision":      analystRoles,
+
+	// Отчетность: черновики готовят аналитики, статус отправки меняет руководитель
+	"POST /api/v1/cases/:case_id/sar-reports": analystRoles,
+	"GET /api/v1/sar-reports":                 analystRoles,
+	"GET /api/v1/sar-reports/:report_id":      analystRoles,
+	"GET /api/v1/sar-reports/:report_id/xml":  analystRoles,
+	"PATCH /api/v1/sar-reports/:report_id":    supervisorRoles,
+	"GET /api/v1/mandatory-reports":           analystRoles,
+	"POST /api/v1/mandatory-reports/export":   supervisorRoles,
+
+	// Черный и санкционный списки
+	"GET /api/v1/blacklist":           analystRoles,
+	"GET /api/v1/blacklist/:entry_id": analystRoles,
+	"POST /api/v1/blacklist":          supervisorRoles,
+
+	// Журнал событий и статистика
+	"GET /api/v1/events": analystRoles,
+	"GET /api/v1/stats":  analystRoles,
+}
+
+// streamTokenRoutes - маршруты, которые принимают короткоживущий токен подписки в параметре stream_token
+// EventSource в браузере не умеет передавать заголовки. API-ключ и JWT в параметрах запроса не принимаются:
+// URL попадает в журналы прокси и историю браузера, а токен подписки истекает через минуту
+var streamTokenRoutes = map[string]bool{
+	"GET /api/v1/transactions/watch": true,
+}
+
+// Security содержит настройки защиты REST API
+type Security struct {
+	Authenticator  *auth.Authenticator // nil - аутентификация отключена, пользователь берется из заголовков шлюза
+	AllowedOrigins []string            // Источники, которым разрешены запросы из браузера
+	RateLimiter    *ratelimit.Limiter  // nil - частота запросов приема не ограничивается
+}
+
+// AuthMiddleware проверяет учетные данные запроса и роли, которым доступен маршрут
+// Запрос к несуществующему маршруту пропускается, чтобы вернуть 404
+func AuthMiddleware(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		if c.FullPath() == "" || publicRoutes[route] || c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		var identity models.Identity
+		var err error
+		authorization, apiKey := c.GetHeader("Authorization"), c.GetHeader(HeaderAPIKey)
+		if streamToken := c.Query("stream_token"); authorization == "" && apiKey == "" && streamToken != "" && streamTokenRoutes[route] {
+			identity, err = authenticator.AuthenticateStreamToken(streamToken)
+		} else {
+			identity, err = authenticator.Authenticate(authorization, apiKey)
+		}
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="bank-aml"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		roles, ok := routeRoles[route]
+		if !ok {
+			roles = adminRoles
+		}
+		if !auth.Authorize(identity, roles) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient role for " + route})
+			return
+		}
+
+		c.Set(identityContextKey, identity)
+		c.Next()
+	}
+}
+
+// authenticatedIdentity возвращает пользователя, проверенного AuthMiddleware
+func authenticatedIdentity(c *gin.Context) (models.Identity, bool) {
+	value, ok := c.Get(identityContextKey)
+	if !ok {
+		return models.Identity{}, false
+	}
+	identity, ok := value.(models.Identity)
+	return identity, ok
+}
+
+// forwardCredentials передает учетные данные REST-запроса в метаданные исходящего gRPC-вызова
+func forwardCredentials(ctx context.Context, c *gin.Context) context.Context {
+	if apiKey := c.GetHeader(HeaderAPIKey); apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", apiKey)
+	}
+	if authorization := c.GetHeader("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+	return ctx
+}
@@ -0,0 +1,225 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/auth"
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIngestKey  = "ingest-key-0123456789"
+	testAnalystKey = "analyst-key-0123456789"
+	testAdminKey   = "admin-key-0123456789"
+)
+
+func newTestAuthenticator(t *testing.T) *auth.Authenticator {
+	t.Helper()
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{
+		Enabled: true,
+		APIKeys: []string{
+			"core-banking:ingest:" + testIngestKey,
+			"alice:analyst:" + testAnalystKey,
+			"ops:admin:" + testAdminKey,
+		},
+	})
+	require.NoError(t, err)
+	return authenticator
+}
+
+func allRouteRegistrars() []RouteRegistrar {
+	return []RouteRegistrar{
+		NewAccountHandlers(nil), NewFlowCycleHandlers(nil), NewCaseHandlers(nil), NewDispositionHandlers(nil),
+		NewDecisionHandlers(nil), NewHoldHandlers(nil), NewSARReportHandlers(nil), NewMandatoryReportHandlers(nil),
+		NewBlacklistHandlers(nil), NewAnalysisHandlers(nil), NewTransactionDetailHandlers(nil),
+		NewAccountActivityHandlers(nil), NewTransactionWatchHandlers(nil),
+	}
+}
+
+func TestRouteRoles_CoverAllRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := SetupRouter(NewHandlers(nil, nil), Security{}, allRouteRegistrars()...)
+
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		_, restricted := routeRoles[key]
+		assert.True(t, restricted || publicRoutes[key], "no access policy for %s", key)
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockTransactionService)
+	mockService.On("ClearAllTransactions").Return(nil)
+	router := SetupRouter(NewHandlers(mockService, nil), Security{Authenticator: newTestAuthenticator(t)})
+
+	cases := map[string]struct {
+		method string
+		path   string
+		header string
+		value  string
+		code   int
+	}{
+		"public health":         {"GET", "/health", "", "", http.StatusOK},
+		"no credentials":        {"DELETE", "/api/v1/transactions", "", "", http.StatusUnauthorized},
+		"unknown key":           {"DELETE", "/api/v1/transactions", HeaderAPIKey, "unknown-key-0123456789", http.StatusUnauthorized},
+		"malformed bearer":      {"DELETE", "/api/v1/transactions", "Authorization", "Bearer not-a-token", http.StatusUnauthorized},
+		"ingest cannot clear":   {"DELETE", "/api/v1/transactions", HeaderAPIKey, testIngestKey, http.StatusForbidden},
+		"analyst cannot clear":  {"DELETE", "/api/v1/transactions", HeaderAPIKey, testAnalystKey, http.StatusForbidden},
+		"analyst cannot ingest": {"POST", "/api/v1/transactions", HeaderAPIKey, testAnalystKey, http.StatusForbidden},
+		"ingest cannot read":    {"GET", "/api/v1/stats", HeaderAPIKey, testIngestKey, http.StatusForbidden},
+		"analyst reads stats":   {"GET", "/api/v1/stats", HeaderAPIKey, testAnalystKey, http.StatusOK},
+		"admin clears":          {"DELETE", "/api/v1/transactions", HeaderAPIKey, testAdminKey, http.StatusOK},
+		"unknown route":         {"GET", "/api/v1/unknown", "", "", http.StatusNotFound},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.header != "" {
+				req.Header.Set(tc.header, tc.value)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestAuthMiddleware_StreamToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	watchService := new(servicemocks.MockTransactionWatchService)
+	watchService.On("OpenWatch", mock.Anything).Return(nil, assert.AnError)
+	router := SetupRouter(NewHandlers(nil, nil), Security{Authenticator: newTestAuthenticator(t)}, NewTransactionWatchHandlers(watchService))
+
+	// Токен подписки выдается по учетным данным в заголовке
+	req := httptest.NewRequest("POST", "/api/v1/transactions/watch/tokens", nil)
+	req.Header.Set(HeaderAPIKey, testAnalystKey)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	var issued models.StreamToken
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &issued))
+	require.NotEmpty(t, issued.Token)
+
+	// Подписка принимает токен в параметре запроса: EventSource не передает заголовки
+	req = httptest.NewRequest("GET", "/api/v1/transactions/watch?stream_token="+url.QueryEscape(issued.Token), nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	watchService.AssertNumberOfCalls(t, "OpenWatch", 1)
+
+	// API-ключ и JWT в параметрах запроса не принимаются
+	for _, query := range []string{"api_key=" + testAnalystKey, "access_token=" + testAnalystKey} {
+		req = httptest.NewRequest("GET", "/api/v1/transactions/watch?"+query, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, query)
+	}
+
+	// Остальные маршруты токен подписки не принимают
+	req = httptest.NewRequest("GET", "/api/v1/stats?stream_token="+url.QueryEscape(issued.Token), nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	watchService.AssertNumberOfCalls(t, "OpenWatch", 1)
+}
+
+func TestIssueWatchToken_RequiresAuthentication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := SetupRouter(NewHandlers(nil, nil), Security{Authenticator: newTestAuthenticator(t)})
+
+	req := httptest.NewRequest("POST", "/api/v1/transactions/watch/tokens", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// Ключ системы-источника не дает доступа к подписке
+	req = httptest.NewRequest("POST", "/api/v1/transactions/watch/tokens", nil)
+	req.Header.Set(HeaderAPIKey, testIngestKey)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRedactQuery(t *testing.T) {
+	cases := map[string]string{
+		"/api/v1/transactions/watch":                                   "/api/v1/transactions/watch",
+		"/api/v1/transactions/watch?stream_token=abc.def&min_score=70": "/api/v1/transactions/watch?stream_token=REDACTED&min_score=70",
+		"/api/v1/transactions/watch?types=analyzed&api_key=secret":     "/api/v1/transactions/watch?types=analyzed&api_key=REDACTED",
+		"/api/v1/stats?access%5Ftoken=secret":                          "/api/v1/stats?access_token=REDACTED",
+	}
+	for path, want := range cases {
+		assert.Equal(t, want, redactQuery(path), path)
+	}
+}
+
+func TestAuthMiddleware_IdentityOverridesGatewayHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(AuthMiddleware(newTestAuthenticator(t)))
+	// Маршрута нет в таблице ролей: доступен только администратору
+	router.GET("/whoami", func(c *gin.Context) {
+		c.JSON(http.StatusOK, requestIdentity(c))
+	})
+
+	req := httptest.NewRequest("GET", "/whoami", nil)
+	req.Header.Set(HeaderAPIKey, testAdminKey)
+	req.Header.Set(HeaderUserID, "mallory")
+	req.Header.Set(HeaderUserRoles, models.RoleApprover)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"user_id":"ops"`)
+
+	req = httptest.NewRequest("GET", "/whoami", nil)
+	req.Header.Set(HeaderAPIKey, testAnalystKey)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CORSMiddleware([]string{"http://localhost:3000"}))
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest("OPTIONS", "/ping", nil)
+	req.Header.Set("Origin", "http://localhost:3000")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "http://localhost:3000", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "X-API-Key")
+
+	req = httptest.NewRequest("GET", "/ping", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+
+	anyOrigin := gin.New()
+	anyOrigin.Use(CORSMiddleware([]string{"*"}))
+	anyOrigin.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w = httptest.NewRecorder()
+	anyOrigin.ServeHTTP(w, req)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+}
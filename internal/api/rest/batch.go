@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"bank-aml-system/internal/logger"
+	"bank-aml-system/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxBatchRecords - наибольшее число записей в одном пакете
+const maxBatchRecords = 10000
+
+// maxBatchLineSize - наибольшая длина строки NDJSON в байтах
+const maxBatchLineSize = 1 << 20
+
+// errBatchTooLarge - пакет содержит больше maxBatchRecords записей
+var errBatchTooLarge = fmt.Errorf("batch exceeds %d records", maxBatchRecords)
+
+// HandleTransactionBatch обрабатывает пакетную загрузку транзакций
+// @Summary Отправить пакет транзакций на анализ
+// @Description Принимает JSON-массив транзакций или NDJSON (одна транзакция на строку, пустые строки пропускаются); формат определяется по первому символу тела.
+// @Description Каждая запись проверяется отдельно по тем же правилам, что и в POST /transactions. Корректные записи сохраняются в БД и отправляются в Kafka частями;
+// @Description результат возвращается по каждой записи в исходном порядке: accepted, rejected (ошибка разбора или проверки, ошибки проверки - в fields по полям) или failed (ошибка сохранения или отправки)
+// @Tags transactions
+// @Accept json
+// @Accept x-ndjson
+// @Produce json
+// @Param transactions body []models.ProcessingRequest true "Транзакции (JSON-массив или NDJSON)"
+// @Success 200 {object} models.BatchIngestionResult "Результат по каждой записи"
+// @Failure 400 {object} map[string]string "Bad Request - пустой пакет или тело не является JSON-массивом или NDJSON"
+// @Failure 413 {object} map[string]string "Request Entity Too Large - больше 10000 записей"
+// @Failure 429 {object} map[string]interface{} "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/batch [post]
+func (h *Handlers) HandleTransactionBatch(c *gin.Context) {
+	records, err := parseBatch(c.Request.Body)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errBatchTooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	logger.LogEvent(logger.EventTransactionReceived, "ingestion-service", "api", map[string]interface{}{
+		"batch_total": len(records),
+	})
+
+	result, err := h.transactionService.ProcessTransactionBatch(records)
+	if err != nil {
+		respondServiceError(c, err, "Failed to process transaction batch")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// parseBatch разбирает тело пакета: JSON-массив, если оно начинается с '[', иначе NDJSON
+// Ошибка возвращается, только если тело нельзя разобрать целиком; ошибки отдельных записей попадают в записи
+func parseBatch(body io.Reader) ([]*models.BatchRecord, error) {
+	reader := bufio.NewReader(body)
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			return nil, errors.New("batch is empty")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+		if err := reader.UnreadByte(); err != nil {
+			return nil, err
+		}
+		if b == '[' {
+			return parseJSONArrayBatch(reader)
+		}
+		return parseNDJSONBatch(reader)
+	}
+}
+
+// parseJSONArrayBatch разбирает JSON-массив транзакций, не читая его в память целиком
+func parseJSONArrayBatch(reader io.Reader) ([]*models.BatchRecord, error) {
+	decoder := json.NewDecoder(reader)
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+
+	var records []*models.BatchRecord
+	for decoder.More() {
+		if len(records) == maxBatchRecords {
+			return nil, errBatchTooLarge
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON array at record %d: %w", len(records), err)
+		}
+		records = append(records, decodeBatchRecord(raw, len(records), 0))
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("batch is empty")
+	}
+	return records, nil
+}
+
+// parseNDJSONBatch разбирает NDJSON: каждая непустая строка - отдельная транзакция
+func parseNDJSONBatch(reader io.Reader) ([]*models.BatchRecord, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBatchLineSize)
+
+	var records []*models.BatchRecord
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		if len(records) == maxBatchRecords {
+			return nil, errBatchTooLarge
+		}
+		records = append(records, decodeBatchRecord(raw, len(records), line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid NDJSON at line %d: %w", line+1, err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("batch is empty")
+	}
+	return records, nil
+}
+
+// decodeBatchRecord разбирает одну запись; поля записи проверяет сервис транзакций
+func decodeBatchRecord(raw []byte, index, line int) *models.BatchRecord {
+	record := &models.BatchRecord{Index: index, Line: line}
+	var req models.ProcessingRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	record.Request = &req
+	return record
+}
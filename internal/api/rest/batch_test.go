@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const validBatchRecord = `{"transaction_id":"TXN-1","account_number":"ACC-1","amount":100,"currency":"RUB","transaction_type":"transfer"}`
+
+func TestHandlers_HandleTransactionBatch_JSONArray(t *testing.T) {
+	mockService := new(servicemocks.MockTransactionService)
+	router := setupTestRouter(NewHandlers(mockService, nil))
+
+	var received []*models.BatchRecord
+	mockService.On("ProcessTransactionBatch", mock.Anything).
+		Run(func(args mock.Arguments) { received = args.Get(0).([]*models.BatchRecord) }).
+		Return(&models.BatchIngestionResult{Total: 3, Accepted: 1, Rejected: 2}, nil)
+
+	body := `[` + validBatchRecord + `, {"transaction_id":"TXN-2","amount":-5}, 42]`
+	req := httptest.NewRequest("POST", "/api/v1/transactions/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"accepted":1`)
+	require.Len(t, received, 3)
+	assert.Empty(t, received[0].Error)
+	assert.Equal(t, "TXN-1", received[0].Request.TransactionID)
+	assert.Equal(t, "TXN-2", received[1].Request.TransactionID)
+	// Поля записи проверяет сервис: обработчик только разбирает JSON
+	assert.Empty(t, received[1].Error)
+	assert.Equal(t, -5.0, received[1].Request.Amount)
+	assert.Nil(t, received[2].Request)
+	assert.NotEmpty(t, received[2].Error)
+	assert.Equal(t, 2, received[2].Index)
+}
+
+func TestHandlers_HandleTransactionBatch_NDJSON(t *testing.T) {
+	mockService := new(servicemocks.MockTransactionService)
+	router := setupTestRouter(NewHandlers(mockService, nil))
+
+	var received []*models.BatchRecord
+	mockService.On("ProcessTransactionBatch", mock.Anything).
+		Run(func(args mock.Arguments) { received = args.Get(0).([]*models.BatchRecord) }).
+		Return(&models.BatchIngestionResult{Total: 2}, nil)
+
+	body := validBatchRecord + "\n\n{not json\n"
+	req := httptest.NewRequest("POST", "/api/v1/transactions/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, received, 2)
+	assert.Equal(t, 1, received[0].Line)
+	assert.Empty(t, received[0].Error)
+	assert.Equal(t, 1, received[1].Index)
+	assert.Equal(t, 3, received[1].Line)
+	assert.NotEmpty(t, received[1].Error)
+}
+
+func TestHandlers_HandleTransactionBatch_InvalidBody(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		code int
+	}{
+		"empty":           {"  \n", http.StatusBadRequest},
+		"empty array":     {"[]", http.StatusBadRequest},
+		"truncated array": {"[" + validBatchRecord + ",", http.StatusBadRequest},
+		"too large":       {strings.Repeat("{}\n", maxBatchRecords+1), http.StatusRequestEntityTooLarge},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockService := new(servicemocks.MockTransactionService)
+			router := setupTestRouter(NewHandlers(mockService, nil))
+
+			req := httptest.NewRequest("POST", "/api/v1/transactions/batch", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+			mockService.AssertNotCalled(t, "ProcessTransactionBatch", mock.Anything)
+		})
+	}
+}
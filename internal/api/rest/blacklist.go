@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BlacklistHandlers содержит обработчики черного и санкционного списков
+type BlacklistHandlers struct {
+	blacklistService services.BlacklistService
+}
+
+// NewBlacklistHandlers создает обработчики черного и санкционного списков
+func NewBlacklistHandlers(blacklistService services.BlacklistService) *BlacklistHandlers {
+	return &BlacklistHandlers{blacklistService: blacklistService}
+}
+
+// RegisterRoutes регистрирует маршруты списков
+func (h *BlacklistHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.POST("/blacklist", h.AddEntry)
+	api.GET("/blacklist", h.ListEntries)
+	api.GET("/blacklist/:entry_id", h.GetEntry)
+}
+
+// AddEntry добавляет запись в черный или санкционный список
+// @Summary Добавить запись в черный или санкционный список
+// @Description Счет из записи сразу учитывается при анализе новых операций. Операции за период ретроспективной проверки (BLACKLIST_RETRO_LOOKBACK),
+// @Description в которых счет участвует как счет клиента или контрагента, а также операции по счетам клиентов с совпавшим именем, попадают в совпадения записи; по ним создаются алерты, а если алерт по операции уже был, совпадение дописывается в него и закрытый кейс открывается повторно
+// @Tags blacklist
+// @Accept json
+// @Produce json
+// @Param entry body models.BlacklistEntryRequest true "Запись списка (нужен account_number или name)"
+// @Success 201 {object} models.BlacklistEntry "Запись добавлена и проверена"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /blacklist [post]
+func (h *BlacklistHandlers) AddEntry(c *gin.Context) {
+	var req models.BlacklistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "added_by", &req.AddedBy) {
+		return
+	}
+
+	entry, err := h.blacklistService.AddEntry(&req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to add blacklist entry")
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+// ListEntries возвращает записи списков
+// @Summary Получить записи черного и санкционного списков
+// @Description Записи начиная с последних; число совпадений ретроспективной проверки - в match_count
+// @Tags blacklist
+// @Produce json
+// @Param list_type query string false "Список (blacklist, sanctions)"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список записей"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /blacklist [get]
+func (h *BlacklistHandlers) ListEntries(c *gin.Context) {
+	entries, err := h.blacklistService.ListEntries(models.BlacklistFilter{
+		ListType: c.Query("list_type"),
+		Limit:    parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get blacklist entries")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"entries": entries})
+}
+
+// GetEntry возвращает запись списка с результатами ретроспективной проверки
+// @Summary Получить запись списка
+// @Tags blacklist
+// @Produce json
+// @Param entry_id path int true "ID записи"
+// @Success 200 {object} models.BlacklistEntry "Запись с совпадениями"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /blacklist/{entry_id} [get]
+func (h *BlacklistHandlers) GetEntry(c *gin.Context) {
+	entryID, err := strconv.ParseInt(c.Param("entry_id"), 10, 64)
+	if err != nil || entryID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid entry_id"})
+		return
+	}
+
+	entry, err := h.blacklistService.GetEntry(entryID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get blacklist entry")
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupBlacklistTestRouter(handlers *BlacklistHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestBlacklistHandlers_AddEntry(t *testing.T) {
+	mockService := new(servicemocks.MockBlacklistService)
+	router := setupBlacklistTestRouter(NewBlacklistHandlers(mockService))
+
+	mockService.On("AddEntry", mock.MatchedBy(func(req *models.BlacklistEntryRequest) bool {
+		return req.ListType == "blacklist" && req.AccountNumber == "BAD-1"
+	})).Return(&models.BlacklistEntry{ID: 1, ListType: "blacklist", AccountNumber: "BAD-1", MatchCount: 1,
+		Matches: []*models.BlacklistMatch{{ProcessingID: "proc-1", MatchedOn: "counterparty_account"}}}, nil)
+
+	body := `{"list_type":"blacklist","account_number":"BAD-1","reason":"fraud","added_by":"analyst-1"}`
+	req := httptest.NewRequest("POST", "/api/v1/blacklist", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), "proc-1")
+	mockService.AssertExpectations(t)
+}
+
+func TestBlacklistHandlers_AddEntry_InvalidListType(t *testing.T) {
+	mockService := new(servicemocks.MockBlacklistService)
+	router := setupBlacklistTestRouter(NewBlacklistHandlers(mockService))
+
+	body := `{"list_type":"pep","account_number":"BAD-1","reason":"fraud","added_by":"analyst-1"}`
+	req := httptest.NewRequest("POST", "/api/v1/blacklist", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "AddEntry", mock.Anything)
+}
+
+func TestBlacklistHandlers_GetEntry_InvalidID(t *testing.T) {
+	mockService := new(servicemocks.MockBlacklistService)
+	router := setupBlacklistTestRouter(NewBlacklistHandlers(mockService))
+
+	req := httptest.NewRequest("GET", "/api/v1/blacklist/abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,237 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CaseHandlers содержит обработчики алертов и кейсов
+type CaseHandlers struct {
+	caseService services.CaseService
+}
+
+// NewCaseHandlers создает обработчики алертов и кейсов
+func NewCaseHandlers(caseService services.CaseService) *CaseHandlers {
+	return &CaseHandlers{caseService: caseService}
+}
+
+// RegisterRoutes регистрирует маршруты алертов и кейсов
+func (h *CaseHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/alerts", h.ListAlerts)
+	api.GET("/cases", h.ListCases)
+	api.GET("/cases/:case_id", h.GetCase)
+	api.PATCH("/cases/:case_id", h.UpdateCase)
+	api.POST("/cases/:case_id/notes", h.AddNote)
+	api.POST("/cases/:case_id/attachments", h.AddAttachment)
+}
+
+// ListAlerts возвращает алерты
+// @Summary Получить алерты
+// @Description Возвращает алерты, созданные по результатам анализа (high / require_verification), начиная с последних
+// @Tags cases
+// @Produce json
+// @Param status query string false "Статус алерта (open, closed)"
+// @Param account_number query string false "Номер счета"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список алертов"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /alerts [get]
+func (h *CaseHandlers) ListAlerts(c *gin.Context) {
+	alerts, err := h.caseService.ListAlerts(models.AlertFilter{
+		Status:        c.Query("status"),
+		AccountNumber: c.Query("account_number"),
+		Limit:         parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get alerts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"alerts": alerts})
+}
+
+// ListCases возвращает кейсы
+// @Summary Получить кейсы
+// @Description Возвращает кейсы с фильтрацией по статусу, исполнителю и счету, начиная с последних обновленных
+// @Tags cases
+// @Produce json
+// @Param status query string false "Статус кейса (open, assigned, investigating, escalated, closed)"
+// @Param assigned_to query string false "Исполнитель"
+// @Param account_number query string false "Номер счета"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список кейсов"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases [get]
+func (h *CaseHandlers) ListCases(c *gin.Context) {
+	cases, err := h.caseService.ListCases(models.CaseFilter{
+		Status:        c.Query("status"),
+		AssignedTo:    c.Query("assigned_to"),
+		AccountNumber: c.Query("account_number"),
+		Limit:         parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get cases")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"cases": cases})
+}
+
+// GetCase возвращает кейс вместе с алертами, заметками и вложениями
+// @Summary Получить кейс
+// @Tags cases
+// @Produce json
+// @Param case_id path int true "ID кейса"
+// @Success 200 {object} models.CaseDetails "Кейс"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases/{case_id} [get]
+func (h *CaseHandlers) GetCase(c *gin.Context) {
+	caseID, ok := parseCaseID(c)
+	if !ok {
+		return
+	}
+
+	details, err := h.caseService.GetCase(caseID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get case")
+		return
+	}
+	if details == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Case not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, details)
+}
+
+// UpdateCase меняет статус, исполнителя или решение по кейсу
+// @Summary Обновить кейс
+// @Description Назначает аналитика и переводит кейс по статусам open → assigned → investigating → escalated → closed. Для закрытия нужно решение
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param case_id path int true "ID кейса"
+// @Param update body models.CaseUpdate true "Изменения"
+// @Success 200 {object} models.Case "Кейс обновлен"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Transition Not Allowed"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases/{case_id} [patch]
+func (h *CaseHandlers) UpdateCase(c *gin.Context) {
+	caseID, ok := parseCaseID(c)
+	if !ok {
+		return
+	}
+
+	var update models.CaseUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "actor", &update.Actor) {
+		return
+	}
+
+	updated, err := h.caseService.UpdateCase(caseID, &update)
+	if err != nil {
+		respondServiceError(c, err, "Failed to update case")
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// AddNote добавляет заметку к кейсу
+// @Summary Добавить заметку к кейсу
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param case_id path int true "ID кейса"
+// @Param note body models.CaseNote true "Заметка"
+// @Success 201 {object} models.CaseNote "Заметка добавлена"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases/{case_id}/notes [post]
+func (h *CaseHandlers) AddNote(c *gin.Context) {
+	caseID, ok := parseCaseID(c)
+	if !ok {
+		return
+	}
+
+	var note models.CaseNote
+	if err := c.ShouldBindJSON(&note); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "author", &note.Author) {
+		return
+	}
+
+	saved, err := h.caseService.AddNote(caseID, &note)
+	if err != nil {
+		respondServiceError(c, err, "Failed to add note")
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// AddAttachment добавляет ссылку на документ к кейсу
+// @Summary Приложить документ к кейсу
+// @Description Документ хранится во внешней системе, к кейсу прикладывается ссылка на него
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param case_id path int true "ID кейса"
+// @Param attachment body models.CaseAttachment true "Вложение"
+// @Success 201 {object} models.CaseAttachment "Вложение добавлено"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases/{case_id}/attachments [post]
+func (h *CaseHandlers) AddAttachment(c *gin.Context) {
+	caseID, ok := parseCaseID(c)
+	if !ok {
+		return
+	}
+
+	var attachment models.CaseAttachment
+	if err := c.ShouldBindJSON(&attachment); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "added_by", &attachment.AddedBy) {
+		return
+	}
+
+	saved, err := h.caseService.AddAttachment(caseID, &attachment)
+	if err != nil {
+		respondServiceError(c, err, "Failed to add attachment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// parseCaseID читает ID кейса из пути; при ошибке сразу отвечает 400
+func parseCaseID(c *gin.Context) (int64, bool) {
+	caseID, err := strconv.ParseInt(c.Param("case_id"), 10, 64)
+	if err != nil || caseID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid case_id"})
+		return 0, false
+	}
+	return caseID, true
+}
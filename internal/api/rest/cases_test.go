@@ -0,0 +1,135 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCaseTestRouter(handlers *CaseHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestCaseHandlers_ListCases_Filter(t *testing.T) {
+	mockService := new(servicemocks.MockCaseService)
+	router := setupCaseTestRouter(NewCaseHandlers(mockService))
+
+	filter := models.CaseFilter{Status: "assigned", AssignedTo: "analyst-1", Limit: 100}
+	mockService.On("ListCases", filter).Return([]*models.Case{{ID: 1, Status: "assigned"}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/cases?status=assigned&assigned_to=analyst-1", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCaseHandlers_UpdateCase_TransitionConflict(t *testing.T) {
+	mockService := new(servicemocks.MockCaseService)
+	router := setupCaseTestRouter(NewCaseHandlers(mockService))
+
+	mockService.On("UpdateCase", int64(5), mock.AnythingOfType("*models.CaseUpdate")).
+		Return(nil, fmt.Errorf("%w: case cannot move from open to escalated", services.ErrConflict))
+
+	body := []byte(`{"status":"escalated","actor":"analyst-1"}`)
+	req := httptest.NewRequest("PATCH", "/api/v1/cases/5", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCaseHandlers_UpdateCase_InvalidInput(t *testing.T) {
+	mockService := new(servicemocks.MockCaseService)
+	router := setupCaseTestRouter(NewCaseHandlers(mockService))
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"invalid case id", "/api/v1/cases/abc", `{"status":"closed","actor":"a"}`},
+		{"unknown status", "/api/v1/cases/5", `{"status":"archived","actor":"a"}`},
+		{"missing actor", "/api/v1/cases/5", `{"status":"investigating"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+	mockService.AssertNotCalled(t, "UpdateCase", mock.Anything, mock.Anything)
+}
+
+func TestCaseHandlers_GetCase_NotFound(t *testing.T) {
+	mockService := new(servicemocks.MockCaseService)
+	router := setupCaseTestRouter(NewCaseHandlers(mockService))
+
+	mockService.On("GetCase", int64(404)).Return(nil, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/cases/404", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCaseHandlers_AddNote_AuthorFromIdentity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockCaseService)
+	router := gin.New()
+	router.Use(AuthMiddleware(newTestAuthenticator(t)))
+	NewCaseHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+
+	mockService.On("AddNote", int64(5), &models.CaseNote{Author: "alice", Text: "выписка запрошена"}).
+		Return(&models.CaseNote{ID: 1, CaseID: 5, Author: "alice", Text: "выписка запрошена"}, nil)
+
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{"author taken from identity", `{"text":"выписка запрошена"}`, http.StatusCreated},
+		{"matching author", `{"author":"alice","text":"выписка запрошена"}`, http.StatusCreated},
+		{"author of another user", `{"author":"bob","text":"выписка запрошена"}`, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/cases/5/notes", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(HeaderAPIKey, testAnalystKey)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+	mockService.AssertNumberOfCalls(t, "AddNote", 2)
+}
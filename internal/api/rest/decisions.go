@@ -0,0 +1,203 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DecisionHandlers содержит обработчики решений по транзакциям
+type DecisionHandlers struct {
+	decisionService services.DecisionService
+}
+
+// NewDecisionHandlers создает обработчики решений по транзакциям
+func NewDecisionHandlers(decisionService services.DecisionService) *DecisionHandlers {
+	return &DecisionHandlers{decisionService: decisionService}
+}
+
+// RegisterRoutes регистрирует маршруты решений
+func (h *DecisionHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/transactions/:processing_id/decision", h.GetDecision)
+	api.POST("/transactions/:processing_id/decision", h.ChangeDecision)
+	api.GET("/decision-approvals", h.ListApprovals)
+	api.POST("/decision-approvals/:approval_id/approve", h.ApproveDecision)
+	api.POST("/decision-approvals/:approval_id/reject", h.RejectDecision)
+}
+
+// GetDecision возвращает решение по транзакции
+// @Summary Получить решение по транзакции
+// @Description Возвращает текущее решение (pending, approved, held, released, blocked, reported) и историю изменений
+// @Tags decisions
+// @Produce json
+// @Param processing_id path string true "Processing ID транзакции"
+// @Success 200 {object} models.DecisionDetails "Решение и история"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/{processing_id}/decision [get]
+func (h *DecisionHandlers) GetDecision(c *gin.Context) {
+	details, err := h.decisionService.GetDecision(c.Param("processing_id"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get decision")
+		return
+	}
+
+	c.JSON(http.StatusOK, details)
+}
+
+// ChangeDecision меняет решение по транзакции
+// @Summary Изменить решение по транзакции
+// @Description Допустимые переходы: pending → approved/held/blocked, held → released/blocked/reported, blocked → released/reported, approved/released → reported.
+// @Description Каждое изменение записывается в историю и публикуется в Kafka для core banking.
+// @Description Блокировка (blocked) и разблокировка (released) вступают в силу только после подтверждения вторым сотрудником с ролью approver или supervisor: возвращается 202 с pending_approval.
+// @Description Инициатор определяется по API-ключу или JWT (без аутентификации - по заголовку X-User-ID); actor, не совпадающий с ним, отклоняется с 403.
+// @Description Без пользователя запроса инициатором считается actor, но блокировку и разблокировку запросить нельзя
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param processing_id path string true "Processing ID транзакции"
+// @Param X-User-ID header string false "Пользователь (только при AUTH_ENABLED=false)"
+// @Param decision body models.DecisionRequest true "Новое решение"
+// @Success 200 {object} models.TransactionDecision "Решение изменено"
+// @Success 202 {object} models.TransactionDecision "Решение ожидает подтверждения"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Переход недопустим"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/{processing_id}/decision [post]
+func (h *DecisionHandlers) ChangeDecision(c *gin.Context) {
+	var req models.DecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	decision, err := h.decisionService.ChangeDecision(c.Param("processing_id"), &req, requestIdentity(c))
+	if err != nil {
+		respondServiceError(c, err, "Failed to change decision")
+		return
+	}
+
+	if decision.PendingApproval != nil {
+		c.JSON(http.StatusAccepted, decision)
+		return
+	}
+	c.JSON(http.StatusOK, decision)
+}
+
+// ListApprovals возвращает запросы на подтверждение решений
+// @Summary Получить запросы на подтверждение
+// @Description Запросы на блокировку и разблокировку, ожидающие второго сотрудника. Неподтвержденные в срок запросы получают статус expired
+// @Tags decisions
+// @Produce json
+// @Param status query string false "Статус запроса (pending, approved, rejected, expired)"
+// @Param processing_id query string false "Processing ID транзакции"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список запросов"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /decision-approvals [get]
+func (h *DecisionHandlers) ListApprovals(c *gin.Context) {
+	approvals, err := h.decisionService.ListApprovals(models.DecisionApprovalFilter{
+		Status:       c.Query("status"),
+		ProcessingID: c.Query("processing_id"),
+		Limit:        parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get approvals")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"approvals": approvals})
+}
+
+// ApproveDecision подтверждает запрос вторым сотрудником
+// @Summary Подтвердить решение
+// @Description Подтверждающий определяется по API-ключу или JWT (без аутентификации - по заголовкам X-User-ID и X-User-Roles); требуется роль approver или supervisor и отличие от инициатора
+// @Tags decisions
+// @Produce json
+// @Param approval_id path int true "ID запроса на подтверждение"
+// @Param X-User-ID header string false "Пользователь (только при AUTH_ENABLED=false)"
+// @Param X-User-Roles header string false "Роли пользователя через запятую (только при AUTH_ENABLED=false)"
+// @Success 200 {object} models.TransactionDecision "Решение применено"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Запрос уже обработан или просрочен"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /decision-approvals/{approval_id}/approve [post]
+func (h *DecisionHandlers) ApproveDecision(c *gin.Context) {
+	approvalID, ok := parseApprovalID(c)
+	if !ok {
+		return
+	}
+	identity, ok := requireIdentity(c)
+	if !ok {
+		return
+	}
+
+	decision, err := h.decisionService.ApproveDecision(approvalID, identity)
+	if err != nil {
+		respondServiceError(c, err, "Failed to approve decision")
+		return
+	}
+
+	c.JSON(http.StatusOK, decision)
+}
+
+// RejectDecision отклоняет запрос на подтверждение
+// @Summary Отклонить решение
+// @Description Отклонить может сотрудник с ролью approver или supervisor либо сам инициатор (отзыв запроса)
+// @Tags decisions
+// @Accept json
+// @Produce json
+// @Param approval_id path int true "ID запроса на подтверждение"
+// @Param X-User-ID header string false "Пользователь (только при AUTH_ENABLED=false)"
+// @Param X-User-Roles header string false "Роли пользователя через запятую (только при AUTH_ENABLED=false)"
+// @Param rejection body models.ApprovalRejection true "Причина отклонения"
+// @Success 200 {object} models.DecisionApproval "Запрос отклонен"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Запрос уже обработан или просрочен"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /decision-approvals/{approval_id}/reject [post]
+func (h *DecisionHandlers) RejectDecision(c *gin.Context) {
+	approvalID, ok := parseApprovalID(c)
+	if !ok {
+		return
+	}
+	identity, ok := requireIdentity(c)
+	if !ok {
+		return
+	}
+
+	var rejection models.ApprovalRejection
+	if err := c.ShouldBindJSON(&rejection); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	approval, err := h.decisionService.RejectDecision(approvalID, identity, rejection.Comment)
+	if err != nil {
+		respondServiceError(c, err, "Failed to reject decision")
+		return
+	}
+
+	c.JSON(http.StatusOK, approval)
+}
+
+func parseApprovalID(c *gin.Context) (int64, bool) {
+	approvalID, err := strconv.ParseInt(c.Param("approval_id"), 10, 64)
+	if err != nil || approvalID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid approval_id"})
+		return 0, false
+	}
+	return approvalID, true
+}
@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupDecisionTestRouter(handlers *DecisionHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestDecisionHandlers_GetDecision(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	mockService.On("GetDecision", "proc-1").Return(&models.DecisionDetails{
+		Decision: models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/proc-1/decision", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"decision":"held"`)
+}
+
+func TestDecisionHandlers_ChangeDecision_Conflict(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	mockService.On("ChangeDecision", "proc-1", &models.DecisionRequest{
+		Decision: "released",
+		Actor:    "analyst-1",
+		Reason:   "проверено",
+	}, models.Identity{}).Return(nil, fmt.Errorf("%w: decision cannot move from approved to released", services.ErrConflict))
+
+	body := []byte(`{"decision":"released","actor":"analyst-1","reason":"проверено"}`)
+	req := httptest.NewRequest("POST", "/api/v1/transactions/proc-1/decision", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDecisionHandlers_ChangeDecision_InvalidInput(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"pending is not settable", `{"decision":"pending","actor":"a","reason":"r"}`},
+		{"missing reason", `{"decision":"blocked","actor":"a"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/transactions/proc-1/decision", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+	mockService.AssertNotCalled(t, "ChangeDecision", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDecisionHandlers_ChangeDecision_PendingApproval(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	mockService.On("ChangeDecision", "proc-1", mock.AnythingOfType("*models.DecisionRequest"), models.Identity{UserID: "analyst-1"}).Return(&models.TransactionDecision{
+		ProcessingID:    "proc-1",
+		Decision:        models.DecisionHeld,
+		PendingApproval: &models.DecisionApproval{ID: 3, ToDecision: models.DecisionBlocked, Status: models.ApprovalStatusPending},
+	}, nil)
+
+	body := []byte(`{"decision":"blocked","reason":"санкционный список"}`)
+	req := httptest.NewRequest("POST", "/api/v1/transactions/proc-1/decision", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderUserID, "analyst-1")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), `"pending_approval"`)
+}
+
+func TestDecisionHandlers_ApproveDecision_Identity(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	approver := models.Identity{UserID: "supervisor-1", Roles: []string{"analyst", "approver"}}
+	mockService.On("ApproveDecision", int64(3), approver).
+		Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionBlocked}, nil)
+
+	req := httptest.NewRequest("POST", "/api/v1/decision-approvals/3/approve", nil)
+	req.Header.Set(HeaderUserID, "supervisor-1")
+	req.Header.Set(HeaderUserRoles, "analyst, approver")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDecisionHandlers_ApproveDecision_Errors(t *testing.T) {
+	mockService := new(servicemocks.MockDecisionService)
+	router := setupDecisionTestRouter(NewDecisionHandlers(mockService))
+
+	mockService.On("ApproveDecision", int64(3), models.Identity{UserID: "analyst-1"}).
+		Return(nil, fmt.Errorf("%w: role approver is required", services.ErrForbidden))
+
+	req := httptest.NewRequest("POST", "/api/v1/decision-approvals/3/approve", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = httptest.NewRequest("POST", "/api/v1/decision-approvals/3/approve", nil)
+	req.Header.Set(HeaderUserID, "analyst-1")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
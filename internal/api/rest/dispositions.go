@@ -0,0 +1,141 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultReportPeriod - период отчета, если границы не заданы
+const defaultReportPeriod = 30 * 24 * time.Hour
+
+// DispositionHandlers содержит обработчики заключений аналитиков и отчетов о точности правил
+type DispositionHandlers struct {
+	dispositionService services.DispositionService
+}
+
+// NewDispositionHandlers создает обработчики заключений аналитиков
+func NewDispositionHandlers(dispositionService services.DispositionService) *DispositionHandlers {
+	return &DispositionHandlers{dispositionService: dispositionService}
+}
+
+// RegisterRoutes регистрирует маршруты заключений и отчетов
+func (h *DispositionHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.POST("/dispositions", h.RecordDisposition)
+	api.GET("/dispositions/:processing_id", h.GetDisposition)
+	api.GET("/reports/rule-precision", h.GetPrecisionReport)
+}
+
+// RecordDisposition сохраняет заключение аналитика
+// @Summary Записать заключение аналитика
+// @Description Сохраняет заключение (true_positive, false_positive, inconclusive) по транзакции (processing_id) или алерту (alert_id). Повторное заключение заменяет предыдущее
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Param disposition body models.Disposition true "Заключение"
+// @Success 201 {object} models.Disposition "Заключение сохранено"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /dispositions [post]
+func (h *DispositionHandlers) RecordDisposition(c *gin.Context) {
+	var d models.Disposition
+	if err := c.ShouldBindJSON(&d); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "analyst", &d.Analyst) {
+		return
+	}
+
+	saved, err := h.dispositionService.RecordDisposition(&d)
+	if err != nil {
+		respondServiceError(c, err, "Failed to record disposition")
+		return
+	}
+
+	c.JSON(http.StatusCreated, saved)
+}
+
+// GetDisposition возвращает заключение аналитика по транзакции
+// @Summary Получить заключение по транзакции
+// @Tags feedback
+// @Produce json
+// @Param processing_id path string true "Processing ID транзакции"
+// @Success 200 {object} models.Disposition "Заключение"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /dispositions/{processing_id} [get]
+func (h *DispositionHandlers) GetDisposition(c *gin.Context) {
+	d, err := h.dispositionService.GetDisposition(c.Param("processing_id"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get disposition")
+		return
+	}
+
+	c.JSON(http.StatusOK, d)
+}
+
+// GetPrecisionReport возвращает отчет о точности флагов
+// @Summary Отчет о точности правил
+// @Description Для каждого флага и версии правил: число срабатываний, заключения аналитиков, precision = TP / (TP + FP) и конверсия алертов в SAR.
+// @Description Границы принимаются в формате YYYY-MM-DD (to включительно) или RFC3339 (to не включительно); по умолчанию последние 30 дней
+// @Tags feedback
+// @Produce json
+// @Param from query string false "Начало периода"
+// @Param to query string false "Конец периода"
+// @Success 200 {object} models.PrecisionReport "Отчет"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /reports/rule-precision [get]
+func (h *DispositionHandlers) GetPrecisionReport(c *gin.Context) {
+	to := time.Now()
+	if value := c.Query("to"); value != "" {
+		parsed, err := parseReportBound(value, true)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		to = parsed
+	}
+
+	from := to.Add(-defaultReportPeriod)
+	if value := c.Query("from"); value != "" {
+		parsed, err := parseReportBound(value, false)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		from = parsed
+	}
+
+	report, err := h.dispositionService.GetPrecisionReport(from, to)
+	if err != nil {
+		respondServiceError(c, err, "Failed to build precision report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// parseReportBound разбирает границу периода отчета
+// Дата без времени в качестве верхней границы включает весь день
+func parseReportBound(value string, upper bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or RFC3339", value)
+	}
+	if upper {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
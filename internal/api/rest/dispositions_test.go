@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupDispositionTestRouter(handlers *DispositionHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestDispositionHandlers_RecordDisposition(t *testing.T) {
+	mockService := new(servicemocks.MockDispositionService)
+	router := setupDispositionTestRouter(NewDispositionHandlers(mockService))
+
+	mockService.On("RecordDisposition", mock.MatchedBy(func(d *models.Disposition) bool {
+		return d.AlertID == 5 && d.Disposition == models.DispositionTruePositive && d.Analyst == "analyst-1"
+	})).Return(&models.Disposition{ID: 1, ProcessingID: "proc-1", AlertID: 5}, nil)
+
+	body := []byte(`{"alert_id":5,"disposition":"true_positive","analyst":"analyst-1"}`)
+	req := httptest.NewRequest("POST", "/api/v1/dispositions", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDispositionHandlers_RecordDisposition_InvalidInput(t *testing.T) {
+	mockService := new(servicemocks.MockDispositionService)
+	router := setupDispositionTestRouter(NewDispositionHandlers(mockService))
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"unknown disposition", `{"processing_id":"proc-1","disposition":"maybe","analyst":"a"}`},
+		{"missing analyst", `{"processing_id":"proc-1","disposition":"false_positive"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/dispositions", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+	mockService.AssertNotCalled(t, "RecordDisposition", mock.Anything)
+}
+
+func TestDispositionHandlers_GetDisposition_NotFound(t *testing.T) {
+	mockService := new(servicemocks.MockDispositionService)
+	router := setupDispositionTestRouter(NewDispositionHandlers(mockService))
+
+	mockService.On("GetDisposition", "proc-404").Return(nil, fmt.Errorf("%w: disposition", services.ErrNotFound))
+
+	req := httptest.NewRequest("GET", "/api/v1/dispositions/proc-404", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestDispositionHandlers_GetPrecisionReport_DateRange(t *testing.T) {
+	mockService := new(servicemocks.MockDispositionService)
+	router := setupDispositionTestRouter(NewDispositionHandlers(mockService))
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetPrecisionReport", from, to).Return(&models.PrecisionReport{From: from, To: to}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/reports/rule-precision?from=2024-01-01&to=2024-01-31", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDispositionHandlers_GetPrecisionReport_InvalidDate(t *testing.T) {
+	mockService := new(servicemocks.MockDispositionService)
+	router := setupDispositionTestRouter(NewDispositionHandlers(mockService))
+
+	req := httptest.NewRequest("GET", "/api/v1/reports/rule-precision?from=01.01.2024", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetPrecisionReport", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	"bank-aml-system/internal/validation"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RouteRegistrar регистрирует дополнительную группу маршрутов в /api/v1
+type RouteRegistrar interface {
+	RegisterRoutes(api *gin.RouterGroup)
+}
+
+// respondServiceError переводит ошибку сервисного слоя в HTTP-ответ
+// Для внутренних ошибок клиенту возвращается только общее сообщение
+func respondServiceError(c *gin.Context, err error, message string) {
+	if fields, ok := validation.FieldErrors(err); ok {
+		respondValidationError(c, fields)
+		return
+	}
+	switch {
+	case errors.Is(err, services.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrUnavailable):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrExpired):
+		c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+	}
+}
+
+// respondValidationError отвечает 400 со списком ошибок по полям запроса
+func respondValidationError(c *gin.Context, fields []models.FieldError) {
+	c.JSON(http.StatusBadRequest, gin.H{"error": "validation failed", "fields": fields})
+}
+
+// respondGRPCInvalidArgument отвечает 400, если gRPC-вызов отклонен как некорректный
+// Ошибки полей берутся из BadRequest в деталях статуса
+func respondGRPCInvalidArgument(c *gin.Context, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return false
+	}
+	var fields []models.FieldError
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, models.FieldError{Field: violation.GetField(), Message: violation.GetDescription()})
+			}
+		}
+	}
+	if len(fields) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		return true
+	}
+	respondValidationError(c, fields)
+	return true
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FlowCycleHandlers содержит обработчики для просмотра круговых потоков средств
+type FlowCycleHandlers struct {
+	flowCycleService services.FlowCycleService
+}
+
+// NewFlowCycleHandlers создает обработчики круговых потоков
+func NewFlowCycleHandlers(flowCycleService services.FlowCycleService) *FlowCycleHandlers {
+	return &FlowCycleHandlers{flowCycleService: flowCycleService}
+}
+
+// RegisterRoutes регистрирует маршруты круговых потоков
+func (h *FlowCycleHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/flow-cycles", h.ListFlowCycles)
+	api.GET("/accounts/:account_number/flow-cycles", h.ListAccountFlowCycles)
+}
+
+// ListFlowCycles возвращает последние обнаруженные круговые потоки
+// @Summary Получить круговые потоки
+// @Description Возвращает обнаруженные циклы A → B → ... → A с полным путем переводов, начиная с последних
+// @Tags investigations
+// @Produce json
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список циклов"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /flow-cycles [get]
+func (h *FlowCycleHandlers) ListFlowCycles(c *gin.Context) {
+	cycles, err := h.flowCycleService.ListFlowCycles(parseListLimit(c))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get flow cycles")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"flow_cycles": cycles})
+}
+
+// ListAccountFlowCycles возвращает круговые потоки, в которых участвовал счет
+// @Summary Получить круговые потоки счета
+// @Tags investigations
+// @Produce json
+// @Param account_number path string true "Номер счета"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список циклов"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /accounts/{account_number}/flow-cycles [get]
+func (h *FlowCycleHandlers) ListAccountFlowCycles(c *gin.Context) {
+	cycles, err := h.flowCycleService.ListAccountFlowCycles(c.Param("account_number"), parseListLimit(c))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get flow cycles")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"flow_cycles": cycles})
+}
+
+// parseListLimit читает параметр limit (по умолчанию 100, максимум 500)
+func parseListLimit(c *gin.Context) int {
+	limit := 100
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 && parsed <= 500 {
+			limit = parsed
+		}
+	}
+	return limit
+}
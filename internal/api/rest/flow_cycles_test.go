@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFlowCycleTestRouter(handlers *FlowCycleHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestFlowCycleHandlers_ListAccountFlowCycles(t *testing.T) {
+	mockService := new(servicemocks.MockFlowCycleService)
+	router := setupFlowCycleTestRouter(NewFlowCycleHandlers(mockService))
+
+	cycles := []*models.FlowCycle{{
+		ID:                   1,
+		ClosingTransactionID: "TXN-3",
+		Accounts:             []string{"A", "B", "C", "A"},
+		Length:               3,
+	}}
+	mockService.On("ListAccountFlowCycles", "B", 20).Return(cycles, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/accounts/B/flow-cycles?limit=20", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var result struct {
+		FlowCycles []models.FlowCycle `json:"flow_cycles"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Len(t, result.FlowCycles, 1)
+	assert.Equal(t, []string{"A", "B", "C", "A"}, result.FlowCycles[0].Accounts)
+	mockService.AssertExpectations(t)
+}
+
+func TestFlowCycleHandlers_ListFlowCycles_Error(t *testing.T) {
+	mockService := new(servicemocks.MockFlowCycleService)
+	router := setupFlowCycleTestRouter(NewFlowCycleHandlers(mockService))
+
+	mockService.On("ListFlowCycles", 100).Return(nil, fmt.Errorf("database is locked"))
+
+	req := httptest.NewRequest("GET", "/api/v1/flow-cycles", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockService.AssertExpectations(t)
+}
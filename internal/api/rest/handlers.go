@@ -2,6 +2,8 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"bank-aml-system/internal/generator"
 	"bank-aml-system/internal/logger"
 	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/ratelimit"
 	"bank-aml-system/internal/services"
 
 	"github.com/gin-gonic/gin"
@@ -38,13 +41,13 @@ func NewHandlers(transactionService services.TransactionService, grpcClient tran
 // @Produce json
 // @Param transaction body models.ProcessingRequest true "Данные транзакции"
 // @Success 201 {object} models.ProcessingResponse "Транзакция принята на обработку"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 400 {object} map[string]interface{} "Bad Request - некорректный JSON или ошибки проверки по полям (fields)"
+// @Failure 429 {object} map[string]interface{} "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After"
 // @Failure 500 {object} map[string]string "Internal Server Error"
 // @Router /transactions [post]
 func (h *Handlers) HandleTransaction(c *gin.Context) {
 	var req models.ProcessingRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !decodeTransactionRequest(c, &req) {
 		return
 	}
 
@@ -57,7 +60,7 @@ func (h *Handlers) HandleTransaction(c *gin.Context) {
 
 	response, err := h.transactionService.ProcessTransaction(&req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process transaction"})
+		respondServiceError(c, err, "Failed to process transaction")
 		return
 	}
 
@@ -79,7 +82,8 @@ func (h *Handlers) HandleTransaction(c *gin.Context) {
 // @Produce json
 // @Param transaction body models.ProcessingRequest true "Данные транзакции"
 // @Success 201 {object} map[string]interface{} "Транзакция успешно обработана через gRPC"
-// @Failure 400 {object} map[string]string "Bad Request - неверный формат данных"
+// @Failure 400 {object} map[string]interface{} "Bad Request - неверный формат данных или ошибки проверки по полям (fields)"
+// @Failure 429 {object} map[string]interface{} "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After"
 // @Failure 500 {object} map[string]string "Internal Server Error - ошибка обработки"
 // @Failure 503 {object} map[string]string "Service Unavailable - gRPC клиент недоступен"
 // @Router /transactions/grpc [post]
@@ -90,8 +94,7 @@ func (h *Handlers) HandleTransactionGRPC(c *gin.Context) {
 	}
 
 	var req models.ProcessingRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !decodeTransactionRequest(c, &req) {
 		return
 	}
 
@@ -117,6 +120,7 @@ func (h *Handlers) HandleTransactionGRPC(c *gin.Context) {
 		TransactionType:     req.TransactionType,
 		CounterpartyAccount: req.CounterpartyAccount,
 		CounterpartyBank:    req.CounterpartyBank,
+		CounterpartyBic:     req.CounterpartyBIC,
 		CounterpartyCountry: req.CounterpartyCountry,
 		Channel:             req.Channel,
 		UserId:              req.UserID,
@@ -127,8 +131,15 @@ func (h *Handlers) HandleTransactionGRPC(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	resp, err := h.grpcClient.AnalyzeTransaction(ctx, grpcReq)
+	resp, err := h.grpcClient.AnalyzeTransaction(forwardCredentials(ctx, c), grpcReq)
 	if err != nil {
+		if retryAfter, limited := grpcRateLimited(err); limited {
+			respondRateLimited(c, ratelimit.OperationTransactions, retryAfter)
+			return
+		}
+		if respondGRPCInvalidArgument(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process transaction via gRPC"})
 		return
 	}
@@ -146,36 +157,109 @@ func (h *Handlers) HandleTransactionGRPC(c *gin.Context) {
 		"risk_score":    resp.RiskScore,
 		"risk_level":    resp.RiskLevel,
 		"flags":         resp.Flags,
+		"evidence":      evidenceFromProto(resp.Evidence),
 		"analyzed_at":   resp.AnalyzedAt,
 		"message":       "Transaction accepted and analyzed via gRPC",
 	})
 }
 
-// GetAllTransactions возвращает список всех транзакций
-// @Summary Получить список транзакций
-// @Description Возвращает список всех транзакций с пагинацией
+// decodeTransactionRequest разбирает тело запроса с транзакцией без проверки полей
+// Поля проверяет сервис транзакций, чтобы REST, gRPC и пакетная загрузка возвращали одинаковые ошибки по полям
+func decodeTransactionRequest(c *gin.Context, req *models.ProcessingRequest) bool {
+	if err := json.NewDecoder(c.Request.Body).Decode(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON: " + err.Error()})
+		return false
+	}
+	return true
+}
+
+// GetAllTransactions возвращает страницу транзакций по фильтру
+// @Summary Поиск транзакций
+// @Description Возвращает транзакции, подходящие под все заданные условия. Порядок задается sort и order; при равных значениях записи упорядочены по внутреннему ID.
+// @Description Для следующей страницы передайте next_cursor из ответа в cursor с теми же условиями, sort и order; на последней странице next_cursor отсутствует
 // @Tags transactions
 // @Accept json
 // @Produce json
+// @Param account_number query string false "Счет клиента"
+// @Param counterparty query string false "Счет контрагента"
+// @Param risk_level query string false "Уровень риска (low, medium, high)"
+// @Param min_score query int false "Минимальная оценка риска"
+// @Param max_score query int false "Максимальная оценка риска"
+// @Param status query string false "Статус обработки (pending_review, reviewed)"
+// @Param currency query string false "Валюта"
+// @Param country query string false "Страна контрагента"
+// @Param channel query string false "Канал"
+// @Param from query string false "Начало периода по времени операции (YYYY-MM-DD или RFC3339)"
+// @Param to query string false "Конец периода по времени операции, не включительно (YYYY-MM-DD включает весь день)"
+// @Param sort query string false "Поле сортировки (created_at, timestamp, amount, risk_score)" default(created_at)
+// @Param order query string false "Направление сортировки (asc, desc)" default(desc)
+// @Param cursor query string false "Курсор следующей страницы"
 // @Param limit query int false "Лимит результатов (максимум 500)" default(100)
-// @Success 200 {object} map[string]interface{} "Список транзакций"
+// @Success 200 {object} models.TransactionPage "Страница транзакций"
+// @Failure 400 {object} map[string]string "Bad Request"
 // @Failure 500 {object} map[string]string "Internal Server Error"
 // @Router /transactions [get]
 func (h *Handlers) GetAllTransactions(c *gin.Context) {
-	limit := 100
-	if limitStr := c.Query("limit"); limitStr != "" {
-		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 && parsed <= 500 {
-			limit = parsed
+	req := &models.TransactionSearchRequest{
+		AccountNumber: c.Query("account_number"),
+		Counterparty:  c.Query("counterparty"),
+		RiskLevel:     c.Query("risk_level"),
+		Status:        c.Query("status"),
+		Currency:      c.Query("currency"),
+		Country:       c.Query("country"),
+		Channel:       c.Query("channel"),
+		SortBy:        c.Query("sort"),
+		Order:         c.Query("order"),
+		Cursor:        c.Query("cursor"),
+		Limit:         parseListLimit(c),
+	}
+
+	var err error
+	if req.MinScore, err = parseOptionalInt(c, "min_score"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.MaxScore, err = parseOptionalInt(c, "max_score"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if value := c.Query("from"); value != "" {
+		from, err := parseReportBound(value, false)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
+		req.From = &from
+	}
+	if value := c.Query("to"); value != "" {
+		to, err := parseReportBound(value, true)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.To = &to
 	}
 
-	transactions, err := h.transactionService.GetAllTransactions(limit)
+	page, err := h.transactionService.SearchTransactions(req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get transactions"})
+		respondServiceError(c, err, "Failed to get transactions")
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"transactions": transactions})
+	c.JSON(http.StatusOK, page)
+}
+
+// parseOptionalInt разбирает необязательный целочисленный параметр запроса
+func parseOptionalInt(c *gin.Context, name string) (*int, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: expected integer", name, value)
+	}
+	return &parsed, nil
 }
 
 // GetTransactionStatus возвращает статус транзакции по processing_id
@@ -208,7 +292,7 @@ func (h *Handlers) GetTransactionStatus(c *gin.Context) {
 
 // ClearAllTransactions очищает все транзакции
 // @Summary Очистить все транзакции
-// @Description Удаляет все транзакции из базы данных
+// @Description Удаляет все транзакции вместе с кейсами, алертами, решениями, анализами и отчетами по ним. Реестр клиентов и счетов и черный список сохраняются
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -238,6 +322,7 @@ func (h *Handlers) ClearAllTransactions(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Success 200 {object} map[string]interface{} "Сгенерированная транзакция"
+// @Failure 429 {object} map[string]interface{} "Too Many Requests - превышено ограничение частоты запросов клиента, см. Retry-After"
 // @Router /transactions/generate [get]
 func (h *Handlers) GenerateRandomTransaction(c *gin.Context) {
 	tx := h.generator.GenerateRandomTransaction()
@@ -256,3 +341,15 @@ func (h *Handlers) GenerateRandomTransaction(c *gin.Context) {
 		"branch_id":            tx.BranchID,
 	})
 }
+
+// evidenceFromProto преобразует доказательную базу флагов из ответа gRPC
+func evidenceFromProto(evidence map[string]*transaction.Evidence) map[string][]string {
+	if len(evidence) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(evidence))
+	for flag, values := range evidence {
+		result[flag] = values.GetValues()
+	}
+	return result
+}
@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
 	servicemocks "bank-aml-system/internal/services/mocks"
+	"bank-aml-system/internal/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +30,7 @@ func setupTestRouter(handlers *Handlers) *gin.Engine {
 	api := router.Group("/api/v1")
 	{
 		api.POST("/transactions", handlers.HandleTransaction)
+		api.POST("/transactions/batch", handlers.HandleTransactionBatch)
 		api.GET("/transactions", handlers.GetAllTransactions)
 		api.GET("/transactions/:processing_id", handlers.GetTransactionStatus)
 		api.DELETE("/transactions", handlers.ClearAllTransactions)
@@ -101,6 +106,38 @@ func TestHandlers_HandleTransaction_InvalidJSON(t *testing.T) {
 	mockService.AssertNotCalled(t, "ProcessTransaction")
 }
 
+func TestHandlers_HandleTransaction_FieldErrors(t *testing.T) {
+	mockService := new(servicemocks.MockTransactionService)
+	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
+	router := setupTestRouter(handlers)
+
+	fields := []models.FieldError{
+		{Field: "currency", Message: "must be an ISO 4217 currency code in uppercase, e.g. RUB"},
+		{Field: "counterparty_account", Message: "IBAN checksum is invalid"},
+	}
+	validationErr := fmt.Errorf("%w: %w", services.ErrInvalidInput, &validation.Error{Fields: fields})
+	mockService.On("ProcessTransaction", mock.AnythingOfType("*models.ProcessingRequest")).Return(nil, validationErr)
+
+	body := `{"transaction_id":"TXN-001","account_number":"ACC123456","amount":100,"currency":"rub","transaction_type":"transfer","counterparty_account":"DE89370400440532013001"}`
+	req := httptest.NewRequest("POST", "/api/v1/transactions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var result struct {
+		Error  string              `json:"error"`
+		Fields []models.FieldError `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, "validation failed", result.Error)
+	assert.Equal(t, fields, result.Fields)
+
+	mockService.AssertExpectations(t)
+}
+
 func TestHandlers_HandleTransaction_ServiceError(t *testing.T) {
 	mockService := new(servicemocks.MockTransactionService)
 	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
@@ -229,20 +266,23 @@ func TestHandlers_GetAllTransactions_Success(t *testing.T) {
 	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
 	router := setupTestRouter(handlers)
 
-	transactions := []*models.TransactionStatusResponse{
-		{
-			ProcessingID:  "proc_1",
-			TransactionID: "TXN-001",
-			Status:        "reviewed",
-		},
-		{
-			ProcessingID:  "proc_2",
-			TransactionID: "TXN-002",
-			Status:        "pending_review",
+	page := &models.TransactionPage{
+		Transactions: []*models.TransactionSummary{
+			{
+				ProcessingID:  "proc_1",
+				TransactionID: "TXN-001",
+				Status:        "reviewed",
+			},
+			{
+				ProcessingID:  "proc_2",
+				TransactionID: "TXN-002",
+				Status:        "pending_review",
+			},
 		},
+		NextCursor: "next",
 	}
 
-	mockService.On("GetAllTransactions", 100).Return(transactions, nil)
+	mockService.On("SearchTransactions", &models.TransactionSearchRequest{Limit: 100}).Return(page, nil)
 
 	req := httptest.NewRequest("GET", "/api/v1/transactions", nil)
 	w := httptest.NewRecorder()
@@ -255,20 +295,34 @@ func TestHandlers_GetAllTransactions_Success(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &result)
 	require.NoError(t, err)
 	assert.Contains(t, result, "transactions")
+	assert.Equal(t, "next", result["next_cursor"])
 
 	mockService.AssertExpectations(t)
 }
 
-func TestHandlers_GetAllTransactions_WithLimit(t *testing.T) {
+func TestHandlers_GetAllTransactions_WithFilters(t *testing.T) {
 	mockService := new(servicemocks.MockTransactionService)
 	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
 	router := setupTestRouter(handlers)
 
-	transactions := []*models.TransactionStatusResponse{}
-
-	mockService.On("GetAllTransactions", 50).Return(transactions, nil)
-
-	req := httptest.NewRequest("GET", "/api/v1/transactions?limit=50", nil)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	minScore := 40
+	mockService.On("SearchTransactions", &models.TransactionSearchRequest{
+		AccountNumber: "ACC-1",
+		RiskLevel:     "high",
+		MinScore:      &minScore,
+		Country:       "CY",
+		From:          &from,
+		To:            &to,
+		SortBy:        "amount",
+		Order:         "asc",
+		Cursor:        "abc",
+		Limit:         50,
+	}).Return(&models.TransactionPage{Transactions: []*models.TransactionSummary{}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions?account_number=ACC-1&risk_level=high&min_score=40&country=CY"+
+		"&from=2024-01-01&to=2024-01-31&sort=amount&order=asc&cursor=abc&limit=50", nil)
 	w := httptest.NewRecorder()
 
 	router.ServeHTTP(w, req)
@@ -277,12 +331,26 @@ func TestHandlers_GetAllTransactions_WithLimit(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestHandlers_GetAllTransactions_InvalidScore(t *testing.T) {
+	mockService := new(servicemocks.MockTransactionService)
+	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
+	router := setupTestRouter(handlers)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions?min_score=high", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "SearchTransactions", mock.Anything)
+}
+
 func TestHandlers_GetAllTransactions_ServiceError(t *testing.T) {
 	mockService := new(servicemocks.MockTransactionService)
 	handlers := NewHandlers(mockService, nil) // nil для grpcClient в тестах
 	router := setupTestRouter(handlers)
 
-	mockService.On("GetAllTransactions", 100).Return(nil, errors.New("database error"))
+	mockService.On("SearchTransactions", mock.Anything).Return(nil, errors.New("database error"))
 
 	req := httptest.NewRequest("GET", "/api/v1/transactions", nil)
 	w := httptest.NewRecorder()
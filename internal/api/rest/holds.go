@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"net/http"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HoldHandlers содержит обработчики сроков проверки приостановленных операций
+type HoldHandlers struct {
+	holdService services.HoldService
+}
+
+// NewHoldHandlers создает обработчики сроков проверки приостановленных операций
+func NewHoldHandlers(holdService services.HoldService) *HoldHandlers {
+	return &HoldHandlers{holdService: holdService}
+}
+
+// RegisterRoutes регистрирует маршруты сроков проверки
+func (h *HoldHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/holds", h.ListHolds)
+}
+
+// ListHolds возвращает сроки проверки приостановленных операций
+// @Summary Получить сроки проверки приостановленных операций
+// @Description Операции, приостановленные по рекомендации require_verification, ожидают решения аналитика до expires_at.
+// @Description По истечении срока операция разрешается (release) или ее кейс эскалируется (escalate) в зависимости от политики. Список упорядочен по сроку
+// @Tags decisions
+// @Produce json
+// @Param status query string false "Статус (active, resolved, released, escalated)"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список сроков"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /holds [get]
+func (h *HoldHandlers) ListHolds(c *gin.Context) {
+	holds, err := h.holdService.ListHolds(models.TransactionHoldFilter{
+		Status: c.Query("status"),
+		Limit:  parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get holds")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"holds": holds})
+}
@@ -0,0 +1,32 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoldHandlers_ListHolds(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockHoldService)
+	router := gin.New()
+	NewHoldHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+
+	mockService.On("ListHolds", models.TransactionHoldFilter{Status: "active", Limit: 100}).
+		Return([]*models.TransactionHold{{ID: 1, ProcessingID: "proc-1", Status: "active"}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/holds?status=active", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "proc-1")
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Заголовки с идентификатором пользователя и его ролями (через запятую), которые проставляет шлюз
+// Учитываются, только если аутентификация API отключена
+const (
+	HeaderUserID    = "X-User-ID"
+	HeaderUserRoles = "X-User-Roles"
+)
+
+// requestIdentity возвращает пользователя, от имени которого выполняется запрос
+// Пользователь, проверенный AuthMiddleware, имеет приоритет над заголовками шлюза
+func requestIdentity(c *gin.Context) models.Identity {
+	if identity, ok := authenticatedIdentity(c); ok {
+		return identity
+	}
+	identity := models.Identity{UserID: strings.TrimSpace(c.GetHeader(HeaderUserID))}
+	for _, role := range strings.Split(c.GetHeader(HeaderUserRoles), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			identity.Roles = append(identity.Roles, role)
+		}
+	}
+	return identity
+}
+
+// requireIdentity возвращает пользователя запроса или отвечает 401, если он не указан
+func requireIdentity(c *gin.Context) (models.Identity, bool) {
+	identity := requestIdentity(c)
+	if identity.UserID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": HeaderUserID + " header is required"})
+		return identity, false
+	}
+	return identity, true
+}
+
+// bindActor подставляет пользователя запроса в поле автора действия field (actor, author, analyst и т.п.)
+// Без пользователя запроса поле обязательно в теле; значение, не совпадающее с пользователем, отклоняется с 403,
+// иначе можно было бы действовать от чужого имени
+func bindActor(c *gin.Context, field string, actor *string) bool {
+	claimed := strings.TrimSpace(*actor)
+	identity := requestIdentity(c)
+	switch {
+	case identity.UserID == "" && claimed == "":
+		c.JSON(http.StatusBadRequest, gin.H{"error": field + " is required"})
+		return false
+	case identity.UserID == "":
+		return true
+	case claimed != "" && claimed != identity.UserID:
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("%s %q does not match the request user %q", field, claimed, identity.UserID)})
+		return false
+	}
+	*actor = identity.UserID
+	return true
+}
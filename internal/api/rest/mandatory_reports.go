@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/reporting"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MandatoryReportHandlers содержит обработчики очереди обязательного контроля операций
+type MandatoryReportHandlers struct {
+	reportService services.MandatoryReportService
+}
+
+// NewMandatoryReportHandlers создает обработчики очереди обязательного контроля
+func NewMandatoryReportHandlers(reportService services.MandatoryReportService) *MandatoryReportHandlers {
+	return &MandatoryReportHandlers{reportService: reportService}
+}
+
+// RegisterRoutes регистрирует маршруты очереди обязательного контроля
+func (h *MandatoryReportHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/mandatory-reports", h.ListReports)
+	api.POST("/mandatory-reports/export", h.ExportDaily)
+}
+
+// ListReports возвращает очередь обязательного контроля
+// @Summary Получить очередь обязательного контроля
+// @Description Операции с наличными на сумму не ниже порога (cash_threshold), операции клиента за день, в сумме превысившие порог (cash_daily_aggregate), и операции в валюте без заданного курса (cash_unconverted), независимо от оценки риска
+// @Tags reporting
+// @Produce json
+// @Param date query string false "Дата операций (YYYY-MM-DD, UTC)"
+// @Param status query string false "Статус (queued, exported)"
+// @Param rule query string false "Правило (cash_threshold, cash_daily_aggregate, cash_unconverted)"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Очередь"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /mandatory-reports [get]
+func (h *MandatoryReportHandlers) ListReports(c *gin.Context) {
+	reports, err := h.reportService.ListReports(models.MandatoryReportFilter{
+		BusinessDate: c.Query("date"),
+		Status:       c.Query("status"),
+		Rule:         c.Query("rule"),
+		Limit:        parseListLimit(c),
+	})
+	if err != nil {
+		respondServiceError(c, err, "Failed to get mandatory reports")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reports": reports})
+}
+
+// ExportDaily выгружает очередь обязательного контроля за день
+// @Summary Выгрузить операции за день
+// @Description Формирует файл со всеми операциями за день (по умолчанию - вчера, UTC) и отмечает ожидавшие выгрузки записи как выгруженные
+// @Tags reporting
+// @Produce text/csv
+// @Produce xml
+// @Param date query string false "Дата операций (YYYY-MM-DD, UTC)"
+// @Param format query string false "Формат (csv, xml)" default(csv)
+// @Success 200 {string} string "Файл выгрузки"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /mandatory-reports/export [post]
+func (h *MandatoryReportHandlers) ExportDaily(c *gin.Context) {
+	date := c.Query("date")
+	if date == "" {
+		date = time.Now().UTC().AddDate(0, 0, -1).Format(reporting.BusinessDateFormat)
+	}
+
+	export, err := h.reportService.ExportDaily(date, c.DefaultQuery("format", reporting.FormatCSV))
+	if err != nil {
+		respondServiceError(c, err, "Failed to export mandatory reports")
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+export.FileName+`"`)
+	c.Header("X-Report-Count", strconv.Itoa(export.Count))
+	c.Data(http.StatusOK, export.ContentType, export.Content)
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupMandatoryReportTestRouter(handlers *MandatoryReportHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestMandatoryReportHandlers_ListReports(t *testing.T) {
+	mockService := new(servicemocks.MockMandatoryReportService)
+	router := setupMandatoryReportTestRouter(NewMandatoryReportHandlers(mockService))
+
+	mockService.On("ListReports", models.MandatoryReportFilter{BusinessDate: "2024-01-15", Status: "queued", Limit: 100}).
+		Return([]*models.MandatoryReport{{ID: 1, ProcessingID: "proc-1"}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/mandatory-reports?date=2024-01-15&status=queued", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "proc-1")
+	mockService.AssertExpectations(t)
+}
+
+func TestMandatoryReportHandlers_ExportDaily(t *testing.T) {
+	mockService := new(servicemocks.MockMandatoryReportService)
+	router := setupMandatoryReportTestRouter(NewMandatoryReportHandlers(mockService))
+
+	mockService.On("ExportDaily", "2024-01-15", "xml").Return(&models.MandatoryReportExport{
+		FileName:    "cash-transactions-2024-01-15.xml",
+		ContentType: "application/xml; charset=utf-8",
+		Content:     []byte("<cash_transaction_report/>"),
+		Count:       3,
+	}, nil)
+
+	req := httptest.NewRequest("POST", "/api/v1/mandatory-reports/export?date=2024-01-15&format=xml", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<cash_transaction_report/>", w.Body.String())
+	assert.Equal(t, "3", w.Header().Get("X-Report-Count"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "cash-transactions-2024-01-15.xml")
+}
+
+func TestMandatoryReportHandlers_ExportDaily_InvalidFormat(t *testing.T) {
+	mockService := new(servicemocks.MockMandatoryReportService)
+	router := setupMandatoryReportTestRouter(NewMandatoryReportHandlers(mockService))
+
+	mockService.On("ExportDaily", mock.Anything, "pdf").
+		Return(nil, fmt.Errorf("%w: unsupported format \"pdf\"", services.ErrInvalidInput))
+
+	req := httptest.NewRequest("POST", "/api/v1/mandatory-reports/export?format=pdf", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// routeOperations - операции приема, к которым относятся маршруты, для ограничения частоты запросов
+// POST /transactions/grpc здесь не ограничивается: запрос передается в AnalyzeTransaction, где действует то же ограничение
+var routeOperations = map[string]string{
+	"POST /api/v1/transactions":         ratelimit.OperationTransactions,
+	"POST /api/v1/transactions/batch":   ratelimit.OperationBatch,
+	"GET /api/v1/transactions/generate": ratelimit.OperationGenerate,
+}
+
+// RateLimitMiddleware ограничивает частоту запросов клиента к маршрутам приема
+// Подключается после AuthMiddleware: клиент определяется по субъекту учетных данных, без аутентификации - по IP-адресу
+func RateLimitMiddleware(limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		operation, ok := routeOperations[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		decision := limiter.Allow(rateLimitClient(c), operation)
+		if !decision.Allowed {
+			respondRateLimited(c, operation, decision.RetryAfter)
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateLimitClient возвращает клиента, для которого ведется корзина токенов
+func rateLimitClient(c *gin.Context) string {
+	if identity, ok := authenticatedIdentity(c); ok {
+		return identity.UserID
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// respondRateLimited отвечает 429 с заголовком Retry-After в секундах
+func respondRateLimited(c *gin.Context, operation string, retryAfter time.Duration) {
+	seconds := ratelimit.RetryAfterSeconds(retryAfter)
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+		"error":       "rate limit exceeded for " + operation,
+		"retry_after": seconds,
+	})
+}
+
+// grpcRateLimited проверяет, отклонен ли gRPC-вызов ограничением частоты, и возвращает подсказку RetryInfo
+func grpcRateLimited(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, true
+}
@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/ratelimit"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.NewLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Limits:  map[string]config.RateLimit{ratelimit.OperationTransactions: {Rate: 0.001, Burst: 1}},
+	}, nil)
+	mockService := new(servicemocks.MockTransactionService)
+	router := SetupRouter(NewHandlers(mockService, nil), Security{Authenticator: newTestAuthenticator(t), RateLimiter: limiter})
+
+	post := func(key string) *httptest.ResponseRecorder {
+		// Некорректный JSON не проходит разбор: ограничение проверяется раньше обработчика
+		req := httptest.NewRequest("POST", "/api/v1/transactions", strings.NewReader("{"))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(HeaderAPIKey, key)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusBadRequest, post(testIngestKey).Code)
+
+	w := post(testIngestKey)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "rate limit exceeded for transactions")
+
+	// Корзина ведется для каждого клиента отдельно
+	assert.Equal(t, http.StatusBadRequest, post(testAdminKey).Code)
+
+	// Маршруты вне приема не ограничиваются
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest("GET", "/api/v1/stats", nil)
+		req.Header.Set(HeaderAPIKey, testAnalystKey)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestRateLimitMiddleware_ByClientIPWithoutAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := ratelimit.NewLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Limits:  map[string]config.RateLimit{ratelimit.OperationBatch: {Rate: 0.001, Burst: 1}},
+	}, nil)
+	router := SetupRouter(NewHandlers(nil, nil), Security{RateLimiter: limiter})
+
+	post := func(remoteAddr string) int {
+		req := httptest.NewRequest("POST", "/api/v1/transactions/batch", strings.NewReader(""))
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusBadRequest, post("10.0.0.1:5000"))
+	assert.Equal(t, http.StatusTooManyRequests, post("10.0.0.1:5001"))
+	assert.Equal(t, http.StatusBadRequest, post("10.0.0.2:5000"))
+}
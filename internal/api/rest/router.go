@@ -1,8 +1,12 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"bank-aml-system/internal/logger"
 
@@ -12,18 +16,35 @@ import (
 )
 
 // CORSMiddleware возвращает middleware для обработки CORS
-func CORSMiddleware() gin.HandlerFunc {
+// Заголовки CORS отдаются только источникам из allowedOrigins; "*" разрешает любой источник,
+// но без передачи учетных данных браузера
+func CORSMiddleware(allowedOrigins []string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.TrimRight(origin, "/")] = true
+	}
+
 	return func(c *gin.Context) {
 		origin := c.Request.Header.Get("Origin")
-		if origin == "" {
-			origin = "*"
+		c.Writer.Header().Add("Vary", "Origin")
+
+		switch {
+		case origin == "":
+		case allowed[origin]:
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		case allowed["*"]:
+			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+		default:
+			// Браузер не получит разрешения и заблокирует ответ
+			origin = ""
 		}
 
-		c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-		c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+		if origin != "" {
+			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-User-ID, X-User-Roles, X-API-Key, Last-Event-ID")
+			c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
+			c.Writer.Header().Set("Access-Control-Max-Age", "86400")
+		}
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -34,6 +55,56 @@ func CORSMiddleware() gin.HandlerFunc {
 	}
 }
 
+// redactedQueryParams - параметры запроса с учетными данными, значения которых не пишутся в журнал запросов
+var redactedQueryParams = map[string]bool{
+	"stream_token": true,
+	"api_key":      true,
+	"access_token": true,
+}
+
+// AccessLogger возвращает журнал запросов в формате gin.Logger, скрывающий учетные данные в параметрах запроса
+func AccessLogger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		var statusColor, methodColor, resetColor string
+		if param.IsOutputColor() {
+			statusColor = param.StatusCodeColor()
+			methodColor = param.MethodColor()
+			resetColor = param.ResetColor()
+		}
+		if param.Latency > time.Minute {
+			param.Latency = param.Latency.Truncate(time.Second)
+		}
+		return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			statusColor, param.StatusCode, resetColor,
+			param.Latency,
+			param.ClientIP,
+			methodColor, param.Method, resetColor,
+			redactQuery(param.Path),
+			param.ErrorMessage,
+		)
+	})
+}
+
+// redactQuery заменяет значения параметров с учетными данными в пути запроса, сохраняя порядок параметров
+func redactQuery(path string) string {
+	base, query, ok := strings.Cut(path, "?")
+	if !ok {
+		return path
+	}
+	params := strings.Split(query, "&")
+	for i, p := range params {
+		name, _, _ := strings.Cut(p, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if redactedQueryParams[name] {
+			params[i] = name + "=REDACTED"
+		}
+	}
+	return base + "?" + strings.Join(params, "&")
+}
+
 // SetupCommonEndpoints добавляет общие endpoints (health, events, stats) к роутеру
 func SetupCommonEndpoints(router *gin.Engine) {
 	// Health check
@@ -61,13 +132,24 @@ func SetupCommonEndpoints(router *gin.Engine) {
 }
 
 // SetupRouter настраивает маршруты REST API
-func SetupRouter(handlers *Handlers) *gin.Engine {
-	router := gin.Default()
+// Дополнительные группы обработчиков (реестр счетов и т.п.) передаются через extra
+func SetupRouter(handlers *Handlers, security Security, extra ...RouteRegistrar) *gin.Engine {
+	router := gin.New()
 
 	// CORS middleware
-	router.Use(CORSMiddleware())
+	router.Use(CORSMiddleware(security.AllowedOrigins))
+
+	router.Use(AccessLogger(), gin.Recovery())
 
-	router.Use(gin.Logger(), gin.Recovery())
+	// Аутентификация и проверка ролей по таблице маршрутов
+	if security.Authenticator != nil {
+		router.Use(AuthMiddleware(security.Authenticator))
+	}
+
+	// Ограничение частоты запросов приема для каждого клиента
+	if security.RateLimiter != nil {
+		router.Use(RateLimitMiddleware(security.RateLimiter))
+	}
 
 	// Swagger UI
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, ginSwagger.URL("/swagger/doc.json")))
@@ -76,12 +158,22 @@ func SetupRouter(handlers *Handlers) *gin.Engine {
 	api := router.Group("/api/v1")
 	{
 		api.POST("/transactions", handlers.HandleTransaction)
+		// Пакетная загрузка: JSON-массив или NDJSON
+		api.POST("/transactions/batch", handlers.HandleTransactionBatch)
 		// Отправка транзакции через gRPC (ingestion -> gRPC server)
 		api.POST("/transactions/grpc", handlers.HandleTransactionGRPC)
 		api.GET("/transactions", handlers.GetAllTransactions)
 		api.GET("/transactions/:processing_id", handlers.GetTransactionStatus)
 		api.DELETE("/transactions", handlers.ClearAllTransactions)
 		api.GET("/transactions/generate", handlers.GenerateRandomTransaction)
+		// Токены подписки нужны только при включенной аутентификации
+		if security.Authenticator != nil {
+			api.POST("/transactions/watch/tokens", IssueWatchToken(security.Authenticator))
+		}
+
+		for _, registrar := range extra {
+			registrar.RegisterRoutes(api)
+		}
 	}
 
 	// Общие endpoints (health, events, stats)
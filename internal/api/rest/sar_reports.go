@@ -0,0 +1,213 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"bank-aml-system/internal/goaml"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SARReportHandlers содержит обработчики сообщений goAML
+type SARReportHandlers struct {
+	sarService services.SARReportService
+}
+
+// NewSARReportHandlers создает обработчики сообщений goAML
+func NewSARReportHandlers(sarService services.SARReportService) *SARReportHandlers {
+	return &SARReportHandlers{sarService: sarService}
+}
+
+// RegisterRoutes регистрирует маршруты сообщений goAML
+func (h *SARReportHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.POST("/cases/:case_id/sar-reports", h.GenerateReport)
+	api.GET("/sar-reports", h.ListReports)
+	api.GET("/sar-reports/:report_id", h.GetReport)
+	api.GET("/sar-reports/:report_id/xml", h.DownloadReport)
+	api.PATCH("/sar-reports/:report_id", h.UpdateReport)
+}
+
+// GenerateReport формирует сообщение goAML по кейсу
+// @Summary Сформировать сообщение goAML по кейсу
+// @Description Собирает сообщение (организация, операции, стороны и счета) по операциям алертов кейса и проверяет его: предварительно по встроенной упрощенной схеме (не подтверждает соответствие официальной схеме goAML), затем по XSD регулятора из GOAML_XSD_PATH, если она задана.
+// @Description Кейс должен быть эскалирован или закрыт с решением sar_filed. Если сообщение не проходит проверку, возвращается 422 со списком несоответствий
+// @Tags sar
+// @Accept json
+// @Produce json
+// @Param case_id path int true "ID кейса"
+// @Param request body models.SARReportRequest true "Обоснование и принятые меры"
+// @Success 201 {object} models.SARReport "Сообщение сформировано"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Conflict"
+// @Failure 422 {object} map[string]interface{} "Сообщение не прошло предварительную проверку или проверку по схеме регулятора"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /cases/{case_id}/sar-reports [post]
+func (h *SARReportHandlers) GenerateReport(c *gin.Context) {
+	caseID, ok := parseCaseID(c)
+	if !ok {
+		return
+	}
+
+	var req models.SARReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "actor", &req.Actor) {
+		return
+	}
+
+	report, err := h.sarService.GenerateReport(caseID, &req)
+	if err != nil {
+		var validationErr *goaml.ValidationError
+		if errors.As(err, &validationErr) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": validationErr.Summary(), "problems": validationErr.Problems})
+			return
+		}
+		respondServiceError(c, err, "Failed to generate report")
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+// ListReports возвращает сообщения goAML
+// @Summary Получить сообщения goAML
+// @Description Возвращает сформированные сообщения с их статусом и номерами, начиная с последних
+// @Tags sar
+// @Produce json
+// @Param case_id query int false "ID кейса"
+// @Param status query string false "Статус (generated, submitted, accepted, rejected)"
+// @Param limit query int false "Лимит результатов (максимум 500)" default(100)
+// @Success 200 {object} map[string]interface{} "Список сообщений"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /sar-reports [get]
+func (h *SARReportHandlers) ListReports(c *gin.Context) {
+	filter := models.SARReportFilter{
+		Status: c.Query("status"),
+		Limit:  parseListLimit(c),
+	}
+	if value := c.Query("case_id"); value != "" {
+		caseID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || caseID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid case_id"})
+			return
+		}
+		filter.CaseID = caseID
+	}
+
+	reports, err := h.sarService.ListReports(filter)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get reports")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reports": reports})
+}
+
+// GetReport возвращает сообщение goAML
+// @Summary Получить сообщение goAML
+// @Tags sar
+// @Produce json
+// @Param report_id path int true "ID сообщения"
+// @Success 200 {object} models.SARReport "Сообщение"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /sar-reports/{report_id} [get]
+func (h *SARReportHandlers) GetReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.sarService.GetReport(reportID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// DownloadReport возвращает XML сообщения для загрузки на портал регулятора
+// @Summary Скачать XML сообщения goAML
+// @Tags sar
+// @Produce xml
+// @Param report_id path int true "ID сообщения"
+// @Success 200 {string} string "XML сообщения"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /sar-reports/{report_id}/xml [get]
+func (h *SARReportHandlers) DownloadReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.sarService.GetReport(reportID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to get report")
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+report.ReferenceNumber+`.xml"`)
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", report.XML)
+}
+
+// UpdateReport меняет статус сообщения после подачи регулятору
+// @Summary Обновить статус сообщения goAML
+// @Description Допустимые переходы: generated -> submitted -> accepted | rejected. Для accepted обязателен номер, присвоенный порталом регулятора
+// @Tags sar
+// @Accept json
+// @Produce json
+// @Param report_id path int true "ID сообщения"
+// @Param update body models.SARReportUpdate true "Новый статус"
+// @Success 200 {object} models.SARReport "Сообщение обновлено"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 409 {object} map[string]string "Conflict"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /sar-reports/{report_id} [patch]
+func (h *SARReportHandlers) UpdateReport(c *gin.Context) {
+	reportID, ok := parseReportID(c)
+	if !ok {
+		return
+	}
+
+	var update models.SARReportUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bindActor(c, "actor", &update.Actor) {
+		return
+	}
+
+	report, err := h.sarService.UpdateReport(reportID, &update)
+	if err != nil {
+		respondServiceError(c, err, "Failed to update report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// parseReportID читает ID сообщения из пути; при ошибке сразу отвечает 400
+func parseReportID(c *gin.Context) (int64, bool) {
+	reportID, err := strconv.ParseInt(c.Param("report_id"), 10, 64)
+	if err != nil || reportID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report_id"})
+		return 0, false
+	}
+	return reportID, true
+}
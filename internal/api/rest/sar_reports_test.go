@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"bank-aml-system/internal/goaml"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupSARReportTestRouter(handlers *SARReportHandlers) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers.RegisterRoutes(router.Group("/api/v1"))
+	return router
+}
+
+func TestSARReportHandlers_GenerateReport(t *testing.T) {
+	mockService := new(servicemocks.MockSARReportService)
+	router := setupSARReportTestRouter(NewSARReportHandlers(mockService))
+
+	mockService.On("GenerateReport", int64(7), mock.MatchedBy(func(req *models.SARReportRequest) bool {
+		return req.Reason == "transit" && req.Actor == "mlro"
+	})).Return(&models.SARReport{ID: 1, CaseID: 7, ReferenceNumber: "AML-000007-1"}, nil)
+
+	body := []byte(`{"reason":"transit","actor":"mlro"}`)
+	req := httptest.NewRequest("POST", "/api/v1/cases/7/sar-reports", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), "AML-000007-1")
+	mockService.AssertExpectations(t)
+}
+
+func TestSARReportHandlers_GenerateReport_ValidationFailed(t *testing.T) {
+	mockService := new(servicemocks.MockSARReportService)
+	router := setupSARReportTestRouter(NewSARReportHandlers(mockService))
+
+	validationErr := &goaml.ValidationError{Problems: []string{"/report/rentity_id: value \"0\" is not one of"}}
+	mockService.On("GenerateReport", int64(7), mock.Anything).
+		Return(nil, fmt.Errorf("%w: %w", services.ErrInvalidInput, validationErr))
+
+	body := []byte(`{"reason":"transit","actor":"mlro"}`)
+	req := httptest.NewRequest("POST", "/api/v1/cases/7/sar-reports", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "/report/rentity_id")
+}
+
+func TestSARReportHandlers_DownloadReport(t *testing.T) {
+	mockService := new(servicemocks.MockSARReportService)
+	router := setupSARReportTestRouter(NewSARReportHandlers(mockService))
+
+	mockService.On("GetReport", int64(3)).Return(&models.SARReport{
+		ID:              3,
+		ReferenceNumber: "AML-000007-1",
+		XML:             []byte("<report></report>"),
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/sar-reports/3/xml", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<report></report>", w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/xml")
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "AML-000007-1.xml")
+}
+
+func TestSARReportHandlers_UpdateReport_Conflict(t *testing.T) {
+	mockService := new(servicemocks.MockSARReportService)
+	router := setupSARReportTestRouter(NewSARReportHandlers(mockService))
+
+	mockService.On("UpdateReport", int64(3), mock.Anything).
+		Return(nil, fmt.Errorf("%w: report cannot move from generated to accepted", services.ErrConflict))
+
+	body := []byte(`{"status":"accepted","regulator_reference":"FIU-1","actor":"mlro"}`)
+	req := httptest.NewRequest("PATCH", "/api/v1/sar-reports/3", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestSARReportHandlers_ListReports_InvalidCaseID(t *testing.T) {
+	mockService := new(servicemocks.MockSARReportService)
+	router := setupSARReportTestRouter(NewSARReportHandlers(mockService))
+
+	req := httptest.NewRequest("GET", "/api/v1/sar-reports?case_id=abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ListReports", mock.Anything)
+}
@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"net/http"
+
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransactionDetailHandlers содержит обработчики полных данных об операции
+type TransactionDetailHandlers struct {
+	detailService services.TransactionDetailService
+}
+
+// NewTransactionDetailHandlers создает обработчики полных данных об операции
+func NewTransactionDetailHandlers(detailService services.TransactionDetailService) *TransactionDetailHandlers {
+	return &TransactionDetailHandlers{detailService: detailService}
+}
+
+// RegisterRoutes регистрирует маршруты полных данных об операции
+func (h *TransactionDetailHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/transactions/:processing_id/details", h.GetTransactionDetail)
+}
+
+// GetTransactionDetail возвращает полные данные об операции для расследования
+// @Summary Получить полные данные об операции
+// @Description Операция со всеми сохраненными полями (счет, контрагент, банк и страна контрагента, канал, пользователь, отделение, исходное время),
+// @Description текущий результат и все версии анализа, решение, алерт, таймер приостановки, заключение аналитика и хронология событий по времени
+// @Tags transactions
+// @Produce json
+// @Param processing_id path string true "Processing ID"
+// @Success 200 {object} models.TransactionDetail "Полные данные об операции"
+// @Failure 404 {object} map[string]string "Not Found"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/{processing_id}/details [get]
+func (h *TransactionDetailHandlers) GetTransactionDetail(c *gin.Context) {
+	detail, err := h.detailService.GetTransactionDetail(c.Param("processing_id"))
+	if err != nil {
+		respondServiceError(c, err, "Failed to get transaction details")
+		return
+	}
+
+	c.JSON(http.StatusOK, detail)
+}
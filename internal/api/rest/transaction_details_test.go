@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTransactionDetailTestRouter() (*gin.Engine, *servicemocks.MockTransactionDetailService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockTransactionDetailService)
+	router := gin.New()
+	NewTransactionDetailHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+	return router, mockService
+}
+
+func TestTransactionDetailHandlers_GetTransactionDetail(t *testing.T) {
+	router, mockService := newTransactionDetailTestRouter()
+
+	mockService.On("GetTransactionDetail", "proc-1").Return(&models.TransactionDetail{
+		ProcessingID: "proc-1",
+		Transaction: models.Transaction{
+			TransactionID:       "TXN-1",
+			AccountNumber:       "ACC-1",
+			CounterpartyAccount: "ACC-9",
+			CounterpartyBank:    "Offshore Bank",
+			CounterpartyCountry: "KY",
+			Channel:             "online",
+			UserID:              "user-1",
+			BranchID:            "branch-1",
+		},
+		Analyses: []*models.AnalysisVersion{},
+		Decision: &models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionPending},
+		Timeline: []*models.TransactionEvent{{Type: models.TransactionEventReceived, At: time.Now()}},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/proc-1/details", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"counterparty_bank":"Offshore Bank"`)
+	assert.Contains(t, w.Body.String(), `"branch_id":"branch-1"`)
+	assert.Contains(t, w.Body.String(), `"type":"received"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestTransactionDetailHandlers_GetTransactionDetail_NotFound(t *testing.T) {
+	router, mockService := newTransactionDetailTestRouter()
+
+	mockService.On("GetTransactionDetail", "missing").
+		Return(nil, fmt.Errorf("%w: transaction missing", services.ErrNotFound))
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/missing/details", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestTransactionDetailHandlers_GetTransactionDetail_ServiceError(t *testing.T) {
+	router, mockService := newTransactionDetailTestRouter()
+
+	mockService.On("GetTransactionDetail", "proc-1").Return(nil, errors.New("database is locked"))
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/proc-1/details", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "Failed to get transaction details")
+}
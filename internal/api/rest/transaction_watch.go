@@ -0,0 +1,173 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/auth"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// watchHeartbeatInterval - период комментариев-пингов, не дающих прокси закрыть простаивающее соединение
+const watchHeartbeatInterval = 15 * time.Second
+
+// TransactionWatchHandlers содержит обработчики подписки на изменения транзакций
+type TransactionWatchHandlers struct {
+	watchService services.TransactionWatchService
+}
+
+// NewTransactionWatchHandlers создает обработчики подписки на изменения транзакций
+func NewTransactionWatchHandlers(watchService services.TransactionWatchService) *TransactionWatchHandlers {
+	return &TransactionWatchHandlers{watchService: watchService}
+}
+
+// RegisterRoutes регистрирует маршруты подписки на изменения транзакций
+func (h *TransactionWatchHandlers) RegisterRoutes(api *gin.RouterGroup) {
+	api.GET("/transactions/watch", h.WatchTransactions)
+}
+
+// WatchTransactions передает изменения транзакций потоком Server-Sent Events
+// @Summary Подписка на изменения транзакций (SSE)
+// @Description Поток text/event-stream: событие на прием транзакции (received), завершение анализа (analyzed), создание алерта (alert_raised) и изменение решения (decision_changed).
+// @Description Поле id каждого события - resume token. После обрыва клиент переподключается с заголовком Last-Event-ID (EventSource делает это сам) или параметром resume_token и получает пропущенные события.
+// @Description Без токена передаются только события после подключения. Токен старше срока хранения ленты отклоняется с 410
+// @Tags transactions
+// @Produce text/event-stream
+// @Param account_number query string false "Счет отправителя или контрагента"
+// @Param risk_level query string false "Уровень риска (low, medium, high); события без анализа не передаются"
+// @Param min_score query int false "Минимальный риск-скор; события без анализа не передаются"
+// @Param types query string false "Типы событий через запятую (received, analyzed, alert_raised, decision_changed)"
+// @Param resume_token query string false "Токен последнего полученного события"
+// @Param stream_token query string false "Токен подписки из POST /transactions/watch/tokens, если учетные данные нельзя передать заголовком"
+// @Param Last-Event-ID header string false "Токен последнего полученного события; имеет приоритет над resume_token"
+// @Success 200 {object} models.TransactionUpdate "Поток событий"
+// @Failure 400 {object} map[string]string "Bad Request"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 410 {object} map[string]string "Resume token expired"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/watch [get]
+func (h *TransactionWatchHandlers) WatchTransactions(c *gin.Context) {
+	minScore, err := parseOptionalInt(c, "min_score")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req := &models.TransactionWatchRequest{
+		AccountNumber: c.Query("account_number"),
+		RiskLevel:     c.Query("risk_level"),
+		MinScore:      minScore,
+		Types:         parseWatchTypes(c.Query("types")),
+		ResumeToken:   c.Query("resume_token"),
+	}
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		req.ResumeToken = lastEventID
+	}
+
+	filter, err := h.watchService.OpenWatch(req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to open transaction watch")
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	// Лента читается в отдельной горутине, запись в ответ - только здесь, вместе с пингами
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	updates := make(chan *models.TransactionUpdate)
+	done := make(chan error, 1)
+	go func() {
+		done <- h.watchService.Watch(ctx, *filter, func(update *models.TransactionUpdate) error {
+			select {
+			case updates <- update:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case update := <-updates:
+			if err := writeWatchEvent(c.Writer, update); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		case err := <-done:
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("Transaction watch stopped: %v", err)
+			}
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// IssueWatchToken выдает короткоживущий токен для подписки из браузера
+// @Summary Токен подписки на изменения транзакций
+// @Description Токен передается в параметре stream_token запроса /transactions/watch, так как EventSource не умеет передавать заголовки.
+// @Description Токен действует минуту и проверяется только при подключении; после обрыва клиент запрашивает новый. Выдается, только если аутентификация включена
+// @Tags transactions
+// @Produce json
+// @Success 201 {object} models.StreamToken
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Internal Server Error"
+// @Router /transactions/watch/tokens [post]
+func IssueWatchToken(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, ok := authenticatedIdentity(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication is required"})
+			return
+		}
+
+		token, expiresAt, err := authenticator.IssueStreamToken(identity)
+		if err != nil {
+			log.Printf("Error issuing stream token: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue stream token"})
+			return
+		}
+		c.JSON(http.StatusCreated, models.StreamToken{Token: token, ExpiresAt: expiresAt})
+	}
+}
+
+// writeWatchEvent записывает событие ленты в формате Server-Sent Events
+func writeWatchEvent(w gin.ResponseWriter, update *models.TransactionUpdate) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", update.ResumeToken, update.Type, data)
+	return err
+}
+
+// parseWatchTypes разбирает список типов событий через запятую
+func parseWatchTypes(value string) []string {
+	var types []string
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	return types
+}
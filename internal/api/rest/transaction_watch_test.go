@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	servicemocks "bank-aml-system/internal/services/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTransactionWatchTestRouter() (*gin.Engine, *servicemocks.MockTransactionWatchService) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(servicemocks.MockTransactionWatchService)
+	router := gin.New()
+	NewTransactionWatchHandlers(mockService).RegisterRoutes(router.Group("/api/v1"))
+	return router, mockService
+}
+
+func TestTransactionWatchHandlers_WatchTransactions(t *testing.T) {
+	router, mockService := newTransactionWatchTestRouter()
+	minScore := 70
+	filter := &models.TransactionUpdateFilter{AfterID: 41, AccountNumber: "ACC-1", MinScore: &minScore, Limit: 500}
+	score, level := 85, "high"
+
+	// Last-Event-ID имеет приоритет над resume_token
+	mockService.On("OpenWatch", &models.TransactionWatchRequest{
+		AccountNumber: "ACC-1", MinScore: &minScore, Types: []string{"analyzed", "alert_raised"}, ResumeToken: "41",
+	}).Return(filter, nil)
+	mockService.On("Watch", mock.Anything, *filter, mock.Anything).Run(func(args mock.Arguments) {
+		send := args.Get(2).(func(*models.TransactionUpdate) error)
+		_ = send(&models.TransactionUpdate{ResumeToken: "42", Type: "analyzed", ProcessingID: "proc-1", RiskScore: &score, RiskLevel: &level})
+		_ = send(&models.TransactionUpdate{ResumeToken: "43", Type: "alert_raised", ProcessingID: "proc-1", AlertID: 7})
+	}).Return(nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/watch?account_number=ACC-1&min_score=70&types=analyzed,%20alert_raised&resume_token=7", nil)
+	req.Header.Set("Last-Event-ID", "41")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.Contains(t, body, "id: 42\nevent: analyzed\ndata: {")
+	assert.Contains(t, body, `"risk_score":85`)
+	assert.Contains(t, body, "id: 43\nevent: alert_raised\n")
+	assert.Less(t, strings.Index(body, "id: 42"), strings.Index(body, "id: 43"))
+	mockService.AssertExpectations(t)
+}
+
+func TestTransactionWatchHandlers_WatchTransactions_Errors(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		code int
+	}{
+		"invalid type":  {fmt.Errorf("%w: unknown event type \"x\"", services.ErrInvalidInput), http.StatusBadRequest},
+		"expired token": {fmt.Errorf("%w: events after resume token \"1\" were deleted", services.ErrExpired), http.StatusGone},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			router, mockService := newTransactionWatchTestRouter()
+			mockService.On("OpenWatch", mock.Anything).Return(nil, tc.err)
+
+			req := httptest.NewRequest("GET", "/api/v1/transactions/watch?resume_token=1", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.code, w.Code)
+			mockService.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTransactionWatchHandlers_WatchTransactions_InvalidMinScore(t *testing.T) {
+	router, mockService := newTransactionWatchTestRouter()
+
+	req := httptest.NewRequest("GET", "/api/v1/transactions/watch?min_score=high", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "OpenWatch", mock.Anything)
+}
@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// ErrUnauthenticated - учетные данные не переданы или не прошли проверку
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// minAPIKeyLength - минимальная длина API-ключа
+const minAPIKeyLength = 16
+
+// knownRoles - роли, которые можно назначить API-ключу
+var knownRoles = map[string]bool{
+	models.RoleIngest:     true,
+	models.RoleAnalyst:    true,
+	models.RoleApprover:   true,
+	models.RoleSupervisor: true,
+	models.RoleAdmin:      true,
+}
+
+// impliedRoles - роли, которые включает в себя старшая роль
+var impliedRoles = map[string][]string{
+	models.RoleAdmin:      {models.RoleSupervisor, models.RoleIngest},
+	models.RoleSupervisor: {models.RoleAnalyst, models.RoleApprover},
+}
+
+// Authenticator проверяет API-ключи и JWT по ключам из конфигурации
+type Authenticator struct {
+	apiKeys      map[[sha256.Size]byte]models.Identity // По хэшу ключа, чтобы не хранить ключи в открытом виде
+	jwtSecret    []byte
+	publicKey    *rsa.PublicKey
+	issuer       string
+	audience     string
+	rolesClaim   string
+	streamSecret []byte // Ключ подписи токенов подписки, см. IssueStreamToken
+	now          func() time.Time
+}
+
+// NewAuthenticator создает проверку учетных данных по конфигурации
+// Возвращает nil без ошибки, если аутентификация отключена
+func NewAuthenticator(cfg config.AuthConfig) (*Authenticator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	a := &Authenticator{
+		apiKeys:    make(map[[sha256.Size]byte]models.Identity),
+		issuer:     cfg.JWTIssuer,
+		audience:   cfg.JWTAudience,
+		rolesClaim: cfg.JWTRolesClaim,
+		now:        time.Now,
+	}
+	if a.rolesClaim == "" {
+		a.rolesClaim = "roles"
+	}
+	for _, entry := range cfg.APIKeys {
+		key, identity, err := parseAPIKey(entry)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256([]byte(key))
+		if _, exists := a.apiKeys[hash]; exists {
+			return nil, fmt.Errorf("duplicate API key for subject %q", identity.UserID)
+		}
+		a.apiKeys[hash] = identity
+	}
+	if cfg.JWTSecret != "" {
+		a.jwtSecret = []byte(cfg.JWTSecret)
+	}
+	if cfg.JWTPublicKeyFile != "" {
+		publicKey, err := loadRSAPublicKey(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		a.publicKey = publicKey
+	}
+
+	if len(a.apiKeys) == 0 && a.jwtSecret == nil && a.publicKey == nil {
+		return nil, errors.New("authentication is enabled but no API keys or JWT keys are configured (set AUTH_API_KEYS, AUTH_JWT_SECRET or AUTH_JWT_PUBLIC_KEY_FILE, or AUTH_ENABLED=false)")
+	}
+	streamSecret, err := newStreamSecret()
+	if err != nil {
+		return nil, err
+	}
+	a.streamSecret = streamSecret
+	return a, nil
+}
+
+// Authenticate проверяет учетные данные запроса: API-ключ или значение заголовка Authorization вида "Bearer <JWT>"
+// Если переданы оба, используется API-ключ
+func (a *Authenticator) Authenticate(authorization, apiKey string) (models.Identity, error) {
+	if apiKey != "" {
+		identity, ok := a.apiKeys[sha256.Sum256([]byte(apiKey))]
+		if !ok {
+			return models.Identity{}, fmt.Errorf("%w: invalid API key", ErrUnauthenticated)
+		}
+		return identity, nil
+	}
+
+	scheme, token, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return models.Identity{}, fmt.Errorf("%w: API key or bearer token is required", ErrUnauthenticated)
+	}
+	return a.verifyToken(strings.TrimSpace(token))
+}
+
+// Authorize проверяет, что у пользователя есть хотя бы одна из ролей
+func Authorize(identity models.Identity, roles []string) bool {
+	for _, role := range roles {
+		if identity.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAPIKey разбирает запись вида subject:role1|role2:key
+func parseAPIKey(entry string) (string, models.Identity, error) {
+	parts := strings.SplitN(entry, ":", 3)
+	if len(parts) != 3 || strings.TrimSpace(parts[0]) == "" {
+		return "", models.Identity{}, errors.New("invalid API key entry: expected subject:role1|role2:key")
+	}
+	subject := strings.TrimSpace(parts[0])
+	key := strings.TrimSpace(parts[2])
+	if len(key) < minAPIKeyLength {
+		return "", models.Identity{}, fmt.Errorf("API key for subject %q must be at least %d characters", subject, minAPIKeyLength)
+	}
+
+	var roles []string
+	for _, role := range strings.Split(parts[1], "|") {
+		role = strings.TrimSpace(role)
+		if role == "" {
+			continue
+		}
+		if !knownRoles[role] {
+			return "", models.Identity{}, fmt.Errorf("unknown role %q for API key subject %q", role, subject)
+		}
+		roles = append(roles, role)
+	}
+	if len(roles) == 0 {
+		return "", models.Identity{}, fmt.Errorf("API key for subject %q has no roles", subject)
+	}
+	return key, models.Identity{UserID: subject, Roles: expandRoles(roles)}, nil
+}
+
+// expandRoles дополняет роли ролями, которые они включают
+func expandRoles(roles []string) []string {
+	seen := make(map[string]bool)
+	var expanded []string
+	var add func(role string)
+	add = func(role string) {
+		if seen[role] {
+			return
+		}
+		seen[role] = true
+		expanded = append(expanded, role)
+		for _, implied := range impliedRoles[role] {
+			add(implied)
+		}
+	}
+	for _, role := range roles {
+		add(role)
+	}
+	return expanded
+}
+
+// loadRSAPublicKey читает открытый ключ RSA из PEM-файла (PUBLIC KEY или RSA PUBLIC KEY)
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT public key file %s is not PEM encoded", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+	}
+	publicKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("JWT public key in %s is not an RSA key", path)
+	}
+	return publicKey, nil
+}
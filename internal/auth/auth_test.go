@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestAuthenticator(t *testing.T, cfg config.AuthConfig) *Authenticator {
+	t.Helper()
+	cfg.Enabled = true
+	a, err := NewAuthenticator(cfg)
+	require.NoError(t, err)
+	a.now = func() time.Time { return testNow }
+	return a
+}
+
+func signHS(t *testing.T, alg string, secret []byte, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, map[string]any{"alg": alg, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(jwtHashes[alg].New, secret)
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS(t *testing.T, alg string, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	input := encodeSegment(t, map[string]any{"alg": alg, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	hash := jwtHashes[alg]
+	digest := hash.New()
+	digest.Write([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, hash, digest.Sum(nil))
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func writePublicKey(t *testing.T, key *rsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwt.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func validClaims(roles any) map[string]any {
+	return map[string]any{
+		"sub":   "alice",
+		"iss":   "aml-idp",
+		"aud":   []string{"bank-aml"},
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"roles": roles,
+	}
+}
+
+func TestNewAuthenticator_Disabled(t *testing.T) {
+	a, err := NewAuthenticator(config.AuthConfig{Enabled: false})
+
+	assert.NoError(t, err)
+	assert.Nil(t, a)
+}
+
+func TestNewAuthenticator_InvalidConfig(t *testing.T) {
+	cases := map[string]config.AuthConfig{
+		"no keys":      {},
+		"unknown role": {APIKeys: []string{"gateway:root:0123456789abcdef"}},
+		"short key":    {APIKeys: []string{"gateway:ingest:short"}},
+		"no roles":     {APIKeys: []string{"gateway::0123456789abcdef"}},
+		"duplicate":    {APIKeys: []string{"a:ingest:0123456789abcdef", "b:analyst:0123456789abcdef"}},
+		"missing key":  {JWTPublicKeyFile: filepath.Join(t.TempDir(), "missing.pem")},
+	}
+	for name, cfg := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg.Enabled = true
+			_, err := NewAuthenticator(cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuthenticator_APIKey(t *testing.T) {
+	a := newTestAuthenticator(t, config.AuthConfig{APIKeys: []string{
+		"core-banking:ingest:ingest-key-0123456789",
+		"ops:admin:admin-key:with-colon-0123",
+	}})
+
+	identity, err := a.Authenticate("", "ingest-key-0123456789")
+	require.NoError(t, err)
+	assert.Equal(t, models.Identity{UserID: "core-banking", Roles: []string{models.RoleIngest}}, identity)
+
+	// Ключ может содержать двоеточие; admin включает все остальные роли
+	identity, err = a.Authenticate("Bearer ignored", "admin-key:with-colon-0123")
+	require.NoError(t, err)
+	assert.Equal(t, "ops", identity.UserID)
+	for _, role := range []string{models.RoleAdmin, models.RoleSupervisor, models.RoleAnalyst, models.RoleApprover, models.RoleIngest} {
+		assert.True(t, identity.HasRole(role), role)
+	}
+
+	_, err = a.Authenticate("", "unknown-key-0123456789")
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+	_, err = a.Authenticate("", "")
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+}
+
+func TestAuthenticator_HMACToken(t *testing.T) {
+	secret := []byte("test-secret")
+	a := newTestAuthenticator(t, config.AuthConfig{JWTSecret: string(secret), JWTIssuer: "aml-idp", JWTAudience: "bank-aml"})
+
+	for _, alg := range []string{"HS256", "HS384", "HS512"} {
+		identity, err := a.Authenticate("Bearer "+signHS(t, alg, secret, validClaims([]string{"supervisor"})), "")
+		require.NoError(t, err, alg)
+		assert.Equal(t, "alice", identity.UserID)
+		assert.True(t, identity.HasRole(models.RoleApprover))
+		assert.False(t, identity.HasRole(models.RoleAdmin))
+	}
+
+	// Роли строкой через пробел
+	identity, err := a.Authenticate("bearer "+signHS(t, "HS256", secret, validClaims("analyst ingest")), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.RoleAnalyst, models.RoleIngest}, identity.Roles)
+}
+
+func TestAuthenticator_RSAToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	a := newTestAuthenticator(t, config.AuthConfig{JWTPublicKeyFile: writePublicKey(t, key)})
+
+	identity, err := a.Authenticate("Bearer "+signRS(t, "RS256", key, validClaims([]string{"analyst"})), "")
+	require.NoError(t, err)
+	assert.Equal(t, models.Identity{UserID: "alice", Roles: []string{models.RoleAnalyst}}, identity)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = a.Authenticate("Bearer "+signRS(t, "RS256", other, validClaims([]string{"analyst"})), "")
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+}
+
+func TestAuthenticator_RejectedTokens(t *testing.T) {
+	secret := []byte("test-secret")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKeyFile := writePublicKey(t, key)
+	a := newTestAuthenticator(t, config.AuthConfig{JWTPublicKeyFile: publicKeyFile, JWTIssuer: "aml-idp", JWTAudience: "bank-aml"})
+	hmacOnly := newTestAuthenticator(t, config.AuthConfig{JWTSecret: string(secret)})
+
+	expired := validClaims([]string{"analyst"})
+	expired["exp"] = testNow.Add(-2 * time.Minute).Unix()
+	notYet := validClaims([]string{"analyst"})
+	notYet["nbf"] = testNow.Add(5 * time.Minute).Unix()
+	noExp := validClaims([]string{"analyst"})
+	delete(noExp, "exp")
+	wrongAudience := validClaims([]string{"analyst"})
+	wrongAudience["aud"] = "other-service"
+	wrongIssuer := validClaims([]string{"analyst"})
+	wrongIssuer["iss"] = "attacker"
+	noSubject := validClaims([]string{"analyst"})
+	delete(noSubject, "sub")
+
+	der := x509.MarshalPKCS1PublicKey(&key.PublicKey)
+	unsigned := encodeSegment(t, map[string]any{"alg": "none"}) + "." + encodeSegment(t, validClaims([]string{"admin"})) + "."
+
+	cases := map[string]struct {
+		a     *Authenticator
+		token string
+	}{
+		"expired":        {a, signRS(t, "RS256", key, expired)},
+		"not yet valid":  {a, signRS(t, "RS256", key, notYet)},
+		"no expiration":  {a, signRS(t, "RS256", key, noExp)},
+		"wrong audience": {a, signRS(t, "RS256", key, wrongAudience)},
+		"wrong issuer":   {a, signRS(t, "RS256", key, wrongIssuer)},
+		"no subject":     {a, signRS(t, "RS256", key, noSubject)},
+		"alg none":       {a, unsigned},
+		// Открытый ключ не должен приниматься как секрет HMAC
+		"alg confusion":  {a, signHS(t, "HS256", der, validClaims([]string{"admin"}))},
+		"rs without key": {hmacOnly, signRS(t, "RS256", key, validClaims([]string{"admin"}))},
+		"bad signature":  {hmacOnly, signHS(t, "HS256", []byte("other-secret"), validClaims([]string{"admin"}))},
+		"malformed":      {a, "not-a-token"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.a.Authenticate("Bearer "+tc.token, "")
+			assert.True(t, errors.Is(err, ErrUnauthenticated), "%v", err)
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	analyst := models.Identity{UserID: "alice", Roles: []string{models.RoleAnalyst}}
+
+	assert.True(t, Authorize(analyst, []string{models.RoleIngest, models.RoleAnalyst}))
+	assert.False(t, Authorize(analyst, []string{models.RoleSupervisor}))
+	assert.False(t, Authorize(analyst, nil))
+}
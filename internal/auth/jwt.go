@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256" // SHA-256 для HS256 и RS256
+	_ "crypto/sha512" // SHA-384 и SHA-512 для HS384, HS512, RS384, RS512
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// clockSkew - допустимое расхождение часов с издателем токена при проверке exp и nbf
+const clockSkew = time.Minute
+
+// jwtHashes - хэш-функции поддерживаемых алгоритмов подписи
+var jwtHashes = map[string]crypto.Hash{
+	"HS256": crypto.SHA256,
+	"HS384": crypto.SHA384,
+	"HS512": crypto.SHA512,
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+}
+
+// jwtHeader - заголовок JWT
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+// jwtClaims - стандартные claims, которые проверяются при аутентификации
+type jwtClaims struct {
+	Subject   string      `json:"sub"`
+	Issuer    string      `json:"iss"`
+	Audience  jwtAudience `json:"aud"`
+	ExpiresAt *float64    `json:"exp"`
+	NotBefore *float64    `json:"nbf"`
+}
+
+// jwtAudience - claim aud: строка или массив строк
+type jwtAudience []string
+
+// UnmarshalJSON разбирает aud в обоих допустимых видах
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// verifyToken проверяет подпись и срок действия JWT и возвращает пользователя из sub и claim ролей
+// Алгоритм подписи должен соответствовать настроенному ключу: токен HS* без секрета или RS* без открытого ключа отклоняется
+func (a *Authenticator) verifyToken(token string) (models.Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return models.Identity{}, fmt.Errorf("%w: malformed token", ErrUnauthenticated)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed token header", ErrUnauthenticated)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed token signature", ErrUnauthenticated)
+	}
+	if err := a.verifySignature(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return models.Identity{}, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed token claims", ErrUnauthenticated)
+	}
+	if err := a.validateClaims(&claims); err != nil {
+		return models.Identity{}, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed token claims", ErrUnauthenticated)
+	}
+	roles, err := parseRolesClaim(raw[a.rolesClaim])
+	if err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed %s claim", ErrUnauthenticated, a.rolesClaim)
+	}
+	return models.Identity{UserID: claims.Subject, Roles: expandRoles(roles)}, nil
+}
+
+// verifySignature проверяет подпись токена ключом, соответствующим алгоритму
+func (a *Authenticator) verifySignature(alg, signingInput string, signature []byte) error {
+	hash, ok := jwtHashes[alg]
+	if !ok {
+		return fmt.Errorf("%w: unsupported token algorithm %q", ErrUnauthenticated, alg)
+	}
+
+	switch alg[:2] {
+	case "HS":
+		if a.jwtSecret == nil {
+			return fmt.Errorf("%w: token algorithm %s is not accepted", ErrUnauthenticated, alg)
+		}
+		mac := hmac.New(hash.New, a.jwtSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return fmt.Errorf("%w: invalid token signature", ErrUnauthenticated)
+		}
+	case "RS":
+		if a.publicKey == nil {
+			return fmt.Errorf("%w: token algorithm %s is not accepted", ErrUnauthenticated, alg)
+		}
+		digest := hash.New()
+		digest.Write([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(a.publicKey, hash, digest.Sum(nil), signature); err != nil {
+			return fmt.Errorf("%w: invalid token signature", ErrUnauthenticated)
+		}
+	}
+	return nil
+}
+
+// validateClaims проверяет срок действия, издателя и получателя токена
+func (a *Authenticator) validateClaims(claims *jwtClaims) error {
+	now := a.now()
+	if claims.Subject == "" {
+		return fmt.Errorf("%w: token has no subject", ErrUnauthenticated)
+	}
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("%w: token has no expiration", ErrUnauthenticated)
+	}
+	if now.After(unixTime(*claims.ExpiresAt).Add(clockSkew)) {
+		return fmt.Errorf("%w: token expired", ErrUnauthenticated)
+	}
+	if claims.NotBefore != nil && now.Add(clockSkew).Before(unixTime(*claims.NotBefore)) {
+		return fmt.Errorf("%w: token is not valid yet", ErrUnauthenticated)
+	}
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return fmt.Errorf("%w: unexpected token issuer", ErrUnauthenticated)
+	}
+	if a.audience != "" && !containsString(claims.Audience, a.audience) {
+		return fmt.Errorf("%w: token is not issued for this audience", ErrUnauthenticated)
+	}
+	return nil
+}
+
+// parseRolesClaim разбирает роли: массив строк или строку с ролями через пробел или запятую
+func parseRolesClaim(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == ',' }), nil
+}
+
+// decodeSegment декодирует часть токена в base64url и разбирает JSON
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unixTime переводит NumericDate (секунды от начала эпохи) во время; дробная часть отбрасывается
+func unixTime(seconds float64) time.Time {
+	return time.Unix(int64(seconds), 0)
+}
+
+// containsString проверяет наличие строки в списке
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// StreamTokenTTL - срок действия токена подписки; токен проверяется только при открытии потока
+const StreamTokenTTL = time.Minute
+
+// streamTokenClaims - содержимое токена подписки
+type streamTokenClaims struct {
+	Subject   string   `json:"sub"`
+	Roles     []string `json:"roles"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+// newStreamSecret создает ключ подписи токенов подписки; ключ живет только в памяти процесса,
+// поэтому токены выданные до перезапуска сервиса перестают действовать
+func newStreamSecret() ([]byte, error) {
+	secret := make([]byte, sha256.Size)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate stream token secret: %w", err)
+	}
+	return secret, nil
+}
+
+// IssueStreamToken выдает короткоживущий токен подписки с учетными данными пользователя
+// Токен передается в параметре запроса там, где клиент не может передать заголовки (EventSource),
+// и в отличие от API-ключа или JWT быстро истекает, если URL попадет в журналы
+func (a *Authenticator) IssueStreamToken(identity models.Identity) (string, time.Time, error) {
+	expiresAt := a.now().Add(StreamTokenTTL).Truncate(time.Second)
+	payload, err := json.Marshal(streamTokenClaims{
+		Subject:   identity.UserID,
+		Roles:     identity.Roles,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(a.signStreamToken(encoded)), expiresAt, nil
+}
+
+// AuthenticateStreamToken проверяет подпись и срок действия токена подписки
+func (a *Authenticator) AuthenticateStreamToken(token string) (models.Identity, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return models.Identity{}, fmt.Errorf("%w: malformed stream token", ErrUnauthenticated)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(decoded, a.signStreamToken(encoded)) {
+		return models.Identity{}, fmt.Errorf("%w: invalid stream token signature", ErrUnauthenticated)
+	}
+
+	var claims streamTokenClaims
+	if err := decodeSegment(encoded, &claims); err != nil {
+		return models.Identity{}, fmt.Errorf("%w: malformed stream token", ErrUnauthenticated)
+	}
+	if !a.now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return models.Identity{}, fmt.Errorf("%w: stream token expired", ErrUnauthenticated)
+	}
+	if claims.Subject == "" {
+		return models.Identity{}, fmt.Errorf("%w: stream token has no subject", ErrUnauthenticated)
+	}
+	return models.Identity{UserID: claims.Subject, Roles: claims.Roles}, nil
+}
+
+// signStreamToken подписывает закодированное содержимое токена подписки
+func (a *Authenticator) signStreamToken(encoded string) []byte {
+	mac := hmac.New(sha256.New, a.streamSecret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_StreamToken(t *testing.T) {
+	a := newTestAuthenticator(t, config.AuthConfig{APIKeys: []string{"alice:analyst:analyst-key-0123456789"}})
+	identity := models.Identity{UserID: "alice", Roles: []string{models.RoleAnalyst}}
+
+	token, expiresAt, err := a.IssueStreamToken(identity)
+	require.NoError(t, err)
+	assert.Equal(t, testNow.Add(StreamTokenTTL), expiresAt)
+
+	got, err := a.AuthenticateStreamToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, identity, got)
+
+	// Токен не заменяет API-ключ и JWT в заголовках
+	_, err = a.Authenticate("Bearer "+token, "")
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	// Истекший токен
+	a.now = func() time.Time { return testNow.Add(StreamTokenTTL) }
+	_, err = a.AuthenticateStreamToken(token)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestAuthenticator_StreamTokenRejected(t *testing.T) {
+	a := newTestAuthenticator(t, config.AuthConfig{APIKeys: []string{"alice:analyst:analyst-key-0123456789"}})
+	other := newTestAuthenticator(t, config.AuthConfig{APIKeys: []string{"alice:analyst:analyst-key-0123456789"}})
+
+	token, _, err := a.IssueStreamToken(models.Identity{UserID: "alice", Roles: []string{models.RoleAnalyst}})
+	require.NoError(t, err)
+	payload, signature, _ := strings.Cut(token, ".")
+	forged, _, err := a.IssueStreamToken(models.Identity{UserID: "alice", Roles: []string{models.RoleAdmin}})
+	require.NoError(t, err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	cases := map[string]string{
+		"empty":             "",
+		"no signature":      payload,
+		"other process key": mustIssue(t, other),
+		"swapped payload":   forgedPayload + "." + signature,
+	}
+	for name, token := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := a.AuthenticateStreamToken(token)
+			assert.ErrorIs(t, err, ErrUnauthenticated)
+		})
+	}
+}
+
+func mustIssue(t *testing.T, a *Authenticator) string {
+	t.Helper()
+	token, _, err := a.IssueStreamToken(models.Identity{UserID: "alice", Roles: []string{models.RoleAnalyst}})
+	require.NoError(t, err)
+	return token
+}
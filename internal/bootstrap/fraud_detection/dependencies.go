@@ -4,12 +4,15 @@ import (
 	"log"
 
 	"bank-aml-system/config"
+	"bank-aml-system/internal/fraud"
 	"bank-aml-system/internal/kafka"
 	"bank-aml-system/internal/models"
 	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/reporting"
 	"bank-aml-system/internal/services"
 	"bank-aml-system/internal/storage"
 	"bank-aml-system/internal/storage/sqlite"
+	"bank-aml-system/internal/validation"
 )
 
 // Dependencies содержит все зависимости для fraud detection service
@@ -19,6 +22,13 @@ type Dependencies struct {
 	RedisClient        *redis.Client
 	RiskAnalyzer       services.RiskAnalyzer
 	TransactionService services.TransactionService
+	CaseService        services.CaseService
+	DispositionService services.DispositionService
+	AnalysisService    services.AnalysisService
+	DecisionService    services.DecisionService
+	HoldService        services.HoldService
+	MandatoryService   services.MandatoryReportService
+	KafkaProducer      kafka.Producer
 	KafkaConsumer      kafka.Consumer
 }
 
@@ -31,6 +41,14 @@ func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	}
 
 	storageRepo := sqlite.NewRepository(storageConn)
+	accountRepo := sqlite.NewAccountRepository(storageConn)
+	flowCycleRepo := sqlite.NewFlowCycleRepository(storageConn)
+	caseRepo := sqlite.NewCaseRepository(storageConn)
+	dispositionRepo := sqlite.NewDispositionRepository(storageConn)
+	decisionRepo := sqlite.NewDecisionRepository(storageConn)
+	holdRepo := sqlite.NewHoldRepository(storageConn)
+	mandatoryRepo := sqlite.NewMandatoryReportRepository(storageConn)
+	analysisRepo := sqlite.NewAnalysisRepository(storageConn)
 
 	// Инициализация Redis
 	log.Println("Connecting to Redis...")
@@ -47,14 +65,48 @@ func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	}
 
 	// Инициализация анализатора рисков
-	riskAnalyzerService := services.NewRiskAnalyzer(redisClient)
+	riskAnalyzerService := services.NewRiskAnalyzerWithOptions(redisClient, fraud.Options{
+		Accounts:   accountRepo,
+		FlowCycles: flowCycleRepo,
+		Rules:      cfg.Rules,
+	})
 
 	// Создаем сервис транзакций для получения статусов с поддержкой Redis (для флагов)
-	transactionService := services.NewTransactionServiceWithRedis(storageRepo, nil, redisClient)
+	transactionService := services.NewTransactionServiceWithRedis(storageRepo, nil, redisClient, validation.NewValidator(cfg.Validation))
+
+	// Алерты и кейсы для аналитиков
+	caseService := services.NewCaseService(caseRepo)
+
+	// Флаги анализа для оценки точности правил по заключениям аналитиков
+	dispositionService := services.NewDispositionService(dispositionRepo, storageRepo, caseRepo)
+
+	// История версий анализа для повторной оценки и сравнения результатов
+	analysisService := services.NewAnalysisService(analysisRepo, storageRepo, riskAnalyzerService, redisClient)
+
+	// Обязательный контроль операций с наличными не зависит от оценки риска
+	mandatoryService := services.NewMandatoryReportService(
+		mandatoryRepo, accountRepo,
+		reporting.NewRules(cfg.Reporting, cfg.GoAML.CurrencyCodeLocal, cfg.GoAML.ExchangeRates),
+		cfg.GoAML.CurrencyCodeLocal,
+	)
+
+	// Решения по транзакциям публикуются в Kafka для core banking
+	log.Println("Connecting Kafka producer for decision events...")
+	producer, err := kafka.NewProducer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	decisionService := services.NewDecisionService(decisionRepo, storageRepo, producer, cfg.Decisions.ApprovalTTL)
+
+	// Срок проверки операций, приостановленных по рекомендации; таймеры хранятся в БД
+	holdService := services.NewHoldService(holdRepo, decisionService, storageRepo, caseRepo, producer, services.HoldPolicy{
+		SLA:          cfg.Decisions.HoldSLA,
+		ExpiryAction: cfg.Decisions.HoldExpiryAction,
+	})
 
 	// Настройка обработчика Kafka событий
 	handler := func(event *models.KafkaTransactionEvent) error {
-		return processTransaction(event, storageRepo, redisClient, riskAnalyzerService)
+		return processTransaction(event, storageRepo, redisClient, riskAnalyzerService, caseService, dispositionService, analysisService, decisionService, holdService, mandatoryService)
 	}
 
 	// Инициализация Kafka Consumer
@@ -71,6 +123,13 @@ func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
 		RedisClient:        redisClient,
 		RiskAnalyzer:       riskAnalyzerService,
 		TransactionService: transactionService,
+		CaseService:        caseService,
+		DispositionService: dispositionService,
+		AnalysisService:    analysisService,
+		DecisionService:    decisionService,
+		HoldService:        holdService,
+		MandatoryService:   mandatoryService,
+		KafkaProducer:      producer,
 		KafkaConsumer:      consumer,
 	}, nil
 }
@@ -82,6 +141,11 @@ func (d *Dependencies) Close() error {
 			return err
 		}
 	}
+	if d.KafkaProducer != nil {
+		if err := d.KafkaProducer.Close(); err != nil {
+			return err
+		}
+	}
 	if d.RedisClient != nil {
 		if err := d.RedisClient.Close(); err != nil {
 			return err
@@ -0,0 +1,39 @@
+package fraud_detection
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"bank-aml-system/internal/services"
+)
+
+// defaultHoldCheckInterval - период проверки, если он не задан в конфигурации
+const defaultHoldCheckInterval = 30 * time.Second
+
+// runHoldScheduler периодически обрабатывает истекшие сроки проверки приостановленных операций
+// Таймеры хранятся в БД, поэтому первый проход сразу после запуска подхватывает сроки,
+// истекшие, пока сервис был остановлен
+func runHoldScheduler(ctx context.Context, holdService services.HoldService, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultHoldCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := holdService.ProcessExpiredHolds(time.Now())
+		if err != nil {
+			log.Printf("Error processing expired holds: %v", err)
+		} else if processed > 0 {
+			log.Printf("Processed %d expired holds", processed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
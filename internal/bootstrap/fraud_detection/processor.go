@@ -2,6 +2,7 @@ package fraud_detection
 
 import (
 	"log"
+	"time"
 
 	"bank-aml-system/internal/logger"
 	"bank-aml-system/internal/models"
@@ -14,8 +15,14 @@ import (
 func processTransaction(
 	event *models.KafkaTransactionEvent,
 	repo storage.TransactionRepository,
-	redisClient *redis.Client,
+	redisClient redis.ClientInterface,
 	riskAnalyzer services.RiskAnalyzer,
+	caseService services.CaseService,
+	dispositionService services.DispositionService,
+	analysisService services.AnalysisService,
+	decisionService services.DecisionService,
+	holdService services.HoldService,
+	mandatoryService services.MandatoryReportService,
 ) error {
 	log.Printf("Processing transaction: %s", event.Data.ProcessingID)
 
@@ -43,7 +50,7 @@ func processTransaction(
 		return nil
 	}
 
-	analysis, err := riskAnalyzer.AnalyzeTransaction(tx)
+	analysis, err := analyzeOnce(event.Data.ProcessingID, tx, repo, redisClient, riskAnalyzer)
 	if err != nil {
 		log.Printf("Error analyzing transaction: %v", err)
 		return err
@@ -83,6 +90,60 @@ func processTransaction(
 		"risk_level":    analysis.RiskLevel,
 	})
 
+	// Первая версия анализа в истории; повторные оценки добавляют следующие
+	if analysisService != nil {
+		if _, err := analysisService.RecordAnalysis(event.Data.ProcessingID, analysis); err != nil {
+			log.Printf("Error recording analysis version for %s: %v", event.Data.ProcessingID, err)
+		}
+	}
+
+	// Сохраняем флаги с версией правил для отчета о точности
+	if dispositionService != nil {
+		if err := dispositionService.RecordFlags(event.Data.ProcessingID, analysis); err != nil {
+			log.Printf("Error recording flags for %s: %v", event.Data.ProcessingID, err)
+		}
+	}
+
+	// Обязательный контроль операций с наличными независимо от оценки риска
+	if mandatoryService != nil {
+		reports, err := mandatoryService.ScreenTransaction(event.Data.ProcessingID, tx)
+		if err != nil {
+			log.Printf("Error screening %s for mandatory reporting: %v", event.Data.ProcessingID, err)
+		} else if len(reports) > 0 {
+			log.Printf("Transaction %s queued for mandatory reporting (%d entries)", event.Data.ProcessingID, len(reports))
+		}
+	}
+
+	// Автоматическое решение по рекомендации (approved или held до проверки аналитиком)
+	if decisionService != nil {
+		decision, err := decisionService.ApplyRecommendation(event.Data.ProcessingID, analysis)
+		if err != nil {
+			log.Printf("Error applying decision for %s: %v", event.Data.ProcessingID, err)
+		} else if decision != nil {
+			log.Printf("Transaction %s decision: %s", event.Data.ProcessingID, decision.Decision)
+
+			// Приостановленная операция получает срок проверки аналитиком
+			if holdService != nil {
+				hold, err := holdService.PlaceHold(event.Data.ProcessingID, decision)
+				if err != nil {
+					log.Printf("Error placing hold timer for %s: %v", event.Data.ProcessingID, err)
+				} else if hold != nil {
+					log.Printf("Transaction %s held until %s (then %s)", event.Data.ProcessingID, hold.ExpiresAt.Format(time.RFC3339), hold.ExpiryAction)
+				}
+			}
+		}
+	}
+
+	// Создаем алерт для аналитиков, если анализ требует проверки
+	if caseService != nil {
+		alert, err := caseService.RaiseAlert(event.Data.ProcessingID, tx, analysis)
+		if err != nil {
+			log.Printf("Error raising alert for %s: %v", event.Data.ProcessingID, err)
+		} else if alert != nil {
+			log.Printf("Alert %d raised for transaction %s (case %d)", alert.ID, event.Data.ProcessingID, alert.CaseID)
+		}
+	}
+
 	if err := redisClient.IncrementRiskStats(analysis.RiskLevel); err != nil {
 		log.Printf("Error updating risk stats: %v", err)
 	}
@@ -92,3 +153,35 @@ func processTransaction(
 
 	return nil
 }
+
+// analyzeOnce возвращает анализ операции, учитывая ее в профилях счета не более одного раза
+// Операции gRPC анализируются синхронно при приеме, и их анализ уже лежит в кэше; повторный анализ
+// еще раз обновил бы профили и потерял бы флаги первого платежа (new_beneficiary), поэтому кэш используется как есть
+func analyzeOnce(
+	processingID string,
+	tx *models.Transaction,
+	repo storage.TransactionRepository,
+	redisClient redis.ClientInterface,
+	riskAnalyzer services.RiskAnalyzer,
+) (*models.RiskAnalysis, error) {
+	cached, err := redisClient.GetAnalysis(processingID)
+	if err != nil {
+		log.Printf("Error reading cached analysis for %s: %v", processingID, err)
+	}
+	if cached != nil {
+		log.Printf("Transaction %s already analyzed at %s, reusing the analysis", processingID, cached.AnalyzedAt.Format(time.RFC3339))
+		return cached, nil
+	}
+
+	status, err := repo.GetTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil && status.AnalysisTimestamp != nil {
+		// Анализ уже учтен в профилях, но выпал из кэша: оценка без повторной записи профилей
+		log.Printf("Transaction %s already analyzed but its analysis is not cached: scoring without updating account profiles", processingID)
+		return riskAnalyzer.RescoreTransaction(tx, nil)
+	}
+
+	return riskAnalyzer.AnalyzeTransaction(tx)
+}
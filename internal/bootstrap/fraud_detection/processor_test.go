@@ -0,0 +1,140 @@
+package fraud_detection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	transaction "bank-aml-system/api/proto"
+	"bank-aml-system/config"
+	"bank-aml-system/internal/fraud"
+	grpcserver "bank-aml-system/internal/grpc"
+	kafkamocks "bank-aml-system/internal/kafka/mocks"
+	"bank-aml-system/internal/models"
+	redismocks "bank-aml-system/internal/redis/mocks"
+	servicemocks "bank-aml-system/internal/services/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func hasFlag(flag string) interface{} {
+	return mock.MatchedBy(func(analysis *models.RiskAnalysis) bool {
+		for _, f := range analysis.Flags {
+			if f == flag {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Операция, принятая по gRPC, учитывается в профилях один раз, а флаг первого платежа доходит до алерта и решения
+func TestProcessTransaction_GRPCTransactionKeepsNewBeneficiaryFlag(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	mockRedis := new(redismocks.MockClientInterface)
+	mockTransactions := new(servicemocks.MockTransactionService)
+	mockCases := new(servicemocks.MockCaseService)
+	mockDecisions := new(servicemocks.MockDecisionService)
+	consumerAnalyzer := new(servicemocks.MockRiskAnalyzer)
+
+	analyzer := fraud.NewRiskAnalyzerWithOptions(mockRedis, fraud.Options{
+		Rules: config.RulesConfig{Beneficiary: config.BeneficiaryRulesConfig{
+			Enabled:     true,
+			LargeAmount: 500000,
+			RapidWindow: 60 * time.Minute,
+		}},
+	})
+	server := grpcserver.NewTransactionGRPCServer(mockRepo, mockProducer, mockRedis, analyzer, mockTransactions, nil, 1)
+
+	txTime := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	mockTransactions.On("ValidateTransaction", mock.Anything).Return(nil)
+	mockRepo.On("SaveTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("UpdateTransactionAnalysis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	mockRedis.On("IsHighRiskCountry", "RU").Return(false, nil)
+	mockRedis.On("IsAccountBlacklisted", "ACC789012").Return(false, nil)
+	mockRedis.On("GetAccountDailyCount", "ACC123456").Return(int64(2), nil)
+	mockRedis.On("IncrementAccountDailyCount", "ACC123456").Return(nil).Once()
+	mockRedis.On("GetBeneficiaryHistory", "ACC123456", "ACC789012", "Sberbank").
+		Return(&models.BeneficiaryHistory{KnownBeneficiaries: 2, KnownBanks: 1, BankKnown: true}, nil).Once()
+	mockRedis.On("RecordBeneficiary", "ACC123456", "ACC789012", "Sberbank", txTime).Return(nil).Once()
+
+	var cached *models.RiskAnalysis
+	mockRedis.On("SaveAnalysis", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		cached = args.Get(1).(*models.RiskAnalysis)
+	}).Return(nil)
+	mockRedis.On("IncrementRiskStats", mock.Anything).Return(nil)
+
+	var event *models.KafkaTransactionEvent
+	mockProducer.On("SendTransactionEvent", mock.Anything).Run(func(args mock.Arguments) {
+		event = args.Get(0).(*models.KafkaTransactionEvent)
+	}).Return(nil)
+
+	resp, err := server.AnalyzeTransaction(context.Background(), &transaction.AnalyzeTransactionRequest{
+		TransactionId:       "TXN-GRPC-001",
+		AccountNumber:       "ACC123456",
+		Amount:              750000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyAccount: "ACC789012",
+		CounterpartyBank:    "Sberbank",
+		CounterpartyCountry: "RU",
+		Channel:             "online",
+		Timestamp:           txTime.Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Flags, "new_beneficiary_large_amount")
+	require.NotNil(t, event)
+
+	// Анализ, сохраненный при приеме, отдается консьюмеру из кэша
+	require.NotNil(t, cached)
+	mockRedis.On("GetAnalysis", resp.ProcessingId).Return(cached, nil)
+	mockRepo.On("GetFullTransactionByProcessingID", resp.ProcessingId).Return(&models.Transaction{
+		TransactionID:       "TXN-GRPC-001",
+		AccountNumber:       "ACC123456",
+		Amount:              750000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyAccount: "ACC789012",
+		CounterpartyBank:    "Sberbank",
+		CounterpartyCountry: "RU",
+		Channel:             "online",
+		Timestamp:           txTime,
+	}, nil)
+	mockDecisions.On("ApplyRecommendation", resp.ProcessingId, hasFlag("new_beneficiary_large_amount")).Return(nil, nil)
+	mockCases.On("RaiseAlert", resp.ProcessingId, mock.Anything, hasFlag("new_beneficiary_large_amount")).Return(nil, nil)
+
+	err = processTransaction(event, mockRepo, mockRedis, consumerAnalyzer, mockCases, nil, nil, mockDecisions, nil, nil)
+	require.NoError(t, err)
+
+	consumerAnalyzer.AssertNotCalled(t, "AnalyzeTransaction", mock.Anything)
+	mockRedis.AssertNumberOfCalls(t, "RecordBeneficiary", 1)
+	mockRedis.AssertNumberOfCalls(t, "IncrementAccountDailyCount", 1)
+	mockRedis.AssertExpectations(t)
+	mockDecisions.AssertExpectations(t)
+	mockCases.AssertExpectations(t)
+}
+
+// Анализ выпал из кэша: операция оценивается заново без повторной записи профилей
+func TestAnalyzeOnce_AnalyzedButNotCachedRescores(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockRedis := new(redismocks.MockClientInterface)
+	mockAnalyzer := new(servicemocks.MockRiskAnalyzer)
+
+	analyzedAt := time.Date(2024, 1, 15, 14, 31, 0, 0, time.UTC)
+	tx := &models.Transaction{TransactionID: "TXN-GRPC-002", AccountNumber: "ACC123456"}
+	rescored := &models.RiskAnalysis{RiskScore: 30, RiskLevel: "medium", Flags: []string{"new_beneficiary_large_amount"}}
+
+	mockRedis.On("GetAnalysis", "proc_2").Return(nil, nil)
+	mockRepo.On("GetTransactionByProcessingID", "proc_2").Return(&models.TransactionStatus{AnalysisTimestamp: &analyzedAt}, nil)
+	mockAnalyzer.On("RescoreTransaction", tx, (*models.RuleInputs)(nil)).Return(rescored, nil)
+
+	analysis, err := analyzeOnce("proc_2", tx, mockRepo, mockRedis, mockAnalyzer)
+	require.NoError(t, err)
+	assert.Equal(t, rescored, analysis)
+	mockAnalyzer.AssertNotCalled(t, "AnalyzeTransaction", mock.Anything)
+}
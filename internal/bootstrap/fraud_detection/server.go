@@ -12,6 +12,7 @@ import (
 
 	"bank-aml-system/config"
 	"bank-aml-system/internal/api/rest"
+	"bank-aml-system/internal/auth"
 
 	"github.com/gin-gonic/gin"
 )
@@ -38,12 +39,28 @@ func StartFraudDetectionService() {
 		}
 	}()
 
-	// Настройка REST API
-	router := gin.Default()
+	// Планировщик сроков проверки приостановленных операций
+	go func() {
+		log.Printf("Starting hold scheduler (interval %s)...", cfg.Decisions.HoldCheckInterval)
+		runHoldScheduler(ctx, deps.HoldService, cfg.Decisions.HoldCheckInterval)
+	}()
+
+	authenticator, err := auth.NewAuthenticator(cfg.Auth)
+	if err != nil {
+		log.Fatalf("Failed to configure authentication: %v", err)
+	}
 
-	// Используем общий CORS middleware
-	router.Use(rest.CORSMiddleware())
-	router.Use(gin.Logger(), gin.Recovery())
+	// Настройка REST API
+	router := gin.New()
+
+	// Используем общие CORS middleware, журнал запросов и проверку доступа
+	router.Use(rest.CORSMiddleware(cfg.Auth.AllowedOrigins))
+	router.Use(rest.AccessLogger(), gin.Recovery())
+	if authenticator != nil {
+		router.Use(rest.AuthMiddleware(authenticator))
+	} else {
+		log.Println("Warning: API authentication is disabled (AUTH_ENABLED=false)")
+	}
 
 	// Настройка маршрутов
 	SetupRoutes(router, deps.TransactionService, deps.StorageRepo, deps.RedisClient)
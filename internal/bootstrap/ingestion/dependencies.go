@@ -7,19 +7,45 @@ import (
 	"bank-aml-system/internal/fraud"
 	"bank-aml-system/internal/kafka"
 	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/reporting"
 	"bank-aml-system/internal/services"
 	"bank-aml-system/internal/storage"
 	"bank-aml-system/internal/storage/sqlite"
+	"bank-aml-system/internal/validation"
 )
 
 // Dependencies содержит все зависимости для ingestion service
 type Dependencies struct {
 	StorageConn        *sqlite.SQLiteStorage
 	StorageRepo        storage.TransactionRepository
+	AccountRepo        storage.AccountRepository
+	FlowCycleRepo      storage.FlowCycleRepository
+	CaseRepo           storage.CaseRepository
+	DispositionRepo    storage.DispositionRepository
+	DecisionRepo       storage.DecisionRepository
+	HoldRepo           storage.HoldRepository
+	SARReportRepo      storage.SARReportRepository
+	MandatoryRepo      storage.MandatoryReportRepository
+	BlacklistRepo      storage.BlacklistRepository
+	AnalysisRepo       storage.AnalysisRepository
+	UpdateRepo         storage.TransactionUpdateRepository
 	KafkaProducer      kafka.Producer
 	RedisClient        *redis.Client
 	RiskAnalyzer       *fraud.RiskAnalyzer
 	TransactionService services.TransactionService
+	AccountService     services.AccountService
+	FlowCycleService   services.FlowCycleService
+	CaseService        services.CaseService
+	DispositionService services.DispositionService
+	DecisionService    services.DecisionService
+	HoldService        services.HoldService
+	SARReportService   services.SARReportService
+	MandatoryService   services.MandatoryReportService
+	BlacklistService   services.BlacklistService
+	AnalysisService    services.AnalysisService
+	DetailService      services.TransactionDetailService
+	ActivityService    services.AccountActivityService
+	WatchService       services.TransactionWatchService
 }
 
 // InitializeDependencies инициализирует все зависимости для ingestion service
@@ -31,6 +57,18 @@ func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	}
 
 	storageRepo := sqlite.NewRepository(storage)
+	accountRepo := sqlite.NewAccountRepository(storage)
+	flowCycleRepo := sqlite.NewFlowCycleRepository(storage)
+	caseRepo := sqlite.NewCaseRepository(storage)
+	dispositionRepo := sqlite.NewDispositionRepository(storage)
+	decisionRepo := sqlite.NewDecisionRepository(storage)
+	holdRepo := sqlite.NewHoldRepository(storage)
+	sarReportRepo := sqlite.NewSARReportRepository(storage)
+	mandatoryRepo := sqlite.NewMandatoryReportRepository(storage)
+	blacklistRepo := sqlite.NewBlacklistRepository(storage)
+	analysisRepo := sqlite.NewAnalysisRepository(storage)
+	activityRepo := sqlite.NewAccountActivityRepository(storage)
+	updateRepo := sqlite.NewTransactionUpdateRepository(storage)
 
 	// Инициализация Kafka Producer
 	log.Println("Connecting to Kafka...")
@@ -57,19 +95,87 @@ func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	// Инициализация анализатора рисков для gRPC
 	var riskAnalyzer *fraud.RiskAnalyzer
 	if redisClient != nil {
-		riskAnalyzer = fraud.NewRiskAnalyzer(redisClient)
+		riskAnalyzer = fraud.NewRiskAnalyzerWithOptions(redisClient, fraud.Options{
+			Accounts:   accountRepo,
+			FlowCycles: flowCycleRepo,
+			Rules:      cfg.Rules,
+		})
 	}
 
-	// Создаем сервис транзакций
-	transactionService := services.NewTransactionService(storageRepo, producer)
+	// Redis опционален: nil передается явно, чтобы сервисы не получили интерфейс с nil-указателем
+	var optionalRedis redis.ClientInterface
+	if redisClient != nil {
+		optionalRedis = redisClient
+	}
+
+	// Создаем сервис транзакций; флаги в списках берутся из кэша анализа в Redis
+	transactionService := services.NewTransactionServiceWithRedis(storageRepo, producer, optionalRedis, validation.NewValidator(cfg.Validation))
+	accountService := services.NewAccountService(accountRepo)
+	flowCycleService := services.NewFlowCycleService(flowCycleRepo)
+	caseService := services.NewCaseService(caseRepo)
+	dispositionService := services.NewDispositionService(dispositionRepo, storageRepo, caseRepo)
+	decisionService := services.NewDecisionService(decisionRepo, storageRepo, producer, cfg.Decisions.ApprovalTTL)
+	holdService := services.NewHoldService(holdRepo, decisionService, storageRepo, caseRepo, producer, services.HoldPolicy{
+		SLA:          cfg.Decisions.HoldSLA,
+		ExpiryAction: cfg.Decisions.HoldExpiryAction,
+	})
+	sarReportService := services.NewSARReportService(sarReportRepo, caseRepo, accountRepo, cfg.GoAML)
+	mandatoryService := services.NewMandatoryReportService(
+		mandatoryRepo, accountRepo,
+		reporting.NewRules(cfg.Reporting, cfg.GoAML.CurrencyCodeLocal, cfg.GoAML.ExchangeRates),
+		cfg.GoAML.CurrencyCodeLocal,
+	)
+
+	// Без Redis записи списков проверяются только ретроспективно
+	blacklistService := services.NewBlacklistService(blacklistRepo, caseService, optionalRedis, cfg.Blacklist.RetroLookback)
+
+	// Повторная оценка использует профили счетов в Redis и без него недоступна
+	var rescoreAnalyzer services.RiskAnalyzer
+	if riskAnalyzer != nil {
+		rescoreAnalyzer = riskAnalyzer
+	}
+	analysisService := services.NewAnalysisService(analysisRepo, storageRepo, rescoreAnalyzer, optionalRedis)
+	detailService := services.NewTransactionDetailService(
+		storageRepo, analysisRepo, caseRepo, decisionRepo, holdRepo, dispositionRepo, optionalRedis,
+	)
+	// Счетчики активности показываются за те же окна, что используют правила анализа
+	activityService := services.NewAccountActivityService(storageRepo, activityRepo, blacklistRepo, optionalRedis, services.VelocityWindows{
+		Flows: cfg.Rules.PassThrough.Window,
+		Fan:   cfg.Rules.Fan.Window,
+	})
+	watchService := services.NewTransactionWatchService(updateRepo, cfg.Watch.PollInterval, cfg.Watch.Retention)
 
 	return &Dependencies{
 		StorageConn:        storage,
 		StorageRepo:        storageRepo,
+		AccountRepo:        accountRepo,
+		FlowCycleRepo:      flowCycleRepo,
+		CaseRepo:           caseRepo,
+		DispositionRepo:    dispositionRepo,
+		DecisionRepo:       decisionRepo,
+		HoldRepo:           holdRepo,
+		SARReportRepo:      sarReportRepo,
+		MandatoryRepo:      mandatoryRepo,
+		BlacklistRepo:      blacklistRepo,
+		AnalysisRepo:       analysisRepo,
+		UpdateRepo:         updateRepo,
 		KafkaProducer:      producer,
 		RedisClient:        redisClient,
 		RiskAnalyzer:       riskAnalyzer,
 		TransactionService: transactionService,
+		AccountService:     accountService,
+		FlowCycleService:   flowCycleService,
+		CaseService:        caseService,
+		DispositionService: dispositionService,
+		DecisionService:    decisionService,
+		HoldService:        holdService,
+		SARReportService:   sarReportService,
+		MandatoryService:   mandatoryService,
+		BlacklistService:   blacklistService,
+		AnalysisService:    analysisService,
+		DetailService:      detailService,
+		ActivityService:    activityService,
+		WatchService:       watchService,
 	}, nil
 }
 
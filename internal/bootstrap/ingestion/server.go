@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -14,10 +15,11 @@ import (
 	"bank-aml-system/config"
 	_ "bank-aml-system/docs" // Swagger docs
 	"bank-aml-system/internal/api/rest"
+	"bank-aml-system/internal/auth"
 	"bank-aml-system/internal/grpc"
+	"bank-aml-system/internal/ratelimit"
 
 	grpcLib "google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // StartIngestionService запускает сервис приема транзакций
@@ -31,12 +33,38 @@ func StartIngestionService() {
 	}
 	defer deps.Close()
 
+	authenticator, err := auth.NewAuthenticator(cfg.Auth)
+	if err != nil {
+		log.Fatalf("Failed to configure authentication: %v", err)
+	}
+	if authenticator == nil {
+		log.Println("Warning: API authentication is disabled (AUTH_ENABLED=false)")
+	}
+
+	// Ограничения частоты запросов приема; без Redis корзины ведутся в памяти экземпляра
+	var rateLimitStore ratelimit.Store
+	if deps.RedisClient != nil {
+		rateLimitStore = deps.RedisClient
+	}
+	limiter := ratelimit.NewLimiter(cfg.RateLimit, rateLimitStore)
+	if limiter == nil {
+		log.Println("Warning: ingestion rate limits are disabled (RATE_LIMIT_ENABLED=false)")
+	}
+
+	// Контекст фоновых задач и подписок; отменяется при остановке, чтобы открытые потоки SSE завершились
+	serviceCtx, stopService := context.WithCancel(context.Background())
+	defer stopService()
+
 	// Настраиваем gRPC-клиент, чтобы из REST можно было вызывать gRPC-сервис
 	var grpcConn *grpcLib.ClientConn
 	var grpcClient transaction.TransactionServiceClient
 
 	grpcAddress := fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort)
-	grpcConn, err = grpcLib.Dial(grpcAddress, grpcLib.WithTransportCredentials(insecure.NewCredentials()))
+	clientCreds, err := grpc.ClientCredentials(cfg.GRPC)
+	if err != nil {
+		log.Fatalf("Failed to configure gRPC client TLS: %v", err)
+	}
+	grpcConn, err = grpcLib.Dial(grpcAddress, grpcLib.WithTransportCredentials(clientCreds))
 	if err != nil {
 		log.Printf("Warning: failed to connect to gRPC server at %s: %v", grpcAddress, err)
 	} else {
@@ -47,12 +75,34 @@ func StartIngestionService() {
 
 	// Настройка REST API
 	handlers := rest.NewHandlers(deps.TransactionService, grpcClient)
-	router := rest.SetupRouter(handlers)
+	accountHandlers := rest.NewAccountHandlers(deps.AccountService)
+	flowCycleHandlers := rest.NewFlowCycleHandlers(deps.FlowCycleService)
+	caseHandlers := rest.NewCaseHandlers(deps.CaseService)
+	dispositionHandlers := rest.NewDispositionHandlers(deps.DispositionService)
+	decisionHandlers := rest.NewDecisionHandlers(deps.DecisionService)
+	holdHandlers := rest.NewHoldHandlers(deps.HoldService)
+	sarReportHandlers := rest.NewSARReportHandlers(deps.SARReportService)
+	mandatoryHandlers := rest.NewMandatoryReportHandlers(deps.MandatoryService)
+	blacklistHandlers := rest.NewBlacklistHandlers(deps.BlacklistService)
+	analysisHandlers := rest.NewAnalysisHandlers(deps.AnalysisService)
+	detailHandlers := rest.NewTransactionDetailHandlers(deps.DetailService)
+	activityHandlers := rest.NewAccountActivityHandlers(deps.ActivityService)
+	watchHandlers := rest.NewTransactionWatchHandlers(deps.WatchService)
+	security := rest.Security{Authenticator: authenticator, AllowedOrigins: cfg.Auth.AllowedOrigins, RateLimiter: limiter}
+	router := rest.SetupRouter(
+		handlers, security, accountHandlers, flowCycleHandlers, caseHandlers,
+		dispositionHandlers, decisionHandlers, holdHandlers, sarReportHandlers, mandatoryHandlers,
+		blacklistHandlers, analysisHandlers, detailHandlers, activityHandlers, watchHandlers,
+	)
+
+	// Очистка ленты изменений транзакций от событий старше срока хранения
+	go runWatchPruner(serviceCtx, deps.WatchService, watchPruneInterval)
 
 	// Запуск HTTP сервера
 	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Server.IngestionPort),
-		Handler: router,
+		Addr:        fmt.Sprintf(":%d", cfg.Server.IngestionPort),
+		Handler:     router,
+		BaseContext: func(net.Listener) context.Context { return serviceCtx },
 	}
 
 	go func() {
@@ -62,12 +112,18 @@ func StartIngestionService() {
 		}
 	}()
 
-	// Запуск gRPC сервера в отдельной горутине
+	// Запуск gRPC сервера в отдельной горутине; grpcDone закрывается после его остановки
+	var grpcDone chan struct{}
 	if deps.RedisClient != nil && deps.RiskAnalyzer != nil {
+		grpcDone = make(chan struct{})
 		go func() {
+			defer close(grpcDone)
 			log.Printf("Starting gRPC server on port %d...", cfg.Server.GRPCPort)
-			grpcServer := grpc.NewTransactionGRPCServer(deps.StorageRepo, deps.KafkaProducer, deps.RedisClient, deps.RiskAnalyzer)
-			if err := grpc.StartGRPCServer(cfg, grpcServer); err != nil {
+			grpcServer := grpc.NewTransactionGRPCServer(deps.StorageRepo, deps.KafkaProducer, deps.RedisClient, deps.RiskAnalyzer, deps.TransactionService, deps.WatchService, cfg.Server.GRPCStreamConcurrency)
+			accountServer := grpc.NewAccountGRPCServer(deps.AccountService, deps.ActivityService)
+			caseServer := grpc.NewCaseGRPCServer(deps.CaseService)
+			decisionServer := grpc.NewDecisionGRPCServer(deps.DecisionService)
+			if err := grpc.StartGRPCServer(serviceCtx, cfg, authenticator, limiter, grpcServer, accountServer, caseServer, decisionServer); err != nil {
 				log.Fatalf("Failed to start gRPC server: %v", err)
 			}
 		}()
@@ -81,12 +137,16 @@ func StartIngestionService() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopService()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+	if grpcDone != nil {
+		<-grpcDone
+	}
 
 	log.Println("Server exited")
 }
@@ -0,0 +1,33 @@
+package ingestion
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"bank-aml-system/internal/services"
+)
+
+// watchPruneInterval - период очистки ленты изменений транзакций
+const watchPruneInterval = time.Hour
+
+// runWatchPruner периодически удаляет события ленты изменений старше срока хранения
+func runWatchPruner(ctx context.Context, watchService services.TransactionWatchService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := watchService.PruneUpdates(time.Now())
+		if err != nil {
+			log.Printf("Error pruning transaction updates: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Pruned %d transaction updates", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
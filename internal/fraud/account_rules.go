@@ -0,0 +1,81 @@
+package fraud
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// turnoverPeriod - период, за который сравнивается фактический оборот с заявленным месячным
+const turnoverPeriod = 30 * 24 * time.Hour
+
+// checkAccountContext оценивает транзакцию в контексте профиля счета из реестра
+// Счета, отсутствующие в реестре, не получают дополнительных баллов.
+// Оборот счета при live читается из хранилища и сохраняется в inputs, иначе берется из inputs
+func (r *RiskAnalyzer) checkAccountContext(tx *models.Transaction, inputs *models.RuleInputs, live bool) (int, []string, error) {
+	profile, err := r.accounts.GetAccountProfile(tx.AccountNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if profile == nil {
+		return 0, nil, nil
+	}
+
+	score := 0
+	var flags []string
+
+	txTime := tx.Timestamp
+	if txTime.IsZero() {
+		txTime = time.Now()
+	}
+
+	// Возраст счета: крупные операции по недавно открытым счетам характерны для дропов
+	newAccountPeriod := time.Duration(r.rules.Account.NewAccountDays) * 24 * time.Hour
+	if txTime.Sub(profile.Account.OpenedAt) < newAccountPeriod {
+		if tx.Amount >= MediumAmountThreshold {
+			score += 20
+			flags = append(flags, "new_account_large_amount")
+		} else {
+			score += 5
+			flags = append(flags, "new_account")
+		}
+	}
+
+	// Отклонение от заявленного оборота: заявленный оборот описывает исходящие операции,
+	// поэтому пополнения его не увеличивают, а сама операция учитывается один раз - своей суммой
+	if profile.Account.DeclaredTurnover > 0 && r.rules.Account.TurnoverMultiplier > 0 && models.FlowDirection(tx) == models.FlowOutbound {
+		if live {
+			turnover, err := r.accounts.GetAccountTurnover(tx.AccountNumber, tx.TransactionID, txTime.Add(-turnoverPeriod))
+			if err != nil {
+				return 0, nil, err
+			}
+			inputs.Turnover = &turnover
+		}
+		turnover := 0.0
+		if inputs.Turnover != nil {
+			turnover = *inputs.Turnover
+		}
+		if turnover+tx.Amount > profile.Account.DeclaredTurnover*r.rules.Account.TurnoverMultiplier {
+			score += 25
+			flags = append(flags, "turnover_deviation")
+		}
+	}
+
+	// Рейтинг риска клиента по результатам KYC
+	switch profile.Customer.KYCRiskRating {
+	case models.KYCRiskHigh:
+		score += 15
+		flags = append(flags, "high_risk_customer")
+	case models.KYCRiskMedium:
+		score += 5
+		flags = append(flags, "medium_risk_customer")
+	}
+
+	// Международные переводы нерезидентов
+	if profile.Customer.Residency == models.ResidencyNonResident && tx.TransactionType == "international_transfer" {
+		score += 10
+		flags = append(flags, "non_resident_international")
+	}
+
+	return score, flags, nil
+}
@@ -0,0 +1,257 @@
+package fraud
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccountRulesAnalyzer(mockRedis *mocks.MockClientInterface, mockAccounts *storagemocks.MockAccountRepository) *RiskAnalyzer {
+	return NewRiskAnalyzerWithOptions(mockRedis, Options{
+		Accounts: mockAccounts,
+		Rules: config.RulesConfig{
+			Account: config.AccountRulesConfig{
+				Enabled:            true,
+				NewAccountDays:     30,
+				TurnoverMultiplier: 1.5,
+			},
+		},
+	})
+}
+
+func setupBaseRedisMocks(mockRedis *mocks.MockClientInterface) {
+	mockRedis.On("IsHighRiskCountry", "RU").Return(false, nil)
+	mockRedis.On("IsAccountBlacklisted", "ACC789012").Return(false, nil)
+	mockRedis.On("GetAccountDailyCount", "ACC123456").Return(int64(2), nil)
+	mockRedis.On("IncrementAccountDailyCount", "ACC123456").Return(nil)
+}
+
+func TestAnalyzeTransaction_AccountRules_NewAccountTurnoverAndKYC(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	txTime := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	setupBaseRedisMocks(mockRedis)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(&models.AccountProfile{
+		Account: models.Account{
+			AccountNumber:    "ACC123456",
+			CustomerID:       "CUST-1",
+			OpenedAt:         txTime.Add(-5 * 24 * time.Hour), // Счет открыт 5 дней назад
+			DeclaredTurnover: 300000.0,
+		},
+		Customer: models.Customer{
+			CustomerID:    "CUST-1",
+			Segment:       models.SegmentRetail,
+			Residency:     models.ResidencyResident,
+			KYCRiskRating: models.KYCRiskHigh,
+		},
+	}, nil)
+	mockAccounts.On("GetAccountTurnover", "ACC123456", "TXN-ACC-001", txTime.Add(-turnoverPeriod)).Return(200000.0, nil)
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-001",
+		AccountNumber:       "ACC123456",
+		Amount:              600000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           txTime,
+		Channel:             "online",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+	require.NotNil(t, analysis)
+
+	assert.Contains(t, analysis.Flags, "new_account_large_amount")
+	assert.Contains(t, analysis.Flags, "turnover_deviation")
+	assert.Contains(t, analysis.Flags, "high_risk_customer")
+	// 10 (medium_amount) + 5 (round_amount) + 20 + 25 + 15 = 75
+	assert.Equal(t, 75, analysis.RiskScore)
+	assert.Equal(t, "high", analysis.RiskLevel)
+
+	mockRedis.AssertExpectations(t)
+	mockAccounts.AssertExpectations(t)
+}
+
+func TestAnalyzeTransaction_AccountRules_EstablishedAccount(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	txTime := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	setupBaseRedisMocks(mockRedis)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(&models.AccountProfile{
+		Account: models.Account{
+			AccountNumber:    "ACC123456",
+			OpenedAt:         txTime.AddDate(-3, 0, 0),
+			DeclaredTurnover: 5000000.0,
+		},
+		Customer: models.Customer{KYCRiskRating: models.KYCRiskLow, Residency: models.ResidencyResident},
+	}, nil)
+	mockAccounts.On("GetAccountTurnover", "ACC123456", "TXN-ACC-002", mock.AnythingOfType("time.Time")).Return(1000000.0, nil)
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-002",
+		AccountNumber:       "ACC123456",
+		Amount:              100000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           txTime,
+		Channel:             "online",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.NotContains(t, analysis.Flags, "new_account")
+	assert.NotContains(t, analysis.Flags, "turnover_deviation")
+	assert.NotContains(t, analysis.Flags, "high_risk_customer")
+	assert.Equal(t, "low", analysis.RiskLevel)
+
+	mockAccounts.AssertExpectations(t)
+}
+
+func TestAnalyzeTransaction_AccountRules_TurnoverJustUnderThreshold(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	txTime := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	setupBaseRedisMocks(mockRedis)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(&models.AccountProfile{
+		Account: models.Account{
+			AccountNumber:    "ACC123456",
+			OpenedAt:         txTime.AddDate(-3, 0, 0),
+			DeclaredTurnover: 300000.0, // Порог отклонения 300000 * 1.5 = 450000
+		},
+		Customer: models.Customer{KYCRiskRating: models.KYCRiskLow, Residency: models.ResidencyResident},
+	}, nil)
+	// Оборот без самой операции: сохраненная строка TXN-ACC-004 исключается запросом
+	mockAccounts.On("GetAccountTurnover", "ACC123456", "TXN-ACC-004", txTime.Add(-turnoverPeriod)).Return(350000.0, nil)
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-004",
+		AccountNumber:       "ACC123456",
+		Amount:              99999.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           txTime,
+		Channel:             "online",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	// 350000 + 99999 = 449999 < 450000: сумма операции учтена один раз
+	assert.NotContains(t, analysis.Flags, "turnover_deviation")
+
+	mockAccounts.AssertExpectations(t)
+}
+
+func TestAnalyzeTransaction_AccountRules_DepositSkipsTurnover(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	txTime := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	mockRedis.On("IsHighRiskCountry", "RU").Return(false, nil)
+	mockRedis.On("GetAccountDailyCount", "ACC123456").Return(int64(2), nil)
+	mockRedis.On("IncrementAccountDailyCount", "ACC123456").Return(nil)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(&models.AccountProfile{
+		Account: models.Account{
+			AccountNumber:    "ACC123456",
+			OpenedAt:         txTime.AddDate(-3, 0, 0),
+			DeclaredTurnover: 300000.0,
+		},
+		Customer: models.Customer{KYCRiskRating: models.KYCRiskLow, Residency: models.ResidencyResident},
+	}, nil)
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-005",
+		AccountNumber:       "ACC123456",
+		Amount:              900000.0,
+		Currency:            "RUB",
+		TransactionType:     "deposit",
+		CounterpartyCountry: "RU",
+		Timestamp:           txTime,
+		Channel:             "branch",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	// Пополнение не входит в заявленный исходящий оборот
+	assert.NotContains(t, analysis.Flags, "turnover_deviation")
+	mockAccounts.AssertNotCalled(t, "GetAccountTurnover", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnalyzeTransaction_AccountRules_UnknownAccount(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	setupBaseRedisMocks(mockRedis)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(nil, nil)
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-003",
+		AccountNumber:       "ACC123456",
+		Amount:              100000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		Channel:             "online",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+	assert.Equal(t, "low", analysis.RiskLevel)
+
+	mockAccounts.AssertNotCalled(t, "GetAccountTurnover", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnalyzeTransaction_AccountRules_RepositoryError(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockAccounts := new(storagemocks.MockAccountRepository)
+	analyzer := newAccountRulesAnalyzer(mockRedis, mockAccounts)
+
+	mockRedis.On("IsHighRiskCountry", "RU").Return(false, nil)
+	mockRedis.On("IsAccountBlacklisted", "ACC789012").Return(false, nil)
+	mockRedis.On("GetAccountDailyCount", "ACC123456").Return(int64(2), nil)
+	mockAccounts.On("GetAccountProfile", "ACC123456").Return(nil, errors.New("database error"))
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-ACC-004",
+		AccountNumber:       "ACC123456",
+		Amount:              100000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		Channel:             "online",
+	}
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	assert.Error(t, err)
+	assert.Nil(t, analysis)
+	mockRedis.AssertNotCalled(t, "IncrementAccountDailyCount", "ACC123456")
+}
@@ -0,0 +1,72 @@
+package fraud
+
+import (
+	"fmt"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// minAmountStdDevShare - нижняя граница стандартного отклонения как доля от средней суммы
+// Без нее счет с одинаковыми платежами давал бы бесконечную z-оценку на копеечное отклонение
+const minAmountStdDevShare = 0.1
+
+// scoreBaselineDeviation оценивает отклонение транзакции от поведенческого профиля счета
+// Пока профиль не набрал WarmupTransactions операций, правило не срабатывает
+func scoreBaselineDeviation(baseline *models.AccountBaseline, tx *models.Transaction, cfg config.BaselineRulesConfig) (int, []string) {
+	if baseline == nil || baseline.TransactionCount < int64(cfg.WarmupTransactions) || baseline.TransactionCount == 0 {
+		return 0, nil
+	}
+
+	score := 0
+	var flags []string
+
+	// Z-оценка суммы относительно истории счета
+	mean := baseline.MeanAmount()
+	stdDev := baseline.StdDevAmount()
+	if floor := mean * minAmountStdDevShare; stdDev < floor {
+		stdDev = floor
+	}
+	if stdDev > 0 && cfg.ZScoreThreshold > 0 {
+		zScore := (tx.Amount - mean) / stdDev
+		if zScore >= cfg.ZScoreThreshold*2 {
+			score += 30
+			flags = append(flags, "amount_anomaly_extreme")
+		} else if zScore >= cfg.ZScoreThreshold {
+			score += 20
+			flags = append(flags, "amount_anomaly")
+		}
+	}
+
+	// Страна контрагента, в которую счет никогда не платил
+	if tx.CounterpartyCountry != "" && baseline.Countries[tx.CounterpartyCountry] == 0 {
+		score += 15
+		flags = append(flags, "new_country")
+	}
+
+	// Нетипичный для счета канал
+	if tx.Channel != "" && categoryShare(baseline.Channels[tx.Channel], baseline.TransactionCount) < cfg.MinCategoryShare {
+		score += 10
+		flags = append(flags, "unusual_channel")
+	}
+
+	// Нетипичное для счета время: учитываем соседние часы, чтобы не штрафовать сдвиг на час
+	hour := tx.Timestamp.Hour()
+	var hourCount int64
+	for _, h := range []int{(hour + 23) % 24, hour, (hour + 1) % 24} {
+		hourCount += baseline.Hours[fmt.Sprintf("%02d", h)]
+	}
+	if categoryShare(hourCount, baseline.TransactionCount) < cfg.MinCategoryShare {
+		score += 10
+		flags = append(flags, "unusual_hour_for_account")
+	}
+
+	return score, flags
+}
+
+func categoryShare(count, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
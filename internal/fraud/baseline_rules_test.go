@@ -0,0 +1,129 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBaselineConfig = config.BaselineRulesConfig{
+	Enabled:            true,
+	WarmupTransactions: 20,
+	ZScoreThreshold:    3.0,
+	MinCategoryShare:   0.05,
+}
+
+// newTestBaseline строит профиль счета, который 40 раз платил ~50 000 в RU через online днем
+func newTestBaseline() *models.AccountBaseline {
+	baseline := &models.AccountBaseline{
+		AccountNumber:  "ACC123456",
+		Countries:      map[string]int64{"RU": 40},
+		Channels:       map[string]int64{"online": 40},
+		Hours:          map[string]int64{"13": 20, "14": 20},
+		Counterparties: map[string]int64{"ACC789012": 40},
+	}
+	for i := 0; i < 40; i++ {
+		amount := 45000.0 + float64(i%2)*10000.0
+		baseline.TransactionCount++
+		baseline.AmountSum += amount
+		baseline.AmountSumSquares += amount * amount
+	}
+	return baseline
+}
+
+func TestScoreBaselineDeviation_TypicalTransaction(t *testing.T) {
+	tx := &models.Transaction{
+		Amount:              52000.0,
+		CounterpartyCountry: "RU",
+		Channel:             "online",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+	}
+
+	score, flags := scoreBaselineDeviation(newTestBaseline(), tx, testBaselineConfig)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, flags)
+}
+
+func TestScoreBaselineDeviation_AnomalousTransaction(t *testing.T) {
+	tx := &models.Transaction{
+		Amount:              600000.0,
+		CounterpartyCountry: "AE",
+		Channel:             "atm",
+		Timestamp:           time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC),
+	}
+
+	score, flags := scoreBaselineDeviation(newTestBaseline(), tx, testBaselineConfig)
+	assert.Equal(t, 65, score)
+	assert.Contains(t, flags, "amount_anomaly_extreme")
+	assert.Contains(t, flags, "new_country")
+	assert.Contains(t, flags, "unusual_channel")
+	assert.Contains(t, flags, "unusual_hour_for_account")
+}
+
+func TestScoreBaselineDeviation_AdjacentHourIsTypical(t *testing.T) {
+	tx := &models.Transaction{
+		Amount:              50000.0,
+		CounterpartyCountry: "RU",
+		Channel:             "online",
+		Timestamp:           time.Date(2024, 1, 15, 15, 10, 0, 0, time.UTC),
+	}
+
+	_, flags := scoreBaselineDeviation(newTestBaseline(), tx, testBaselineConfig)
+	assert.NotContains(t, flags, "unusual_hour_for_account")
+}
+
+func TestScoreBaselineDeviation_WarmUp(t *testing.T) {
+	baseline := newTestBaseline()
+	baseline.TransactionCount = 5
+
+	tx := &models.Transaction{
+		Amount:              9000000.0,
+		CounterpartyCountry: "AE",
+		Channel:             "atm",
+		Timestamp:           time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC),
+	}
+
+	score, flags := scoreBaselineDeviation(baseline, tx, testBaselineConfig)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, flags)
+
+	score, flags = scoreBaselineDeviation(nil, tx, testBaselineConfig)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, flags)
+}
+
+func TestAnalyzeTransaction_BaselineUpdatedAfterScoring(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	analyzer := NewRiskAnalyzerWithOptions(mockRedis, Options{
+		Rules: config.RulesConfig{Baseline: testBaselineConfig},
+	})
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-BL-001",
+		AccountNumber:       "ACC123456",
+		Amount:              600000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		Channel:             "online",
+	}
+
+	setupBaseRedisMocks(mockRedis)
+	mockRedis.On("GetAccountBaseline", "ACC123456").Return(newTestBaseline(), nil)
+	mockRedis.On("UpdateAccountBaseline", "ACC123456", tx).Return(nil)
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.Contains(t, analysis.Flags, "amount_anomaly_extreme")
+	assert.NotContains(t, analysis.Flags, "new_country")
+	mockRedis.AssertExpectations(t)
+}
@@ -0,0 +1,54 @@
+package fraud
+
+import (
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// isOutgoingPayment проверяет, является ли операция платежом в пользу контрагента
+func isOutgoingPayment(tx *models.Transaction) bool {
+	return tx.CounterpartyAccount != "" && tx.TransactionType != "deposit"
+}
+
+// scoreNewBeneficiary оценивает платеж с учетом истории получателей счета
+// Классическая схема дропа: тестовый платеж новому получателю и крупный перевод вслед за ним
+func scoreNewBeneficiary(history *models.BeneficiaryHistory, tx *models.Transaction, cfg config.BeneficiaryRulesConfig) (int, []string) {
+	if history == nil {
+		return 0, nil
+	}
+
+	score := 0
+	var flags []string
+	isLarge := cfg.LargeAmount > 0 && tx.Amount >= cfg.LargeAmount
+
+	if history.FirstSeenAt == nil {
+		// Первый платеж этому получателю
+		if isLarge {
+			score += 30
+			flags = append(flags, "new_beneficiary_large_amount")
+		} else {
+			score += 5
+			flags = append(flags, "new_beneficiary")
+		}
+	} else if isLarge && cfg.RapidWindow > 0 {
+		// Крупный платеж вскоре после первого знакомства с получателем
+		txTime := tx.Timestamp
+		if txTime.IsZero() {
+			txTime = time.Now()
+		}
+		if txTime.Sub(*history.FirstSeenAt) <= cfg.RapidWindow {
+			score += 20
+			flags = append(flags, "new_beneficiary_rapid_large_amount")
+		}
+	}
+
+	// Новый банк получателя у счета, который уже платил в другие банки
+	if tx.CounterpartyBank != "" && history.KnownBanks > 0 && !history.BankKnown {
+		score += 5
+		flags = append(flags, "new_counterparty_bank")
+	}
+
+	return score, flags
+}
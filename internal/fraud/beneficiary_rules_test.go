@@ -0,0 +1,88 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBeneficiaryConfig = config.BeneficiaryRulesConfig{
+	Enabled:     true,
+	LargeAmount: 500000,
+	RapidWindow: 60 * time.Minute,
+}
+
+func TestScoreNewBeneficiary_FirstPayment(t *testing.T) {
+	history := &models.BeneficiaryHistory{KnownBeneficiaries: 3, KnownBanks: 1, BankKnown: true}
+
+	score, flags := scoreNewBeneficiary(history, &models.Transaction{Amount: 1000.0}, testBeneficiaryConfig)
+	assert.Equal(t, 5, score)
+	assert.Equal(t, []string{"new_beneficiary"}, flags)
+
+	score, flags = scoreNewBeneficiary(history, &models.Transaction{Amount: 750000.0}, testBeneficiaryConfig)
+	assert.Equal(t, 30, score)
+	assert.Equal(t, []string{"new_beneficiary_large_amount"}, flags)
+}
+
+func TestScoreNewBeneficiary_RapidLargePayment(t *testing.T) {
+	firstSeen := time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC)
+	history := &models.BeneficiaryHistory{FirstSeenAt: &firstSeen, KnownBeneficiaries: 1}
+
+	tx := &models.Transaction{Amount: 750000.0, Timestamp: firstSeen.Add(20 * time.Minute)}
+	score, flags := scoreNewBeneficiary(history, tx, testBeneficiaryConfig)
+	assert.Equal(t, 20, score)
+	assert.Equal(t, []string{"new_beneficiary_rapid_large_amount"}, flags)
+
+	// Вне окна получатель уже считается известным
+	tx.Timestamp = firstSeen.Add(3 * time.Hour)
+	score, flags = scoreNewBeneficiary(history, tx, testBeneficiaryConfig)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, flags)
+}
+
+func TestScoreNewBeneficiary_NewBank(t *testing.T) {
+	firstSeen := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	history := &models.BeneficiaryHistory{FirstSeenAt: &firstSeen, KnownBeneficiaries: 4, KnownBanks: 2}
+
+	tx := &models.Transaction{Amount: 1000.0, CounterpartyBank: "Unknown Bank"}
+	score, flags := scoreNewBeneficiary(history, tx, testBeneficiaryConfig)
+	assert.Equal(t, 5, score)
+	assert.Equal(t, []string{"new_counterparty_bank"}, flags)
+}
+
+func TestAnalyzeTransaction_BeneficiaryRecordedAfterScoring(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	analyzer := NewRiskAnalyzerWithOptions(mockRedis, Options{
+		Rules: config.RulesConfig{Beneficiary: testBeneficiaryConfig},
+	})
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-BN-001",
+		AccountNumber:       "ACC123456",
+		Amount:              750000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		CounterpartyBank:    "Sberbank",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		Channel:             "online",
+	}
+
+	setupBaseRedisMocks(mockRedis)
+	mockRedis.On("GetBeneficiaryHistory", "ACC123456", "ACC789012", "Sberbank").
+		Return(&models.BeneficiaryHistory{KnownBeneficiaries: 2, KnownBanks: 1, BankKnown: true}, nil)
+	mockRedis.On("RecordBeneficiary", "ACC123456", "ACC789012", "Sberbank", tx.Timestamp).Return(nil)
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.Contains(t, analysis.Flags, "new_beneficiary_large_amount")
+	mockRedis.AssertExpectations(t)
+}
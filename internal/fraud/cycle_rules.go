@@ -0,0 +1,15 @@
+package fraud
+
+import (
+	"bank-aml-system/internal/models"
+)
+
+// scoreCircularFlow оценивает циклы, которые замыкает перевод
+// Сами циклы ищутся по графу переводов при анализе и сохраняются после оценки
+func scoreCircularFlow(cycles []models.FlowCycle) (int, []string) {
+	if len(cycles) == 0 {
+		return 0, nil
+	}
+
+	return 40, []string{"circular_flow"}
+}
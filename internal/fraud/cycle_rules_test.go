@@ -0,0 +1,67 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testCycleConfig = config.CycleRulesConfig{
+	Enabled:         true,
+	MaxLength:       4,
+	Window:          24 * time.Hour,
+	AmountTolerance: 0.1,
+}
+
+func TestAnalyzeTransaction_CircularFlowRecorded(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	mockCycles := new(storagemocks.MockFlowCycleRepository)
+	analyzer := NewRiskAnalyzerWithOptions(mockRedis, Options{
+		FlowCycles: mockCycles,
+		Rules:      config.RulesConfig{Cycle: testCycleConfig},
+	})
+
+	// ACC789012 → ACC555555 → ACC123456, теперь ACC123456 возвращает средства в ACC789012
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	tx := &models.Transaction{
+		TransactionID:       "TXN-CY-003",
+		AccountNumber:       "ACC123456",
+		Amount:              950000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           start.Add(2 * time.Hour),
+		Channel:             "online",
+	}
+	since := tx.Timestamp.Add(-testCycleConfig.Window)
+
+	setupBaseRedisMocks(mockRedis)
+	mockRedis.On("GetTransferEdges", "ACC789012", since).Return([]models.TransferEdge{
+		{TransactionID: "TXN-CY-001", From: "ACC789012", To: "ACC555555", Amount: 1000000, Timestamp: start},
+	}, nil)
+	mockRedis.On("GetTransferEdges", "ACC555555", since).Return([]models.TransferEdge{
+		{TransactionID: "TXN-CY-002", From: "ACC555555", To: "ACC123456", Amount: 980000, Timestamp: start.Add(time.Hour)},
+	}, nil)
+	mockRedis.On("RecordTransferEdge", mock.MatchedBy(func(edge *models.TransferEdge) bool {
+		return edge.TransactionID == "TXN-CY-003" && edge.From == "ACC123456" && edge.To == "ACC789012"
+	})).Return(nil)
+	mockCycles.On("SaveFlowCycle", mock.MatchedBy(func(cycle *models.FlowCycle) bool {
+		return cycle.ClosingTransactionID == "TXN-CY-003" && cycle.Length == 3
+	})).Return(nil)
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.Contains(t, analysis.Flags, "circular_flow")
+	mockRedis.AssertExpectations(t)
+	mockCycles.AssertExpectations(t)
+}
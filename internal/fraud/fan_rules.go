@@ -0,0 +1,78 @@
+package fraud
+
+import (
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// fanObservation - сторона операции, для которой отслеживается число различных контрагентов
+type fanObservation struct {
+	account      string
+	direction    string
+	counterparty string
+	flag         string
+	threshold    int64
+}
+
+// fanObservations определяет, какие вееры затрагивает операция
+// Исходящий перевод расширяет исходящий веер плательщика и входящий веер получателя,
+// поступление - входящий веер счета
+func fanObservations(tx *models.Transaction, cfg config.FanRulesConfig) []fanObservation {
+	if tx.CounterpartyAccount == "" {
+		return nil
+	}
+
+	if models.FlowDirection(tx) == models.FlowInbound {
+		return []fanObservation{
+			{tx.AccountNumber, models.FlowInbound, tx.CounterpartyAccount, "fan_in", cfg.FanInThreshold},
+		}
+	}
+	return []fanObservation{
+		{tx.AccountNumber, models.FlowOutbound, tx.CounterpartyAccount, "fan_out", cfg.FanOutThreshold},
+		{tx.CounterpartyAccount, models.FlowInbound, tx.AccountNumber, "fan_in", cfg.FanInThreshold},
+	}
+}
+
+// checkFanPatterns проверяет веерные поступления и выплаты
+// Контрагенты, давшие срабатывание, возвращаются как доказательная база по флагу.
+// При live вееры читаются из Redis и сохраняются в inputs, иначе берутся из inputs
+func (r *RiskAnalyzer) checkFanPatterns(observations []fanObservation, txTime time.Time, inputs *models.RuleInputs, live bool) (int, []string, map[string][]string, error) {
+	score := 0
+	var flags []string
+	var evidence map[string][]string
+
+	for _, obs := range observations {
+		if obs.threshold <= 0 {
+			continue
+		}
+
+		key := obs.account + ":" + obs.direction
+		if live {
+			fan, err := r.redisClient.GetCounterpartyFan(obs.account, obs.direction, obs.counterparty, r.rules.Fan.Window, txTime)
+			if err != nil {
+				return 0, nil, nil, err
+			}
+			if fan != nil {
+				if inputs.Fans == nil {
+					inputs.Fans = make(map[string]*models.CounterpartyFan)
+				}
+				inputs.Fans[key] = fan
+			}
+		}
+		fan := inputs.Fans[key]
+		if fan == nil || fan.DistinctCount < obs.threshold {
+			continue
+		}
+
+		score += 25
+		flags = append(flags, obs.flag)
+		if evidence == nil {
+			evidence = make(map[string][]string)
+		}
+		evidence[obs.flag] = fan.Counterparties
+	}
+
+	return score, flags, evidence, nil
+}
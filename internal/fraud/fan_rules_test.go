@@ -0,0 +1,72 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFanConfig = config.FanRulesConfig{
+	Enabled:         true,
+	Window:          24 * time.Hour,
+	FanInThreshold:  10,
+	FanOutThreshold: 10,
+}
+
+func TestFanObservations(t *testing.T) {
+	outgoing := &models.Transaction{AccountNumber: "A", CounterpartyAccount: "B", TransactionType: "transfer"}
+	observations := fanObservations(outgoing, testFanConfig)
+	require.Len(t, observations, 2)
+	assert.Equal(t, fanObservation{"A", models.FlowOutbound, "B", "fan_out", 10}, observations[0])
+	assert.Equal(t, fanObservation{"B", models.FlowInbound, "A", "fan_in", 10}, observations[1])
+
+	deposit := &models.Transaction{AccountNumber: "A", CounterpartyAccount: "B", TransactionType: "deposit"}
+	observations = fanObservations(deposit, testFanConfig)
+	require.Len(t, observations, 1)
+	assert.Equal(t, fanObservation{"A", models.FlowInbound, "B", "fan_in", 10}, observations[0])
+
+	cash := &models.Transaction{AccountNumber: "A", TransactionType: "withdrawal"}
+	assert.Empty(t, fanObservations(cash, testFanConfig))
+}
+
+func TestAnalyzeTransaction_FanOutWithEvidence(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	analyzer := NewRiskAnalyzerWithOptions(mockRedis, Options{
+		Rules: config.RulesConfig{Fan: testFanConfig},
+	})
+
+	tx := &models.Transaction{
+		TransactionID:       "TXN-FAN-001",
+		AccountNumber:       "ACC123456",
+		Amount:              15000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		Channel:             "online",
+	}
+	recipients := []string{"ACC789012", "ACC000011", "ACC000012", "ACC000013"}
+
+	setupBaseRedisMocks(mockRedis)
+	mockRedis.On("GetCounterpartyFan", "ACC123456", models.FlowOutbound, "ACC789012", 24*time.Hour, tx.Timestamp).
+		Return(&models.CounterpartyFan{DistinctCount: 12, Counterparties: recipients}, nil)
+	mockRedis.On("GetCounterpartyFan", "ACC789012", models.FlowInbound, "ACC123456", 24*time.Hour, tx.Timestamp).
+		Return(&models.CounterpartyFan{DistinctCount: 2, Counterparties: []string{"ACC123456"}}, nil)
+	mockRedis.On("RecordCounterparty", "ACC123456", models.FlowOutbound, "ACC789012", tx.Timestamp).Return(nil)
+	mockRedis.On("RecordCounterparty", "ACC789012", models.FlowInbound, "ACC123456", tx.Timestamp).Return(nil)
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.Contains(t, analysis.Flags, "fan_out")
+	assert.NotContains(t, analysis.Flags, "fan_in")
+	assert.Equal(t, recipients, analysis.Evidence["fan_out"])
+	mockRedis.AssertExpectations(t)
+}
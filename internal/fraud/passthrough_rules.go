@@ -0,0 +1,40 @@
+package fraud
+
+import (
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// scorePassThrough оценивает исходящую операцию с учетом недавних поступлений на счет
+// Дроп получает крупную сумму и в течение короткого времени выводит почти все средства дальше
+func scorePassThrough(flows *models.AccountFlowSummary, tx *models.Transaction, cfg config.PassThroughRulesConfig) (int, []string) {
+	if flows == nil || flows.Inflow <= 0 || flows.LastInflowAt == nil {
+		return 0, nil
+	}
+	if flows.Inflow < cfg.MinInflow {
+		return 0, nil
+	}
+
+	// Учитываем и текущую операцию - именно она может довести вывод до порога
+	ratio := (flows.Outflow + tx.Amount) / flows.Inflow
+	if ratio < cfg.OutflowRatio {
+		return 0, nil
+	}
+
+	score := 35
+	flags := []string{"pass_through"}
+
+	// Вывод почти сразу после поступления - более сильный признак
+	txTime := tx.Timestamp
+	if txTime.IsZero() {
+		txTime = time.Now()
+	}
+	if cfg.Window > 0 && txTime.Sub(*flows.LastInflowAt) <= cfg.Window/4 {
+		score += 15
+		flags = append(flags, "pass_through_rapid")
+	}
+
+	return score, flags
+}
@@ -0,0 +1,93 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPassThroughConfig = config.PassThroughRulesConfig{
+	Enabled:      true,
+	Window:       60 * time.Minute,
+	MinInflow:    100000,
+	OutflowRatio: 0.8,
+}
+
+func TestScorePassThrough_RapidOutflow(t *testing.T) {
+	inflowAt := time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC)
+	flows := &models.AccountFlowSummary{
+		Inflow:        2000000,
+		InflowCount:   1,
+		FirstInflowAt: &inflowAt,
+		LastInflowAt:  &inflowAt,
+	}
+
+	tx := &models.Transaction{Amount: 1950000.0, Timestamp: inflowAt.Add(10 * time.Minute)}
+	score, flags := scorePassThrough(flows, tx, testPassThroughConfig)
+	assert.Equal(t, 50, score)
+	assert.Equal(t, []string{"pass_through", "pass_through_rapid"}, flags)
+
+	tx.Timestamp = inflowAt.Add(50 * time.Minute)
+	score, flags = scorePassThrough(flows, tx, testPassThroughConfig)
+	assert.Equal(t, 35, score)
+	assert.Equal(t, []string{"pass_through"}, flags)
+}
+
+func TestScorePassThrough_NotMuleBehaviour(t *testing.T) {
+	inflowAt := time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC)
+
+	// Выведена лишь малая часть поступления
+	flows := &models.AccountFlowSummary{Inflow: 2000000, InflowCount: 1, LastInflowAt: &inflowAt}
+	tx := &models.Transaction{Amount: 300000.0, Timestamp: inflowAt.Add(10 * time.Minute)}
+	score, flags := scorePassThrough(flows, tx, testPassThroughConfig)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, flags)
+
+	// Поступление ниже порога
+	flows = &models.AccountFlowSummary{Inflow: 50000, InflowCount: 1, LastInflowAt: &inflowAt}
+	tx = &models.Transaction{Amount: 50000.0, Timestamp: inflowAt.Add(10 * time.Minute)}
+	score, _ = scorePassThrough(flows, tx, testPassThroughConfig)
+	assert.Equal(t, 0, score)
+
+	// Поступлений в окне не было
+	score, _ = scorePassThrough(&models.AccountFlowSummary{}, tx, testPassThroughConfig)
+	assert.Equal(t, 0, score)
+}
+
+func TestAnalyzeTransaction_PassThroughFlowsRecorded(t *testing.T) {
+	mockRedis := new(mocks.MockClientInterface)
+	analyzer := NewRiskAnalyzerWithOptions(mockRedis, Options{
+		Rules: config.RulesConfig{PassThrough: testPassThroughConfig},
+	})
+
+	inflowAt := time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC)
+	tx := &models.Transaction{
+		TransactionID:       "TXN-PT-001",
+		AccountNumber:       "ACC123456",
+		Amount:              1950000.0,
+		Currency:            "RUB",
+		TransactionType:     "transfer",
+		CounterpartyCountry: "RU",
+		CounterpartyAccount: "ACC789012",
+		Timestamp:           inflowAt.Add(10 * time.Minute),
+		Channel:             "online",
+	}
+
+	setupBaseRedisMocks(mockRedis)
+	mockRedis.On("GetAccountFlows", "ACC123456", tx.Timestamp.Add(-time.Hour)).
+		Return(&models.AccountFlowSummary{Inflow: 2000000, InflowCount: 1, LastInflowAt: &inflowAt}, nil)
+	mockRedis.On("RecordAccountFlow", "ACC123456", models.FlowOutbound, "TXN-PT-001", 1950000.0, tx.Timestamp).Return(nil)
+
+	analysis, err := analyzer.AnalyzeTransaction(tx)
+	require.NoError(t, err)
+
+	assert.Contains(t, analysis.Flags, "pass_through")
+	assert.Contains(t, analysis.Flags, "pass_through_rapid")
+	mockRedis.AssertExpectations(t)
+}
@@ -3,8 +3,11 @@ package fraud
 import (
 	"time"
 
+	"bank-aml-system/config"
+	"bank-aml-system/internal/graph"
 	"bank-aml-system/internal/models"
 	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/storage"
 )
 
 const (
@@ -14,8 +17,23 @@ const (
 	HighFrequencyThreshold   = 10        // 10 транзакций в день
 )
 
+// RulesetVersion - версия набора правил и весов
+// Увеличивается при любом изменении правил, чтобы отчеты о точности флагов не смешивали разные версии
+const RulesetVersion = "2.0"
+
 type RiskAnalyzer struct {
-	redisClient redis.ClientInterface // Используем интерфейс для возможности мокирования
+	redisClient redis.ClientInterface       // Используем интерфейс для возможности мокирования
+	accounts    storage.AccountRepository   // Опциональный реестр счетов для контекстных правил
+	flowCycles  storage.FlowCycleRepository // Опциональное хранилище обнаруженных круговых потоков
+	cycles      *graph.Detector
+	rules       config.RulesConfig
+}
+
+// Options задает опциональные зависимости и настройки дополнительных правил
+type Options struct {
+	Accounts   storage.AccountRepository
+	FlowCycles storage.FlowCycleRepository
+	Rules      config.RulesConfig
 }
 
 func NewRiskAnalyzer(redisClient redis.ClientInterface) *RiskAnalyzer {
@@ -24,11 +42,50 @@ func NewRiskAnalyzer(redisClient redis.ClientInterface) *RiskAnalyzer {
 	}
 }
 
+// NewRiskAnalyzerWithOptions создает анализатор с дополнительными правилами
+func NewRiskAnalyzerWithOptions(redisClient redis.ClientInterface, opts Options) *RiskAnalyzer {
+	analyzer := &RiskAnalyzer{
+		redisClient: redisClient,
+		accounts:    opts.Accounts,
+		flowCycles:  opts.FlowCycles,
+		rules:       opts.Rules,
+	}
+	if opts.Rules.Cycle.Enabled {
+		analyzer.cycles = graph.NewDetector(redisClient, graph.Options{
+			MaxLength:       opts.Rules.Cycle.MaxLength,
+			Window:          opts.Rules.Cycle.Window,
+			AmountTolerance: opts.Rules.Cycle.AmountTolerance,
+		})
+	}
+	return analyzer
+}
+
 // AnalyzeTransaction выполняет полный анализ транзакции на предмет рисков
+// и учитывает ее в профилях счета (счетчики, поведенческий профиль, получатели, потоки)
 func (r *RiskAnalyzer) AnalyzeTransaction(tx *models.Transaction) (*models.RiskAnalysis, error) {
+	return r.analyze(tx, nil, true)
+}
+
+// RescoreTransaction повторно оценивает уже учтенную транзакцию без изменения профилей счета
+// Оценка выполняется по текущему набору правил и состоянию профилей inputs, сохраненному при анализе:
+// текущие профили уже учитывают саму операцию и более поздние операции счета.
+// Без inputs (анализ выполнен до их сохранения) используется текущее состояние профилей
+func (r *RiskAnalyzer) RescoreTransaction(tx *models.Transaction, inputs *models.RuleInputs) (*models.RiskAnalysis, error) {
+	return r.analyze(tx, inputs, false)
+}
+
+// analyze вычисляет оценку риска; при record транзакция учитывается в профилях счета
+// replay - сохраненное состояние профилей: при нем профили из Redis и хранилища не читаются
+func (r *RiskAnalyzer) analyze(tx *models.Transaction, replay *models.RuleInputs, record bool) (*models.RiskAnalysis, error) {
 	score := 0
 	var flags []string
 
+	live := replay == nil
+	inputs := replay
+	if live {
+		inputs = &models.RuleInputs{}
+	}
+
 	// 1. Проверка суммы транзакции (несколько уровней)
 	if tx.Amount >= VeryLargeAmountThreshold {
 		// Очень крупная сумма (>= 5 млн)
@@ -81,10 +138,14 @@ func (r *RiskAnalyzer) AnalyzeTransaction(tx *models.Transaction) (*models.RiskA
 	}
 
 	// 5. Проверка частоты операций
-	dailyCount, err := r.redisClient.GetAccountDailyCount(tx.AccountNumber)
-	if err != nil {
-		return nil, err
+	if live {
+		dailyCount, err := r.redisClient.GetAccountDailyCount(tx.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+		inputs.DailyCount = dailyCount
 	}
+	dailyCount := inputs.DailyCount
 	if dailyCount >= HighFrequencyThreshold {
 		score += 25
 		flags = append(flags, "high_frequency")
@@ -142,9 +203,103 @@ func (r *RiskAnalyzer) AnalyzeTransaction(tx *models.Transaction) (*models.RiskA
 		}
 	}
 
-	// Увеличиваем счетчик транзакций по счету
-	if err := r.redisClient.IncrementAccountDailyCount(tx.AccountNumber); err != nil {
-		return nil, err
+	// 10. Проверка в контексте профиля счета (возраст счета, оборот, рейтинг KYC)
+	if r.accounts != nil && r.rules.Account.Enabled {
+		points, accountFlags, err := r.checkAccountContext(tx, inputs, live)
+		if err != nil {
+			return nil, err
+		}
+		score += points
+		flags = append(flags, accountFlags...)
+	}
+
+	// 11. Проверка отклонения от поведенческого профиля счета
+	if r.rules.Baseline.Enabled {
+		if live {
+			baseline, err := r.redisClient.GetAccountBaseline(tx.AccountNumber)
+			if err != nil {
+				return nil, err
+			}
+			inputs.Baseline = baseline
+		}
+		points, baselineFlags := scoreBaselineDeviation(inputs.Baseline, tx, r.rules.Baseline)
+		score += points
+		flags = append(flags, baselineFlags...)
+	}
+
+	// Время операции для правил, работающих с временными окнами
+	txTime := tx.Timestamp
+	if txTime.IsZero() {
+		txTime = time.Now()
+	}
+
+	// 12. Проверка первого платежа новому получателю
+	checkBeneficiary := r.rules.Beneficiary.Enabled && isOutgoingPayment(tx)
+	if checkBeneficiary {
+		if live {
+			history, err := r.redisClient.GetBeneficiaryHistory(tx.AccountNumber, tx.CounterpartyAccount, tx.CounterpartyBank)
+			if err != nil {
+				return nil, err
+			}
+			inputs.Beneficiary = history
+		}
+		points, beneficiaryFlags := scoreNewBeneficiary(inputs.Beneficiary, tx, r.rules.Beneficiary)
+		score += points
+		flags = append(flags, beneficiaryFlags...)
+	}
+
+	// 13. Проверка транзитного движения средств (поступление и быстрый вывод)
+	if r.rules.PassThrough.Enabled && models.FlowDirection(tx) == models.FlowOutbound {
+		if live {
+			accountFlows, err := r.redisClient.GetAccountFlows(tx.AccountNumber, txTime.Add(-r.rules.PassThrough.Window))
+			if err != nil {
+				return nil, err
+			}
+			inputs.Flows = accountFlows
+		}
+		points, passThroughFlags := scorePassThrough(inputs.Flows, tx, r.rules.PassThrough)
+		score += points
+		flags = append(flags, passThroughFlags...)
+	}
+
+	// 14. Проверка круговых потоков средств A → B → ... → A
+	edge := models.TransferEdgeFromTransaction(tx)
+	if edge != nil {
+		edge.Timestamp = txTime
+	}
+	var flowCycles []models.FlowCycle
+	if r.cycles != nil && edge != nil {
+		if live {
+			found, err := r.cycles.FindCycles(*edge)
+			if err != nil {
+				return nil, err
+			}
+			inputs.Cycles = found
+		}
+		points, cycleFlags := scoreCircularFlow(inputs.Cycles)
+		score += points
+		flags = append(flags, cycleFlags...)
+		flowCycles = inputs.Cycles
+	}
+
+	// 15. Проверка веерных поступлений и выплат (много различных контрагентов за окно)
+	var fans []fanObservation
+	var evidence map[string][]string
+	if r.rules.Fan.Enabled {
+		fans = fanObservations(tx, r.rules.Fan)
+		points, fanFlags, fanEvidence, err := r.checkFanPatterns(fans, txTime, inputs, live)
+		if err != nil {
+			return nil, err
+		}
+		score += points
+		flags = append(flags, fanFlags...)
+		evidence = fanEvidence
+	}
+
+	if record {
+		if err := r.recordObservation(tx, txTime, checkBeneficiary, edge, fans, flowCycles); err != nil {
+			return nil, err
+		}
 	}
 
 	// Определяем уровень риска
@@ -159,9 +314,68 @@ func (r *RiskAnalyzer) AnalyzeTransaction(tx *models.Transaction) (*models.RiskA
 		Flags:          flags,
 		Recommendation: recommendation,
 		AnalyzedAt:     time.Now(),
+		Evidence:       evidence,
+		RulesetVersion: RulesetVersion,
+		Inputs:         inputs,
 	}, nil
 }
 
+// recordObservation учитывает оцененную транзакцию в профилях счета и сохраняет найденные циклы
+func (r *RiskAnalyzer) recordObservation(
+	tx *models.Transaction,
+	txTime time.Time,
+	checkBeneficiary bool,
+	edge *models.TransferEdge,
+	fans []fanObservation,
+	flowCycles []models.FlowCycle,
+) error {
+	// Увеличиваем счетчик транзакций по счету
+	if err := r.redisClient.IncrementAccountDailyCount(tx.AccountNumber); err != nil {
+		return err
+	}
+
+	// Обновляем поведенческий профиль уже после оценки, чтобы транзакция не сравнивалась сама с собой
+	if r.rules.Baseline.Enabled {
+		if err := r.redisClient.UpdateAccountBaseline(tx.AccountNumber, tx); err != nil {
+			return err
+		}
+	}
+
+	if checkBeneficiary {
+		if err := r.redisClient.RecordBeneficiary(tx.AccountNumber, tx.CounterpartyAccount, tx.CounterpartyBank, txTime); err != nil {
+			return err
+		}
+	}
+
+	if r.rules.PassThrough.Enabled {
+		if err := r.redisClient.RecordAccountFlow(tx.AccountNumber, models.FlowDirection(tx), tx.TransactionID, tx.Amount, txTime); err != nil {
+			return err
+		}
+	}
+
+	if r.cycles != nil && edge != nil {
+		if err := r.redisClient.RecordTransferEdge(edge); err != nil {
+			return err
+		}
+	}
+
+	for _, obs := range fans {
+		if err := r.redisClient.RecordCounterparty(obs.account, obs.direction, obs.counterparty, txTime); err != nil {
+			return err
+		}
+	}
+
+	// Сохраняем полный путь цикла для расследования
+	if r.flowCycles != nil {
+		for i := range flowCycles {
+			if err := r.flowCycles.SaveFlowCycle(&flowCycles[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // calculateRiskLevel определяет уровень риска на основе баллов
 func calculateRiskLevel(score int) string {
 	if score <= 30 {
@@ -104,10 +104,10 @@ func (g *TransactionGenerator) GenerateRandomTransaction() *models.Transaction {
 	// Случайное время (может быть ночным)
 	if g.rand.Float64() < 0.2 { // 20% вероятность ночного времени
 		hour := g.rand.Intn(6) // 00:00 - 06:00
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	} else {
 		hour := 6 + g.rand.Intn(18) // 06:00 - 24:00
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	}
 
 	return tx
@@ -123,7 +123,7 @@ func (g *TransactionGenerator) generateLowRisk(tx *models.Transaction) {
 	
 	// Обычное время (8:00 - 22:00) - 0 баллов
 	hour := 8 + g.rand.Intn(14)
-	tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+	tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	
 	// Обычный счет
 	tx.CounterpartyAccount = fmt.Sprintf("ACC%d", 2000000000+g.rand.Int63n(9999999999))
@@ -147,7 +147,7 @@ func (g *TransactionGenerator) generateMediumRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomOffshoreBank()
 		// Обычное время
 		hour := 8 + g.rand.Intn(14)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	case 1:
 		// Крупная сумма + ночное время = 30 + 15 = 45 баллов
 		tx.Amount = g.roundToTwoDecimals(1000000.0 + g.rand.Float64()*500000.0)
@@ -156,7 +156,7 @@ func (g *TransactionGenerator) generateMediumRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomBank()
 		// Ночное время
 		hour := g.rand.Intn(6)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	case 2:
 		// Средняя сумма + офшор + ночное время = 0 + 40 + 15 = 55 баллов
 		tx.Amount = g.roundToTwoDecimals(200000.0 + g.rand.Float64()*800000.0)
@@ -165,7 +165,7 @@ func (g *TransactionGenerator) generateMediumRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomOffshoreBank()
 		// Ночное время (00:00 - 06:00)
 		hour := g.rand.Intn(6)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	}
 }
 
@@ -186,7 +186,7 @@ func (g *TransactionGenerator) generateHighRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomOffshoreBank()
 		// Ночное время
 		hour := g.rand.Intn(6)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	case 1:
 		// Очень крупная сумма + офшор + ночное время = 30 + 40 + 15 = 85 баллов
 		tx.Amount = g.roundToTwoDecimals(3000000.0 + g.rand.Float64()*5000000.0)
@@ -195,7 +195,7 @@ func (g *TransactionGenerator) generateHighRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomOffshoreBank()
 		// Ночное время
 		hour := g.rand.Intn(6)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	case 2:
 		// Крупная сумма + офшор + ночное время + международный перевод = 30 + 40 + 15 = 85 баллов
 		tx.Amount = g.roundToTwoDecimals(2000000.0 + g.rand.Float64()*3000000.0)
@@ -205,7 +205,7 @@ func (g *TransactionGenerator) generateHighRisk(tx *models.Transaction) {
 		tx.CounterpartyBank = g.getRandomOffshoreBank()
 		// Ночное время
 		hour := g.rand.Intn(6)
-		tx.Timestamp = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), hour, g.rand.Intn(60), 0, 0, time.Local)
+		tx.Timestamp = g.recentTimeAt(hour, g.rand.Intn(60))
 	}
 }
 
@@ -234,3 +234,14 @@ func (g *TransactionGenerator) roundToTwoDecimals(value float64) float64 {
 	return math.Round(value*100) / 100
 }
 
+
+// recentTimeAt возвращает сегодняшнее время с заданными часом и минутой,
+// а если оно еще не наступило - то же время вчера: время операции не может быть в будущем
+func (g *TransactionGenerator) recentTimeAt(hour, minute int) time.Time {
+	now := time.Now()
+	t := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, time.Local)
+	if t.After(now) {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t
+}
@@ -0,0 +1,347 @@
+package goaml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// DateTimeFormat - формат дат goAML (без часового пояса, время UTC)
+const DateTimeFormat = "2006-01-02T15:04:05"
+
+// Коды сообщений
+const (
+	ReportCodeSTR = "STR" // Сообщение о подозрительной операции
+	ReportCodeSAR = "SAR" // Сообщение о подозрительной деятельности
+)
+
+// SubmissionElectronic - код электронной подачи сообщения
+const SubmissionElectronic = "E"
+
+// Способы проведения операции (transmode_code)
+const (
+	TransmodeTransfer      = "K"
+	TransmodeInternational = "I"
+	TransmodeCash          = "C"
+	TransmodePayment       = "P"
+)
+
+// Источники средств (from_funds_code / to_funds_code)
+const (
+	FundsAccount = "K"
+	FundsCash    = "C"
+)
+
+// Report - сообщение goAML
+type Report struct {
+	XMLName           xml.Name      `xml:"report"`
+	RentityID         int           `xml:"rentity_id"`
+	RentityBranch     string        `xml:"rentity_branch,omitempty"`
+	SubmissionCode    string        `xml:"submission_code"`
+	ReportCode        string        `xml:"report_code"`
+	EntityReference   string        `xml:"entity_reference"`
+	SubmissionDate    string        `xml:"submission_date"`
+	CurrencyCodeLocal string        `xml:"currency_code_local"`
+	ReportingPerson   PersonContact `xml:"reporting_person"`
+	Reason            string        `xml:"reason"`
+	Action            string        `xml:"action,omitempty"`
+	Transactions      []Transaction `xml:"transaction"`
+	Indicators        []string      `xml:"report_indicators>indicator"`
+}
+
+// PersonContact - ответственный сотрудник отчитывающейся организации
+type PersonContact struct {
+	FirstName string `xml:"first_name"`
+	LastName  string `xml:"last_name"`
+	Email     string `xml:"email,omitempty"`
+}
+
+// Transaction - операция в сообщении
+type Transaction struct {
+	Number        string `xml:"transactionnumber"`
+	InternalRef   string `xml:"internal_ref_number,omitempty"`
+	Location      string `xml:"transaction_location,omitempty"`
+	Description   string `xml:"transaction_description,omitempty"`
+	Date          string `xml:"date_transaction"`
+	TransmodeCode string `xml:"transmode_code"`
+	AmountLocal   string `xml:"amount_local"`
+
+	FromMyClient *FromParty `xml:"t_from_my_client,omitempty"`
+	From         *FromParty `xml:"t_from,omitempty"`
+	ToMyClient   *ToParty   `xml:"t_to_my_client,omitempty"`
+	To           *ToParty   `xml:"t_to,omitempty"`
+}
+
+// FromParty - отправитель средств
+type FromParty struct {
+	FundsCode       string           `xml:"from_funds_code"`
+	ForeignCurrency *ForeignCurrency `xml:"from_foreign_currency,omitempty"`
+	Account         *Account         `xml:"from_account,omitempty"`
+	Country         string           `xml:"from_country"`
+}
+
+// ToParty - получатель средств
+type ToParty struct {
+	FundsCode       string           `xml:"to_funds_code"`
+	ForeignCurrency *ForeignCurrency `xml:"to_foreign_currency,omitempty"`
+	Account         *Account         `xml:"to_account,omitempty"`
+	Country         string           `xml:"to_country"`
+}
+
+// ForeignCurrency - сумма операции в валюте, отличной от местной
+type ForeignCurrency struct {
+	CurrencyCode string `xml:"foreign_currency_code"`
+	Amount       string `xml:"foreign_amount"`
+	ExchangeRate string `xml:"foreign_exchange_rate"`
+}
+
+// Account - счет стороны операции; для счета клиента банка заполняются реквизиты и владелец
+type Account struct {
+	InstitutionName     string     `xml:"institution_name,omitempty"`
+	Swift               string     `xml:"swift,omitempty"`
+	Branch              string     `xml:"branch,omitempty"`
+	Account             string     `xml:"account"`
+	CurrencyCode        string     `xml:"currency_code,omitempty"`
+	ClientNumber        string     `xml:"client_number,omitempty"`
+	PersonalAccountType string     `xml:"personal_account_type,omitempty"`
+	Entity              *Entity    `xml:"t_entity,omitempty"`
+	Signatory           *Signatory `xml:"signatory,omitempty"`
+	Opened              string     `xml:"opened,omitempty"`
+	StatusCode          string     `xml:"status_code,omitempty"`
+}
+
+// Entity - юридическое лицо или ИП, владелец счета
+type Entity struct {
+	Name                     string `xml:"name"`
+	IncorporationCountryCode string `xml:"incorporation_country_code,omitempty"`
+}
+
+// Signatory - физическое лицо, владелец счета
+type Signatory struct {
+	Person Person `xml:"t_person"`
+}
+
+// Person - физическое лицо
+type Person struct {
+	FirstName string `xml:"first_name"`
+	LastName  string `xml:"last_name"`
+	Residence string `xml:"residence,omitempty"`
+}
+
+// Input - данные для формирования сообщения
+type Input struct {
+	ReportCode   string
+	Reference    string // Уникальный номер сообщения в системе банка
+	SubmittedAt  time.Time
+	Reason       string
+	Action       string
+	Transactions []*models.CaseTransaction
+	Profiles     map[string]*models.AccountProfile // Профили счетов клиентов банка по номеру счета
+}
+
+// Build формирует сообщение goAML по операциям кейса
+// Незаполненные реквизиты остаются пустыми - их перечислит проверка по схеме
+func Build(cfg config.GoAMLConfig, in Input) (*Report, error) {
+	report := &Report{
+		RentityID:         cfg.RentityID,
+		RentityBranch:     cfg.RentityBranch,
+		SubmissionCode:    SubmissionElectronic,
+		ReportCode:        in.ReportCode,
+		EntityReference:   in.Reference,
+		SubmissionDate:    formatDateTime(in.SubmittedAt),
+		CurrencyCodeLocal: cfg.CurrencyCodeLocal,
+		ReportingPerson: PersonContact{
+			FirstName: cfg.ReportingPersonFirstName,
+			LastName:  cfg.ReportingPersonLastName,
+			Email:     cfg.ReportingPersonEmail,
+		},
+		Reason: in.Reason,
+		Action: in.Action,
+	}
+
+	indicators := make(map[string]bool)
+	for _, ct := range in.Transactions {
+		tx, err := buildTransaction(cfg, ct, in.Profiles[ct.Transaction.AccountNumber])
+		if err != nil {
+			return nil, err
+		}
+		report.Transactions = append(report.Transactions, *tx)
+		for _, flag := range ct.Flags {
+			indicators[flag] = true
+		}
+	}
+	for flag := range indicators {
+		report.Indicators = append(report.Indicators, flag)
+	}
+	sort.Strings(report.Indicators)
+
+	return report, nil
+}
+
+// Marshal сериализует сообщение в XML с заголовком
+func Marshal(report *Report) ([]byte, error) {
+	body, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func buildTransaction(cfg config.GoAMLConfig, ct *models.CaseTransaction, profile *models.AccountProfile) (*Transaction, error) {
+	t := &ct.Transaction
+
+	amountLocal, foreign, err := convertAmount(cfg, t.Amount, t.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("transaction %s: %w", t.TransactionID, err)
+	}
+
+	tx := &Transaction{
+		Number:        t.TransactionID,
+		InternalRef:   ct.ProcessingID,
+		Location:      transactionLocation(t),
+		Description:   t.TransactionType,
+		Date:          formatDateTime(t.Timestamp),
+		TransmodeCode: transmodeCode(t.TransactionType),
+		AmountLocal:   formatAmount(amountLocal),
+	}
+
+	client := clientAccount(cfg, t, profile)
+	counterparty := counterpartyAccount(t)
+	counterpartyCountry := t.CounterpartyCountry
+	if counterpartyCountry == "" {
+		counterpartyCountry = cfg.CountryCodeLocal
+	}
+
+	switch models.FlowDirection(t) {
+	case models.FlowInbound:
+		from := &FromParty{FundsCode: FundsCash, ForeignCurrency: foreign, Country: counterpartyCountry}
+		if counterparty != nil {
+			from.FundsCode = FundsAccount
+			from.Account = counterparty
+		}
+		tx.From = from
+		tx.ToMyClient = &ToParty{FundsCode: FundsAccount, Account: client, Country: cfg.CountryCodeLocal}
+	default:
+		tx.FromMyClient = &FromParty{FundsCode: FundsAccount, ForeignCurrency: foreign, Account: client, Country: cfg.CountryCodeLocal}
+		to := &ToParty{FundsCode: FundsAccount, Account: counterparty, Country: counterpartyCountry}
+		if t.TransactionType == "withdrawal" {
+			to.FundsCode = FundsCash
+		}
+		tx.To = to
+	}
+
+	return tx, nil
+}
+
+// convertAmount пересчитывает сумму в местную валюту по курсу из конфигурации
+func convertAmount(cfg config.GoAMLConfig, amount float64, currency string) (float64, *ForeignCurrency, error) {
+	if currency == "" || strings.EqualFold(currency, cfg.CurrencyCodeLocal) {
+		return amount, nil, nil
+	}
+	rate, ok := cfg.ExchangeRates[strings.ToUpper(currency)]
+	if !ok {
+		return 0, nil, fmt.Errorf("no exchange rate configured for %s", currency)
+	}
+	return amount * rate, &ForeignCurrency{
+		CurrencyCode: strings.ToUpper(currency),
+		Amount:       formatAmount(amount),
+		ExchangeRate: strconv.FormatFloat(rate, 'f', -1, 64),
+	}, nil
+}
+
+// clientAccount заполняет реквизиты счета клиента банка и его владельца из реестра счетов
+func clientAccount(cfg config.GoAMLConfig, t *models.Transaction, profile *models.AccountProfile) *Account {
+	account := &Account{
+		InstitutionName: cfg.InstitutionName,
+		Swift:           cfg.InstitutionSwift,
+		Branch:          t.BranchID,
+		Account:         t.AccountNumber,
+		CurrencyCode:    t.Currency,
+	}
+	if profile == nil {
+		return account
+	}
+
+	customer := &profile.Customer
+	account.ClientNumber = customer.CustomerID
+	if profile.Account.Currency != "" {
+		account.CurrencyCode = profile.Account.Currency
+	}
+	if !profile.Account.OpenedAt.IsZero() {
+		account.Opened = formatDateTime(profile.Account.OpenedAt)
+	}
+	account.StatusCode = accountStatusCode(profile.Account.Status)
+
+	if customer.Segment == models.SegmentRetail {
+		account.PersonalAccountType = "A"
+		account.Signatory = &Signatory{Person: splitName(customer.FullName, customer.Country)}
+	} else {
+		account.PersonalAccountType = "B"
+		account.Entity = &Entity{Name: customer.FullName, IncorporationCountryCode: customer.Country}
+	}
+	return account
+}
+
+func counterpartyAccount(t *models.Transaction) *Account {
+	if t.CounterpartyAccount == "" {
+		return nil
+	}
+	return &Account{InstitutionName: t.CounterpartyBank, Swift: t.CounterpartyBIC, Account: t.CounterpartyAccount}
+}
+
+// splitName разбирает ФИО в порядке "Фамилия Имя Отчество"
+func splitName(fullName, country string) Person {
+	parts := strings.Fields(fullName)
+	person := Person{Residence: country}
+	if len(parts) > 0 {
+		person.LastName = parts[0]
+		person.FirstName = strings.Join(parts[1:], " ")
+	}
+	return person
+}
+
+func transmodeCode(transactionType string) string {
+	switch transactionType {
+	case "international_transfer":
+		return TransmodeInternational
+	case "deposit", "withdrawal":
+		return TransmodeCash
+	case "payment":
+		return TransmodePayment
+	default:
+		return TransmodeTransfer
+	}
+}
+
+func accountStatusCode(status string) string {
+	switch status {
+	case "", "active":
+		return "A"
+	case "closed":
+		return "C"
+	default:
+		return "B"
+	}
+}
+
+func transactionLocation(t *models.Transaction) string {
+	if t.BranchID != "" {
+		return t.Channel + ", branch " + t.BranchID
+	}
+	return t.Channel
+}
+
+func formatDateTime(ts time.Time) string {
+	return ts.UTC().Format(DateTimeFormat)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(math.Round(amount*100)/100, 'f', 2, 64)
+}
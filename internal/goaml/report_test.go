@@ -0,0 +1,195 @@
+package goaml
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.GoAMLConfig{
+	RentityID:                1024,
+	InstitutionName:          "Test Bank",
+	InstitutionSwift:         "TESTRUMM",
+	CountryCodeLocal:         "RU",
+	CurrencyCodeLocal:        "RUB",
+	ExchangeRates:            map[string]float64{"USD": 90},
+	ReportingPersonFirstName: "Ivan",
+	ReportingPersonLastName:  "Petrov",
+	ReportingPersonEmail:     "mlro@testbank.ru",
+}
+
+func testProfiles() map[string]*models.AccountProfile {
+	return map[string]*models.AccountProfile{
+		"40817810000000000001": {
+			Account: models.Account{
+				AccountNumber: "40817810000000000001",
+				CustomerID:    "C-1",
+				Currency:      "RUB",
+				OpenedAt:      time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+				Status:        "active",
+			},
+			Customer: models.Customer{
+				CustomerID: "C-1",
+				FullName:   "Sidorov Petr Ivanovich",
+				Segment:    models.SegmentRetail,
+				Residency:  models.ResidencyResident,
+				Country:    "RU",
+			},
+		},
+	}
+}
+
+func testInput(transactions ...*models.CaseTransaction) Input {
+	return Input{
+		ReportCode:   ReportCodeSTR,
+		Reference:    "SAR-2024-000001",
+		SubmittedAt:  time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+		Reason:       "Transit of funds through a newly opened account",
+		Transactions: transactions,
+		Profiles:     testProfiles(),
+	}
+}
+
+func caseTransaction(id, txType string, amount float64, currency string) *models.CaseTransaction {
+	return &models.CaseTransaction{
+		ProcessingID: "proc-" + id,
+		Flags:        []string{"pass_through", "new_account"},
+		Transaction: models.Transaction{
+			TransactionID:       id,
+			AccountNumber:       "40817810000000000001",
+			Amount:              amount,
+			Currency:            currency,
+			TransactionType:     txType,
+			CounterpartyAccount: "40702810000000000099",
+			CounterpartyBank:    "Other Bank",
+			CounterpartyCountry: "RU",
+			Timestamp:           time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			Channel:             "online",
+		},
+	}
+}
+
+func buildXML(t *testing.T, cfg config.GoAMLConfig, in Input) []byte {
+	report, err := Build(cfg, in)
+	require.NoError(t, err)
+	doc, err := Marshal(report)
+	require.NoError(t, err)
+	return doc
+}
+
+func TestBuild_ValidReport(t *testing.T) {
+	doc := buildXML(t, testConfig, testInput(
+		caseTransaction("T1", "transfer", 150000, "RUB"),
+		caseTransaction("T2", "deposit", 1000, "USD"),
+	))
+
+	require.NoError(t, Precheck(doc))
+
+	xml := string(doc)
+	assert.True(t, strings.HasPrefix(xml, "<?xml"))
+	assert.Contains(t, xml, "<entity_reference>SAR-2024-000001</entity_reference>")
+	assert.Contains(t, xml, "<t_from_my_client>")
+	assert.Contains(t, xml, "<t_to_my_client>")
+	assert.Contains(t, xml, "<last_name>Sidorov</last_name>")
+	assert.Contains(t, xml, "<first_name>Petr Ivanovich</first_name>")
+	assert.Contains(t, xml, "<amount_local>90000.00</amount_local>")
+	assert.Contains(t, xml, "<foreign_currency_code>USD</foreign_currency_code>")
+	assert.Equal(t, 1, strings.Count(xml, "<indicator>pass_through</indicator>"))
+}
+
+func TestBuild_CorporateClientIsEntity(t *testing.T) {
+	in := testInput(caseTransaction("T1", "payment", 5000, "RUB"))
+	profile := in.Profiles["40817810000000000001"]
+	profile.Customer.Segment = models.SegmentCorporate
+	profile.Customer.FullName = "OOO Romashka"
+
+	report, err := Build(testConfig, in)
+	require.NoError(t, err)
+
+	account := report.Transactions[0].FromMyClient.Account
+	require.NotNil(t, account.Entity)
+	assert.Nil(t, account.Signatory)
+	assert.Equal(t, "B", account.PersonalAccountType)
+	assert.Equal(t, TransmodePayment, report.Transactions[0].TransmodeCode)
+}
+
+func TestBuild_MissingExchangeRate(t *testing.T) {
+	_, err := Build(testConfig, testInput(caseTransaction("T1", "transfer", 100, "EUR")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EUR")
+}
+
+func TestPrecheck_ReportsMissingRequisites(t *testing.T) {
+	in := testInput(caseTransaction("T1", "withdrawal", 100, "RUB"))
+	in.Profiles = nil
+
+	doc := buildXML(t, config.GoAMLConfig{CountryCodeLocal: "RU", CurrencyCodeLocal: "RUB"}, in)
+	err := Precheck(doc)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	problems := strings.Join(validationErr.Problems, "\n")
+	assert.Contains(t, problems, "/report/rentity_id")
+	assert.Contains(t, problems, "/report/reporting_person/first_name")
+	assert.Contains(t, problems, "/report/transaction/t_from_my_client/from_account/institution_name")
+	assert.Contains(t, problems, "one of t_entity, signatory is required")
+}
+
+func TestPrecheck_RejectsUnexpectedElements(t *testing.T) {
+	doc := buildXML(t, testConfig, testInput(caseTransaction("T1", "transfer", 100, "RUB")))
+	doc = []byte(strings.Replace(string(doc), "<reason>", "<comments>x</comments><reason>", 1))
+
+	err := Precheck(doc)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/report/comments: unexpected element")
+}
+
+func TestPrecheck_Malformed(t *testing.T) {
+	err := Precheck([]byte("<report><rentity_id>1</report>"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "malformed XML")
+}
+
+func newTestSchemaValidator(t *testing.T) *SchemaValidator {
+	if _, err := exec.LookPath("xmllint"); err != nil {
+		t.Skip("xmllint is not installed")
+	}
+	// Вместо официальной схемы регулятора используется встроенная: проверяется работа с xmllint
+	v, err := NewSchemaValidator("schema/goaml.xsd", "xmllint")
+	require.NoError(t, err)
+	return v
+}
+
+func TestSchemaValidator_ValidReport(t *testing.T) {
+	v := newTestSchemaValidator(t)
+	doc := buildXML(t, testConfig, testInput(caseTransaction("T1", "transfer", 150000, "RUB")))
+
+	assert.NoError(t, v.Validate(doc))
+}
+
+func TestSchemaValidator_ReportsProblems(t *testing.T) {
+	v := newTestSchemaValidator(t)
+	doc := buildXML(t, testConfig, testInput(caseTransaction("T1", "transfer", 100, "RUB")))
+	doc = []byte(strings.Replace(string(doc), "<reason>", "<comments>x</comments><reason>", 1))
+
+	err := v.Validate(doc)
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.True(t, validationErr.Official)
+	assert.Contains(t, strings.Join(validationErr.Problems, "\n"), "Element 'comments': This element is not expected")
+	assert.Contains(t, err.Error(), "goAML schema validation failed")
+}
+
+func TestNewSchemaValidator_MissingSchema(t *testing.T) {
+	_, err := NewSchemaValidator("schema/missing.xsd", "xmllint")
+	assert.Error(t, err)
+}
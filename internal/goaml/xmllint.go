@@ -0,0 +1,86 @@
+package goaml
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// xmllintTimeout ограничивает время проверки одного сообщения
+const xmllintTimeout = 30 * time.Second
+
+// Коды завершения xmllint, означающие ошибку в самом сообщении, а не в схеме или запуске
+const (
+	xmllintExitInvalid   = 3 // Сообщение не соответствует схеме
+	xmllintExitMalformed = 4 // Сообщение не является корректным XML
+)
+
+// SchemaValidator проверяет сообщения по официальной схеме goAML регулятора валидатором xmllint (libxml2)
+// Схема не встраивается в код: ее версию определяет регулятор, а путь задается GOAML_XSD_PATH
+type SchemaValidator struct {
+	schemaPath string
+	xmllint    string
+}
+
+// NewSchemaValidator проверяет, что схема и xmllint доступны, и создает валидатор
+func NewSchemaValidator(schemaPath, xmllint string) (*SchemaValidator, error) {
+	if _, err := os.Stat(schemaPath); err != nil {
+		return nil, fmt.Errorf("goAML schema is not available: %w", err)
+	}
+	path, err := exec.LookPath(xmllint)
+	if err != nil {
+		return nil, fmt.Errorf("xmllint is required to validate against %s: %w", schemaPath, err)
+	}
+	return &SchemaValidator{schemaPath: schemaPath, xmllint: path}, nil
+}
+
+// Validate проверяет XML сообщения по схеме регулятора
+// Несоответствия возвращаются как *ValidationError; прочие ошибки означают, что проверку выполнить не удалось
+func (v *SchemaValidator) Validate(doc []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), xmllintTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, v.xmllint, "--noout", "--nonet", "--schema", v.schemaPath, "-")
+	cmd.Stdin = bytes.NewReader(doc)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Errorf("failed to run xmllint: %w", err)
+	}
+
+	code := exitErr.ExitCode()
+	if problems := parseXMLLintOutput(stderr.String()); len(problems) > 0 && (code == xmllintExitInvalid || code == xmllintExitMalformed) {
+		return &ValidationError{Problems: problems, Official: true}
+	}
+	return fmt.Errorf("xmllint failed with exit code %d: %s", code, strings.TrimSpace(stderr.String()))
+}
+
+// parseXMLLintOutput выбирает из вывода xmllint сообщения об ошибках в документе, прочитанном из stdin
+// Строки имеют вид "-:12: Schemas validity error : Element 'x': ..."; строки с фрагментом документа пропускаются
+func parseXMLLintOutput(output string) []string {
+	var problems []string
+	for _, line := range strings.Split(output, "\n") {
+		rest, ok := strings.CutPrefix(line, "-:")
+		if !ok {
+			continue
+		}
+		lineNo, message, ok := strings.Cut(rest, ": ")
+		if !ok {
+			continue
+		}
+		message = strings.TrimPrefix(message, "Schemas validity error : ")
+		problems = append(problems, fmt.Sprintf("line %s: %s", lineNo, strings.TrimSpace(message)))
+	}
+	return problems
+}
@@ -0,0 +1,392 @@
+package goaml
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Упрощенная схема описывает только подмножество элементов, которое заполняет система, и хранится вместе с кодом.
+// Проверка по ней - предварительная (полнота и формат реквизитов) и не подтверждает соответствие
+// официальной схеме goAML: для этого задается GOAML_XSD_PATH (см. SchemaValidator)
+//
+//go:embed schema/goaml.xsd
+var schemaXSD []byte
+
+// ValidationError содержит все найденные несоответствия сообщения схеме
+type ValidationError struct {
+	Problems []string
+	Official bool // Несоответствия найдены по официальной схеме регулятора, а не предварительной проверкой
+}
+
+// Summary возвращает вид проверки, которую не прошло сообщение
+func (e *ValidationError) Summary() string {
+	if e.Official {
+		return "goAML schema validation failed"
+	}
+	return "goAML precheck failed"
+}
+
+func (e *ValidationError) Error() string {
+	return e.Summary() + ": " + strings.Join(e.Problems, "; ")
+}
+
+// Precheck проверяет XML сообщения по встроенной упрощенной схеме
+func Precheck(doc []byte) error {
+	s, err := loadSchema()
+	if err != nil {
+		return err
+	}
+	return s.validate(doc)
+}
+
+var (
+	schemaOnce   sync.Once
+	parsedSchema *schema
+	schemaErr    error
+)
+
+func loadSchema() (*schema, error) {
+	schemaOnce.Do(func() {
+		parsedSchema, schemaErr = parseSchema(schemaXSD)
+	})
+	return parsedSchema, schemaErr
+}
+
+// Поддерживается подмножество XSD, которого достаточно для упрощенной схемы:
+// именованные complexType с sequence/choice, minOccurs/maxOccurs и simpleType с ограничениями
+// enumeration, minLength, maxLength, pattern, minInclusive поверх встроенных типов.
+
+type xsdParticle struct {
+	XMLName   xml.Name
+	Name      string        `xml:"name,attr"`
+	Type      string        `xml:"type,attr"`
+	MinOccurs string        `xml:"minOccurs,attr"`
+	MaxOccurs string        `xml:"maxOccurs,attr"`
+	Items     []xsdParticle `xml:",any"`
+}
+
+type xsdComplexType struct {
+	Name     string       `xml:"name,attr"`
+	Sequence *xsdParticle `xml:"sequence"`
+}
+
+type xsdFacet struct {
+	Value string `xml:"value,attr"`
+}
+
+type xsdSimpleType struct {
+	Name        string `xml:"name,attr"`
+	Restriction struct {
+		Base         string     `xml:"base,attr"`
+		Enumerations []xsdFacet `xml:"enumeration"`
+		MinLength    *xsdFacet  `xml:"minLength"`
+		MaxLength    *xsdFacet  `xml:"maxLength"`
+		Pattern      *xsdFacet  `xml:"pattern"`
+		MinInclusive *xsdFacet  `xml:"minInclusive"`
+	} `xml:"restriction"`
+}
+
+type xsdSchema struct {
+	Elements     []xsdParticle    `xml:"element"`
+	ComplexTypes []xsdComplexType `xml:"complexType"`
+	SimpleTypes  []xsdSimpleType  `xml:"simpleType"`
+}
+
+type simpleType struct {
+	base         string
+	enumerations []string
+	minLength    int
+	maxLength    int
+	pattern      *regexp.Regexp
+	minInclusive *float64
+}
+
+type schema struct {
+	roots        map[string]string // Имя корневого элемента -> тип
+	complexTypes map[string]*xsdParticle
+	simpleTypes  map[string]*simpleType
+}
+
+func parseSchema(data []byte) (*schema, error) {
+	var raw xsdSchema
+	if err := xml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse goAML schema: %w", err)
+	}
+
+	s := &schema{
+		roots:        make(map[string]string),
+		complexTypes: make(map[string]*xsdParticle),
+		simpleTypes:  make(map[string]*simpleType),
+	}
+	for _, el := range raw.Elements {
+		s.roots[el.Name] = el.Type
+	}
+	for i := range raw.ComplexTypes {
+		ct := raw.ComplexTypes[i]
+		if ct.Sequence == nil {
+			return nil, fmt.Errorf("goAML schema: complexType %s has no sequence", ct.Name)
+		}
+		s.complexTypes[ct.Name] = ct.Sequence
+	}
+	for _, st := range raw.SimpleTypes {
+		r := st.Restriction
+		t := &simpleType{base: r.Base, maxLength: -1}
+		for _, e := range r.Enumerations {
+			t.enumerations = append(t.enumerations, e.Value)
+		}
+		if r.MinLength != nil {
+			t.minLength, _ = strconv.Atoi(r.MinLength.Value)
+		}
+		if r.MaxLength != nil {
+			t.maxLength, _ = strconv.Atoi(r.MaxLength.Value)
+		}
+		if r.Pattern != nil {
+			// Шаблоны XSD неявно привязаны к началу и концу значения
+			re, err := regexp.Compile(`^(?:` + r.Pattern.Value + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("goAML schema: simpleType %s: %w", st.Name, err)
+			}
+			t.pattern = re
+		}
+		if r.MinInclusive != nil {
+			v, err := strconv.ParseFloat(r.MinInclusive.Value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("goAML schema: simpleType %s: %w", st.Name, err)
+			}
+			t.minInclusive = &v
+		}
+		s.simpleTypes[st.Name] = t
+	}
+	return s, nil
+}
+
+// node - элемент проверяемого документа
+type node struct {
+	name     string
+	text     string
+	children []*node
+}
+
+func parseDocument(doc []byte) (*node, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(doc))
+	var stack []*node
+	var root *node
+	for {
+		tok, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name.Local}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("multiple root elements")
+				}
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("empty document")
+	}
+	return root, nil
+}
+
+type validator struct {
+	schema   *schema
+	problems []string
+}
+
+func (s *schema) validate(doc []byte) error {
+	root, err := parseDocument(doc)
+	if err != nil {
+		return &ValidationError{Problems: []string{"malformed XML: " + err.Error()}}
+	}
+
+	v := &validator{schema: s}
+	typeName, ok := s.roots[root.name]
+	if !ok {
+		v.addf("/%s: unexpected root element", root.name)
+	} else {
+		v.element(root, typeName, "/"+root.name)
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+func (v *validator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) element(n *node, typeName, path string) {
+	if content, ok := v.schema.complexTypes[typeName]; ok {
+		pos := v.sequence(content, n.children, 0, path)
+		if pos < len(n.children) {
+			v.addf("%s/%s: unexpected element", path, n.children[pos].name)
+		}
+		return
+	}
+
+	if len(n.children) > 0 {
+		v.addf("%s: element must not contain child elements", path)
+		return
+	}
+	v.simple(strings.TrimSpace(n.text), typeName, path)
+}
+
+// sequence сопоставляет дочерние элементы начиная с pos с моделью sequence и возвращает позицию после нее
+func (v *validator) sequence(seq *xsdParticle, children []*node, pos int, path string) int {
+	for i := range seq.Items {
+		p := &seq.Items[i]
+		switch p.XMLName.Local {
+		case "element":
+			pos = v.particle(p, children, pos, path)
+		case "choice":
+			pos = v.choice(p, children, pos, path)
+		}
+	}
+	return pos
+}
+
+// particle сопоставляет подряд идущие элементы с одним объявлением element
+func (v *validator) particle(p *xsdParticle, children []*node, pos int, path string) int {
+	minOccurs, maxOccurs := occurs(p)
+	count := 0
+	for pos < len(children) && children[pos].name == p.Name && (maxOccurs < 0 || count < maxOccurs) {
+		v.element(children[pos], p.Type, path+"/"+p.Name)
+		pos++
+		count++
+	}
+	if count < minOccurs {
+		v.addf("%s/%s: required element is missing", path, p.Name)
+	}
+	return pos
+}
+
+// choice выбирает альтернативу по имени очередного элемента
+func (v *validator) choice(p *xsdParticle, children []*node, pos int, path string) int {
+	minOccurs, _ := occurs(p)
+	var names []string
+	for i := range p.Items {
+		alt := &p.Items[i]
+		names = append(names, alt.Name)
+		if pos < len(children) && children[pos].name == alt.Name {
+			return v.particle(alt, children, pos, path)
+		}
+	}
+	if minOccurs > 0 {
+		v.addf("%s: one of %s is required", path, strings.Join(names, ", "))
+	}
+	return pos
+}
+
+func occurs(p *xsdParticle) (int, int) {
+	minOccurs, maxOccurs := 1, 1
+	if p.MinOccurs != "" {
+		minOccurs, _ = strconv.Atoi(p.MinOccurs)
+	}
+	if p.MaxOccurs == "unbounded" {
+		maxOccurs = -1
+	} else if p.MaxOccurs != "" {
+		maxOccurs, _ = strconv.Atoi(p.MaxOccurs)
+	}
+	return minOccurs, maxOccurs
+}
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+func (v *validator) simple(value, typeName, path string) {
+	t, ok := v.schema.simpleTypes[typeName]
+	if !ok {
+		if err := checkBuiltin(value, typeName); err != nil {
+			v.addf("%s: %v", path, err)
+		}
+		return
+	}
+
+	if err := checkBuiltin(value, t.base); err != nil {
+		v.addf("%s: %v", path, err)
+		return
+	}
+	if len(t.enumerations) > 0 && !contains(t.enumerations, value) {
+		v.addf("%s: value %q is not one of %s", path, value, strings.Join(t.enumerations, ", "))
+	}
+	length := utf8.RuneCountInString(value)
+	if length < t.minLength {
+		v.addf("%s: value must be at least %d characters", path, t.minLength)
+	}
+	if t.maxLength >= 0 && length > t.maxLength {
+		v.addf("%s: value must be at most %d characters", path, t.maxLength)
+	}
+	if t.pattern != nil && !t.pattern.MatchString(value) {
+		v.addf("%s: value %q does not match the required format", path, value)
+	}
+	if t.minInclusive != nil {
+		if number, err := strconv.ParseFloat(value, 64); err == nil && number < *t.minInclusive {
+			v.addf("%s: value must be at least %v", path, *t.minInclusive)
+		}
+	}
+}
+
+func checkBuiltin(value, typeName string) error {
+	switch strings.TrimPrefix(typeName, "xs:") {
+	case "string":
+		return nil
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("value %q is not an integer", value)
+		}
+	case "decimal":
+		if !decimalPattern.MatchString(value) {
+			return fmt.Errorf("value %q is not a decimal", value)
+		}
+	case "dateTime":
+		if _, err := time.Parse("2006-01-02T15:04:05", value); err != nil {
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				return fmt.Errorf("value %q is not a dateTime", value)
+			}
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return fmt.Errorf("value %q is not a date", value)
+		}
+	default:
+		return fmt.Errorf("unsupported schema type %s", typeName)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
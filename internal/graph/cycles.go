@@ -0,0 +1,148 @@
+// Package graph строит граф переводов между счетами и ищет в нем круговые потоки средств
+package graph
+
+import (
+	"math"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// maxCyclesPerTransaction ограничивает число циклов, возвращаемых для одной транзакции
+const maxCyclesPerTransaction = 10
+
+// EdgeSource предоставляет исходящие ребра счета - скользящее окно графа переводов
+type EdgeSource interface {
+	GetTransferEdges(accountNumber string, since time.Time) ([]models.TransferEdge, error)
+}
+
+// Options содержит параметры поиска циклов
+type Options struct {
+	MaxLength       int           // Максимальное число переводов в цикле, включая замыкающий
+	Window          time.Duration // Цикл должен уложиться в окно до замыкающей транзакции
+	AmountTolerance float64       // Допустимое относительное отклонение суммы каждого перевода от замыкающего
+}
+
+// Detector ищет циклы, которые замыкает новая транзакция
+type Detector struct {
+	source EdgeSource
+	opts   Options
+}
+
+// NewDetector создает детектор круговых потоков
+func NewDetector(source EdgeSource, opts Options) *Detector {
+	return &Detector{source: source, opts: opts}
+}
+
+// FindCycles ищет пути closing.To → ... → closing.From среди ранее совершенных переводов
+// Вместе с замыкающим ребром такой путь образует цикл. Переводы в пути идут в хронологическом
+// порядке, укладываются в окно и по сумме отличаются от замыкающего не больше, чем на допуск
+func (d *Detector) FindCycles(closing models.TransferEdge) ([]models.FlowCycle, error) {
+	if d.opts.MaxLength < 2 || closing.From == closing.To {
+		return nil, nil
+	}
+
+	search := &cycleSearch{
+		detector: d,
+		closing:  closing,
+		since:    closing.Timestamp.Add(-d.opts.Window),
+		edges:    make(map[string][]models.TransferEdge),
+		visited:  map[string]bool{closing.To: true},
+	}
+	if err := search.walk(closing.To, time.Time{}, nil); err != nil {
+		return nil, err
+	}
+
+	return search.cycles, nil
+}
+
+// cycleSearch хранит состояние обхода в глубину для одной замыкающей транзакции
+type cycleSearch struct {
+	detector *Detector
+	closing  models.TransferEdge
+	since    time.Time
+	edges    map[string][]models.TransferEdge // Кэш исходящих ребер, чтобы не читать счет повторно
+	visited  map[string]bool
+	cycles   []models.FlowCycle
+}
+
+func (s *cycleSearch) walk(account string, after time.Time, path []models.TransferEdge) error {
+	if len(s.cycles) >= maxCyclesPerTransaction {
+		return nil
+	}
+	// Еще одно ребро пути плюс замыкающее не должны превышать MaxLength
+	if len(path)+2 > s.detector.opts.MaxLength {
+		return nil
+	}
+
+	outgoing, err := s.outgoing(account)
+	if err != nil {
+		return err
+	}
+
+	for _, edge := range outgoing {
+		if edge.TransactionID == s.closing.TransactionID {
+			continue
+		}
+		if edge.Timestamp.Before(after) || edge.Timestamp.After(s.closing.Timestamp) {
+			continue
+		}
+		if !s.withinTolerance(edge.Amount) {
+			continue
+		}
+
+		if edge.To == s.closing.From {
+			s.addCycle(append(append([]models.TransferEdge{}, path...), edge))
+			continue
+		}
+		if s.visited[edge.To] {
+			continue
+		}
+
+		s.visited[edge.To] = true
+		if err := s.walk(edge.To, edge.Timestamp, append(path, edge)); err != nil {
+			return err
+		}
+		delete(s.visited, edge.To)
+	}
+
+	return nil
+}
+
+func (s *cycleSearch) outgoing(account string) ([]models.TransferEdge, error) {
+	if edges, ok := s.edges[account]; ok {
+		return edges, nil
+	}
+	edges, err := s.detector.source.GetTransferEdges(account, s.since)
+	if err != nil {
+		return nil, err
+	}
+	s.edges[account] = edges
+	return edges, nil
+}
+
+func (s *cycleSearch) withinTolerance(amount float64) bool {
+	base := math.Max(amount, s.closing.Amount)
+	if base <= 0 {
+		return false
+	}
+	return math.Abs(amount-s.closing.Amount)/base <= s.detector.opts.AmountTolerance
+}
+
+func (s *cycleSearch) addCycle(path []models.TransferEdge) {
+	edges := append(path, s.closing)
+
+	cycle := models.FlowCycle{
+		ClosingTransactionID: s.closing.TransactionID,
+		Accounts:             make([]string, 0, len(edges)+1),
+		Edges:                edges,
+		Length:               len(edges),
+	}
+	cycle.Accounts = append(cycle.Accounts, s.closing.To)
+	for _, edge := range edges {
+		cycle.Accounts = append(cycle.Accounts, edge.To)
+		cycle.TotalAmount += edge.Amount
+	}
+
+	s.cycles = append(s.cycles, cycle)
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticSource - граф переводов в памяти
+type staticSource map[string][]models.TransferEdge
+
+func (s staticSource) GetTransferEdges(accountNumber string, since time.Time) ([]models.TransferEdge, error) {
+	var edges []models.TransferEdge
+	for _, edge := range s[accountNumber] {
+		if !edge.Timestamp.Before(since) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges, nil
+}
+
+var testOptions = Options{MaxLength: 4, Window: 24 * time.Hour, AmountTolerance: 0.1}
+
+func edgeAt(id, from, to string, amount float64, at time.Time) models.TransferEdge {
+	return models.TransferEdge{TransactionID: id, From: from, To: to, Amount: amount, Timestamp: at}
+}
+
+func TestFindCycles_ThreeAccounts(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	source := staticSource{
+		"A": {edgeAt("T1", "A", "B", 1000000, start)},
+		"B": {edgeAt("T2", "B", "C", 980000, start.Add(time.Hour))},
+	}
+
+	closing := edgeAt("T3", "C", "A", 960000, start.Add(2*time.Hour))
+	cycles, err := NewDetector(source, testOptions).FindCycles(closing)
+	require.NoError(t, err)
+	require.Len(t, cycles, 1)
+
+	cycle := cycles[0]
+	assert.Equal(t, "T3", cycle.ClosingTransactionID)
+	assert.Equal(t, []string{"A", "B", "C", "A"}, cycle.Accounts)
+	assert.Equal(t, 3, cycle.Length)
+	assert.Equal(t, "T1", cycle.Edges[0].TransactionID)
+	assert.Equal(t, "T3", cycle.Edges[2].TransactionID)
+	assert.InDelta(t, 2940000, cycle.TotalAmount, 0.01)
+}
+
+func TestFindCycles_RespectsConstraints(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	closing := edgeAt("T3", "C", "A", 1000000, start.Add(2*time.Hour))
+
+	tests := []struct {
+		name   string
+		source staticSource
+		opts   Options
+	}{
+		{
+			name: "amount outside tolerance",
+			source: staticSource{
+				"A": {edgeAt("T1", "A", "B", 1000000, start)},
+				"B": {edgeAt("T2", "B", "C", 500000, start.Add(time.Hour))},
+			},
+			opts: testOptions,
+		},
+		{
+			name: "transfers out of order",
+			source: staticSource{
+				"A": {edgeAt("T1", "A", "B", 1000000, start.Add(time.Hour))},
+				"B": {edgeAt("T2", "B", "C", 1000000, start)},
+			},
+			opts: testOptions,
+		},
+		{
+			name: "cycle longer than max length",
+			source: staticSource{
+				"A": {edgeAt("T1", "A", "B", 1000000, start)},
+				"B": {edgeAt("T2", "B", "C", 1000000, start.Add(time.Hour))},
+			},
+			opts: Options{MaxLength: 2, Window: 24 * time.Hour, AmountTolerance: 0.1},
+		},
+		{
+			name: "first transfer outside window",
+			source: staticSource{
+				"A": {edgeAt("T1", "A", "B", 1000000, start.Add(-48*time.Hour))},
+				"B": {edgeAt("T2", "B", "C", 1000000, start.Add(time.Hour))},
+			},
+			opts: testOptions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycles, err := NewDetector(tt.source, tt.opts).FindCycles(closing)
+			require.NoError(t, err)
+			assert.Empty(t, cycles)
+		})
+	}
+}
+
+func TestFindCycles_NoInnerLoops(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	source := staticSource{
+		"A": {edgeAt("T1", "A", "B", 1000000, start)},
+		"B": {edgeAt("T2", "B", "A", 1000000, start.Add(time.Minute)), edgeAt("T3", "B", "C", 1000000, start.Add(time.Hour))},
+	}
+
+	closing := edgeAt("T4", "C", "A", 1000000, start.Add(2*time.Hour))
+	cycles, err := NewDetector(source, testOptions).FindCycles(closing)
+	require.NoError(t, err)
+	require.Len(t, cycles, 1)
+	assert.Equal(t, []string{"A", "B", "C", "A"}, cycles[0].Accounts)
+}
@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	transaction "bank-aml-system/api/proto"
+)
+
+// AccountGRPCServer реализует gRPC сервис реестра клиентов и счетов
+type AccountGRPCServer struct {
+	transaction.UnimplementedAccountServiceServer
+	accountService  services.AccountService
+	activityService services.AccountActivityService
+}
+
+// NewAccountGRPCServer создает gRPC сервер реестра клиентов и счетов
+func NewAccountGRPCServer(accountService services.AccountService, activityService services.AccountActivityService) *AccountGRPCServer {
+	return &AccountGRPCServer{accountService: accountService, activityService: activityService}
+}
+
+// Register регистрирует сервис на gRPC сервере
+func (s *AccountGRPCServer) Register(server *grpc.Server) {
+	transaction.RegisterAccountServiceServer(server, s)
+}
+
+// SaveCustomer регистрирует или обновляет клиента
+func (s *AccountGRPCServer) SaveCustomer(ctx context.Context, req *transaction.Customer) (*transaction.Customer, error) {
+	saved, err := s.accountService.SaveCustomer(&models.Customer{
+		CustomerID:    req.CustomerId,
+		FullName:      req.FullName,
+		Segment:       req.Segment,
+		Residency:     req.Residency,
+		Country:       req.Country,
+		KYCRiskRating: req.KycRiskRating,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "Failed to save customer")
+	}
+
+	return customerToProto(saved), nil
+}
+
+// GetCustomer возвращает клиента
+func (s *AccountGRPCServer) GetCustomer(ctx context.Context, req *transaction.GetCustomerRequest) (*transaction.Customer, error) {
+	customer, err := s.accountService.GetCustomer(req.CustomerId)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get customer")
+	}
+	if customer == nil {
+		return nil, status.Errorf(codes.NotFound, "Customer not found")
+	}
+
+	return customerToProto(customer), nil
+}
+
+// SaveAccount регистрирует или обновляет счет
+func (s *AccountGRPCServer) SaveAccount(ctx context.Context, req *transaction.Account) (*transaction.Account, error) {
+	account := &models.Account{
+		AccountNumber:    req.AccountNumber,
+		CustomerID:       req.CustomerId,
+		Currency:         req.Currency,
+		DeclaredTurnover: req.DeclaredTurnover,
+		Status:           req.Status,
+	}
+	if req.OpenedAt != "" {
+		openedAt, err := time.Parse(time.RFC3339, req.OpenedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "opened_at must be RFC3339: %v", err)
+		}
+		account.OpenedAt = openedAt
+	}
+
+	saved, err := s.accountService.SaveAccount(account)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to save account")
+	}
+
+	return accountToProto(saved), nil
+}
+
+// GetAccount возвращает счет вместе с владельцем
+func (s *AccountGRPCServer) GetAccount(ctx context.Context, req *transaction.GetAccountRequest) (*transaction.AccountProfile, error) {
+	profile, err := s.accountService.GetAccount(req.AccountNumber)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get account")
+	}
+	if profile == nil {
+		return nil, status.Errorf(codes.NotFound, "Account not found")
+	}
+
+	return &transaction.AccountProfile{
+		Account:  accountToProto(&profile.Account),
+		Customer: customerToProto(&profile.Customer),
+	}, nil
+}
+
+// ListAccounts возвращает счета клиента
+func (s *AccountGRPCServer) ListAccounts(ctx context.Context, req *transaction.ListAccountsRequest) (*transaction.ListAccountsResponse, error) {
+	accounts, err := s.accountService.ListAccounts(req.CustomerId)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get accounts")
+	}
+
+	resp := &transaction.ListAccountsResponse{}
+	for _, account := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(account))
+	}
+	return resp, nil
+}
+
+func customerToProto(c *models.Customer) *transaction.Customer {
+	if c == nil {
+		return nil
+	}
+	return &transaction.Customer{
+		CustomerId:    c.CustomerID,
+		FullName:      c.FullName,
+		Segment:       c.Segment,
+		Residency:     c.Residency,
+		Country:       c.Country,
+		KycRiskRating: c.KYCRiskRating,
+		CreatedAt:     formatTime(&c.CreatedAt),
+		UpdatedAt:     formatTime(&c.UpdatedAt),
+	}
+}
+
+func accountToProto(a *models.Account) *transaction.Account {
+	if a == nil {
+		return nil
+	}
+	return &transaction.Account{
+		AccountNumber:    a.AccountNumber,
+		CustomerId:       a.CustomerID,
+		Currency:         a.Currency,
+		OpenedAt:         formatTime(&a.OpenedAt),
+		DeclaredTurnover: a.DeclaredTurnover,
+		Status:           a.Status,
+		CreatedAt:        formatTime(&a.CreatedAt),
+		UpdatedAt:        formatTime(&a.UpdatedAt),
+	}
+}
+
+// GetAccountActivity возвращает операции счета в обоих направлениях, итоги, счетчики Redis и записи списков
+func (s *AccountGRPCServer) GetAccountActivity(ctx context.Context, req *transaction.GetAccountActivityRequest) (*transaction.AccountActivity, error) {
+	activityReq := &models.AccountActivityRequest{
+		AccountNumber: req.AccountNumber,
+		Period:        req.Period,
+		Limit:         int(req.Limit),
+	}
+	var err error
+	if activityReq.From, err = parseOptionalTime("from", req.From); err != nil {
+		return nil, err
+	}
+	if activityReq.To, err = parseOptionalTime("to", req.To); err != nil {
+		return nil, err
+	}
+
+	activity, err := s.activityService.GetAccountActivity(activityReq)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get account activity")
+	}
+
+	resp := &transaction.AccountActivity{
+		AccountNumber: activity.AccountNumber,
+		Sent:          accountTransactionsToProto(activity.Sent),
+		Received:      accountTransactionsToProto(activity.Received),
+		Totals: &transaction.AccountActivityTotals{
+			ByCurrency: activityTotalsToProto(activity.Totals.ByCurrency),
+			ByCountry:  activityTotalsToProto(activity.Totals.ByCountry),
+			ByChannel:  activityTotalsToProto(activity.Totals.ByChannel),
+			ByPeriod:   activityTotalsToProto(activity.Totals.ByPeriod),
+		},
+	}
+	if v := activity.Velocity; v != nil {
+		resp.Velocity = &transaction.AccountVelocity{
+			DailyCount: v.DailyCount,
+			FlowWindow: v.FlowWindow,
+			FanWindow:  v.FanWindow,
+			FanIn:      v.FanIn,
+			FanOut:     v.FanOut,
+		}
+		if v.Flows != nil {
+			resp.Velocity.Inflow = v.Flows.Inflow
+			resp.Velocity.Outflow = v.Flows.Outflow
+			resp.Velocity.InflowCount = v.Flows.InflowCount
+			resp.Velocity.OutflowCount = v.Flows.OutflowCount
+		}
+		if v.Baseline != nil {
+			resp.Velocity.BaselineTransactions = v.Baseline.TransactionCount
+			resp.Velocity.BaselineMeanAmount = v.Baseline.MeanAmount()
+		}
+	}
+	for _, e := range activity.ListEntries {
+		resp.ListEntries = append(resp.ListEntries, &transaction.AccountListEntry{
+			Id:            e.ID,
+			ListType:      e.ListType,
+			AccountNumber: e.AccountNumber,
+			Name:          e.Name,
+			Reason:        e.Reason,
+			AddedBy:       e.AddedBy,
+			CreatedAt:     formatTime(&e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func accountTransactionsToProto(items []*models.TransactionSummary) []*transaction.AccountTransaction {
+	result := make([]*transaction.AccountTransaction, 0, len(items))
+	for _, t := range items {
+		item := &transaction.AccountTransaction{
+			ProcessingId:        t.ProcessingID,
+			TransactionId:       t.TransactionID,
+			AccountNumber:       t.AccountNumber,
+			Amount:              t.Amount,
+			Currency:            t.Currency,
+			TransactionType:     t.TransactionType,
+			CounterpartyAccount: t.CounterpartyAccount,
+			CounterpartyCountry: t.CounterpartyCountry,
+			Channel:             t.Channel,
+			Timestamp:           formatTime(&t.Timestamp),
+			Status:              t.Status,
+			Flags:               t.Flags,
+		}
+		if t.RiskScore != nil {
+			item.RiskScore = int32(*t.RiskScore)
+		}
+		if t.RiskLevel != nil {
+			item.RiskLevel = *t.RiskLevel
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func activityTotalsToProto(totals []*models.ActivityTotal) []*transaction.ActivityTotal {
+	result := make([]*transaction.ActivityTotal, 0, len(totals))
+	for _, t := range totals {
+		result = append(result, &transaction.ActivityTotal{
+			Direction: t.Direction,
+			Key:       t.Key,
+			Currency:  t.Currency,
+			Count:     t.Count,
+			Amount:    t.Amount,
+		})
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"bank-aml-system/internal/auth"
+	"bank-aml-system/internal/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataAPIKey - ключ метаданных с API-ключом; JWT передается в authorization: Bearer <token>
+const MetadataAPIKey = "x-api-key"
+
+// identityKey - ключ контекста, под которым хранится аутентифицированный пользователь
+type identityKey struct{}
+
+// Роли с доступом к методам
+var (
+	ingestRoles   = []string{models.RoleIngest}
+	statusRoles   = []string{models.RoleIngest, models.RoleAnalyst}
+	analystRoles  = []string{models.RoleAnalyst}
+	approverRoles = []string{models.RoleSupervisor, models.RoleApprover}
+)
+
+// publicMethodPrefixes - методы, доступные без аутентификации (reflection для grpcurl)
+var publicMethodPrefixes = []string{
+	"/grpc.reflection.",
+}
+
+// methodRoles - роли, которым доступен метод; достаточно одной из них
+// Старшие роли включают младшие, поэтому указывается минимальная роль. Сервер не запустится,
+// если у зарегистрированного метода нет записи в таблице
+var methodRoles = map[string][]string{
+	"/transaction.TransactionService/AnalyzeTransaction":        ingestRoles,
+	"/transaction.TransactionService/AnalyzeTransactionStream":  ingestRoles,
+	"/transaction.TransactionService/GenerateRandomTransaction": ingestRoles,
+	"/transaction.TransactionService/GetTransactionStatus":      statusRoles,
+	"/transaction.TransactionService/ListTransactions":          analystRoles,
+	"/transaction.TransactionService/WatchTransactions":         analystRoles,
+
+	"/transaction.AccountService/SaveCustomer":       ingestRoles,
+	"/transaction.AccountService/SaveAccount":        ingestRoles,
+	"/transaction.AccountService/GetCustomer":        analystRoles,
+	"/transaction.AccountService/GetAccount":         analystRoles,
+	"/transaction.AccountService/ListAccounts":       analystRoles,
+	"/transaction.AccountService/GetAccountActivity": analystRoles,
+
+	"/transaction.CaseService/ListAlerts":        analystRoles,
+	"/transaction.CaseService/ListCases":         analystRoles,
+	"/transaction.CaseService/GetCase":           analystRoles,
+	"/transaction.CaseService/UpdateCase":        analystRoles,
+	"/transaction.CaseService/AddCaseNote":       analystRoles,
+	"/transaction.CaseService/AddCaseAttachment": analystRoles,
+
+	"/transaction.DecisionService/GetTransactionDecision":    analystRoles,
+	"/transaction.DecisionService/UpdateTransactionDecision": analystRoles,
+	"/transaction.DecisionService/ListDecisionApprovals":     analystRoles,
+	"/transaction.DecisionService/ApproveDecision":           approverRoles,
+	"/transaction.DecisionService/RejectDecision":            approverRoles,
+}
+
+// AuthInterceptors возвращает перехватчики, которые проверяют учетные данные вызова и роли, которым доступен метод
+func AuthInterceptors(authenticator *auth.Authenticator) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorizeCall(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorizeCall(ss.Context(), authenticator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+// authorizeCall проверяет учетные данные из метаданных вызова и добавляет пользователя в контекст
+func authorizeCall(ctx context.Context, authenticator *auth.Authenticator, method string) (context.Context, error) {
+	if isPublicMethod(method) {
+		return ctx, nil
+	}
+
+	var authorization, apiKey string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+		if values := md.Get(MetadataAPIKey); len(values) > 0 {
+			apiKey = values[0]
+		}
+	}
+
+	identity, err := authenticator.Authenticate(authorization, apiKey)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnauthenticated) {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return ctx, status.Error(codes.Internal, "failed to authenticate")
+	}
+
+	roles, ok := methodRoles[method]
+	if !ok || !auth.Authorize(identity, roles) {
+		return ctx, status.Errorf(codes.PermissionDenied, "insufficient role for %s", method)
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// checkMethodPolicies проверяет, что для каждого зарегистрированного метода задана политика доступа
+func checkMethodPolicies(server *grpc.Server) error {
+	for service, info := range server.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := "/" + service + "/" + method.Name
+			if _, ok := methodRoles[fullMethod]; !ok && !isPublicMethod(fullMethod) {
+				return fmt.Errorf("no access policy for gRPC method %s", fullMethod)
+			}
+		}
+	}
+	return nil
+}
+
+// isPublicMethod проверяет, доступен ли метод без аутентификации
+func isPublicMethod(method string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
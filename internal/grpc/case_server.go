@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	transaction "bank-aml-system/api/proto"
+)
+
+// CaseGRPCServer реализует gRPC сервис алертов и кейсов
+type CaseGRPCServer struct {
+	transaction.UnimplementedCaseServiceServer
+	caseService services.CaseService
+}
+
+// NewCaseGRPCServer создает gRPC сервер алертов и кейсов
+func NewCaseGRPCServer(caseService services.CaseService) *CaseGRPCServer {
+	return &CaseGRPCServer{caseService: caseService}
+}
+
+// Register регистрирует сервис на gRPC сервере
+func (s *CaseGRPCServer) Register(server *grpc.Server) {
+	transaction.RegisterCaseServiceServer(server, s)
+}
+
+// ListAlerts возвращает алерты
+func (s *CaseGRPCServer) ListAlerts(ctx context.Context, req *transaction.ListAlertsRequest) (*transaction.ListAlertsResponse, error) {
+	alerts, err := s.caseService.ListAlerts(models.AlertFilter{
+		Status:        req.Status,
+		AccountNumber: req.AccountNumber,
+		Limit:         listLimit(req.Limit),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get alerts")
+	}
+
+	resp := &transaction.ListAlertsResponse{}
+	for _, alert := range alerts {
+		resp.Alerts = append(resp.Alerts, alertToProto(alert))
+	}
+	return resp, nil
+}
+
+// ListCases возвращает кейсы
+func (s *CaseGRPCServer) ListCases(ctx context.Context, req *transaction.ListCasesRequest) (*transaction.ListCasesResponse, error) {
+	cases, err := s.caseService.ListCases(models.CaseFilter{
+		Status:        req.Status,
+		AssignedTo:    req.AssignedTo,
+		AccountNumber: req.AccountNumber,
+		Limit:         listLimit(req.Limit),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get cases")
+	}
+
+	resp := &transaction.ListCasesResponse{}
+	for _, c := range cases {
+		resp.Cases = append(resp.Cases, caseToProto(c))
+	}
+	return resp, nil
+}
+
+// GetCase возвращает кейс вместе с алертами, заметками и вложениями
+func (s *CaseGRPCServer) GetCase(ctx context.Context, req *transaction.GetCaseRequest) (*transaction.CaseDetails, error) {
+	details, err := s.caseService.GetCase(req.CaseId)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get case")
+	}
+	if details == nil {
+		return nil, status.Errorf(codes.NotFound, "Case not found")
+	}
+
+	resp := &transaction.CaseDetails{Case: caseToProto(&details.Case)}
+	for _, alert := range details.Alerts {
+		resp.Alerts = append(resp.Alerts, alertToProto(alert))
+	}
+	for _, note := range details.Notes {
+		resp.Notes = append(resp.Notes, caseNoteToProto(note))
+	}
+	for _, attachment := range details.Attachments {
+		resp.Attachments = append(resp.Attachments, caseAttachmentToProto(attachment))
+	}
+	return resp, nil
+}
+
+// UpdateCase меняет статус, исполнителя или решение по кейсу
+func (s *CaseGRPCServer) UpdateCase(ctx context.Context, req *transaction.UpdateCaseRequest) (*transaction.Case, error) {
+	update := &models.CaseUpdate{
+		Status:     req.Status,
+		AssignedTo: req.AssignedTo,
+		Resolution: req.Resolution,
+		Actor:      req.Actor,
+	}
+	if err := bindActor(ctx, "actor", &update.Actor); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.caseService.UpdateCase(req.CaseId, update)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to update case")
+	}
+
+	return caseToProto(updated), nil
+}
+
+// AddCaseNote добавляет заметку к кейсу
+func (s *CaseGRPCServer) AddCaseNote(ctx context.Context, req *transaction.CaseNote) (*transaction.CaseNote, error) {
+	note := &models.CaseNote{
+		Author: req.Author,
+		Text:   req.Text,
+	}
+	if err := bindActor(ctx, "author", &note.Author); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.caseService.AddNote(req.CaseId, note)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to add note")
+	}
+
+	return caseNoteToProto(saved), nil
+}
+
+// AddCaseAttachment добавляет ссылку на документ к кейсу
+func (s *CaseGRPCServer) AddCaseAttachment(ctx context.Context, req *transaction.CaseAttachment) (*transaction.CaseAttachment, error) {
+	attachment := &models.CaseAttachment{
+		Name:      req.Name,
+		Reference: req.Reference,
+		AddedBy:   req.AddedBy,
+	}
+	if err := bindActor(ctx, "added_by", &attachment.AddedBy); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.caseService.AddAttachment(req.CaseId, attachment)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to add attachment")
+	}
+
+	return caseAttachmentToProto(saved), nil
+}
+
+// listLimit применяет к limit из запроса те же ограничения, что и REST API (по умолчанию 100, максимум 500)
+func listLimit(limit int32) int {
+	if limit <= 0 || limit > 500 {
+		return 100
+	}
+	return int(limit)
+}
+
+func alertToProto(a *models.Alert) *transaction.Alert {
+	return &transaction.Alert{
+		Id:             a.ID,
+		CaseId:         a.CaseID,
+		ProcessingId:   a.ProcessingID,
+		TransactionId:  a.TransactionID,
+		AccountNumber:  a.AccountNumber,
+		RiskScore:      int32(a.RiskScore),
+		RiskLevel:      a.RiskLevel,
+		Recommendation: a.Recommendation,
+		Flags:          a.Flags,
+		Status:         a.Status,
+		CreatedAt:      formatTime(&a.CreatedAt),
+	}
+}
+
+func caseToProto(c *models.Case) *transaction.Case {
+	if c == nil {
+		return nil
+	}
+	return &transaction.Case{
+		Id:            c.ID,
+		AccountNumber: c.AccountNumber,
+		Status:        c.Status,
+		AssignedTo:    c.AssignedTo,
+		Resolution:    c.Resolution,
+		MaxRiskScore:  int32(c.MaxRiskScore),
+		AlertCount:    int32(c.AlertCount),
+		CreatedAt:     formatTime(&c.CreatedAt),
+		UpdatedAt:     formatTime(&c.UpdatedAt),
+		ClosedAt:      formatTime(c.ClosedAt),
+	}
+}
+
+func caseNoteToProto(n *models.CaseNote) *transaction.CaseNote {
+	return &transaction.CaseNote{
+		Id:        n.ID,
+		CaseId:    n.CaseID,
+		Author:    n.Author,
+		Text:      n.Text,
+		CreatedAt: formatTime(&n.CreatedAt),
+	}
+}
+
+func caseAttachmentToProto(a *models.CaseAttachment) *transaction.CaseAttachment {
+	return &transaction.CaseAttachment{
+		Id:        a.ID,
+		CaseId:    a.CaseID,
+		Name:      a.Name,
+		Reference: a.Reference,
+		AddedBy:   a.AddedBy,
+		CreatedAt: formatTime(&a.CreatedAt),
+	}
+}
@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+
+	"google.golang.org/grpc"
+
+	transaction "bank-aml-system/api/proto"
+)
+
+// DecisionGRPCServer реализует gRPC сервис решений по транзакциям
+type DecisionGRPCServer struct {
+	transaction.UnimplementedDecisionServiceServer
+	decisionService services.DecisionService
+}
+
+// NewDecisionGRPCServer создает gRPC сервер решений по транзакциям
+func NewDecisionGRPCServer(decisionService services.DecisionService) *DecisionGRPCServer {
+	return &DecisionGRPCServer{decisionService: decisionService}
+}
+
+// Register регистрирует сервис на gRPC сервере
+func (s *DecisionGRPCServer) Register(server *grpc.Server) {
+	transaction.RegisterDecisionServiceServer(server, s)
+}
+
+// GetTransactionDecision возвращает текущее решение по транзакции вместе с историей
+func (s *DecisionGRPCServer) GetTransactionDecision(ctx context.Context, req *transaction.GetTransactionDecisionRequest) (*transaction.TransactionDecisionDetails, error) {
+	details, err := s.decisionService.GetDecision(req.ProcessingId)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get decision")
+	}
+
+	resp := &transaction.TransactionDecisionDetails{Decision: decisionToProto(&details.Decision)}
+	for _, change := range details.History {
+		resp.History = append(resp.History, &transaction.DecisionChange{
+			Id:           change.ID,
+			ProcessingId: change.ProcessingID,
+			FromDecision: change.FromDecision,
+			ToDecision:   change.ToDecision,
+			Actor:        change.Actor,
+			ApprovedBy:   change.ApprovedBy,
+			Reason:       change.Reason,
+			CreatedAt:    formatTime(&change.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// UpdateTransactionDecision меняет решение по транзакции
+func (s *DecisionGRPCServer) UpdateTransactionDecision(ctx context.Context, req *transaction.UpdateTransactionDecisionRequest) (*transaction.TransactionDecision, error) {
+	decision, err := s.decisionService.ChangeDecision(req.ProcessingId, &models.DecisionRequest{
+		Decision: req.Decision,
+		Actor:    req.Actor,
+		Reason:   req.Reason,
+	}, identityFromContext(ctx))
+	if err != nil {
+		return nil, toStatusError(err, "Failed to change decision")
+	}
+
+	return decisionToProto(decision), nil
+}
+
+// ListDecisionApprovals возвращает запросы на подтверждение решений
+func (s *DecisionGRPCServer) ListDecisionApprovals(ctx context.Context, req *transaction.ListDecisionApprovalsRequest) (*transaction.ListDecisionApprovalsResponse, error) {
+	approvals, err := s.decisionService.ListApprovals(models.DecisionApprovalFilter{
+		Status:       req.Status,
+		ProcessingID: req.ProcessingId,
+		Limit:        listLimit(req.Limit),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "Failed to get approvals")
+	}
+
+	resp := &transaction.ListDecisionApprovalsResponse{}
+	for _, approval := range approvals {
+		resp.Approvals = append(resp.Approvals, approvalToProto(approval))
+	}
+	return resp, nil
+}
+
+// ApproveDecision подтверждает запрос вторым сотрудником
+func (s *DecisionGRPCServer) ApproveDecision(ctx context.Context, req *transaction.ApproveDecisionRequest) (*transaction.TransactionDecision, error) {
+	identity, err := requireIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decision, err := s.decisionService.ApproveDecision(req.ApprovalId, identity)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to approve decision")
+	}
+
+	return decisionToProto(decision), nil
+}
+
+// RejectDecision отклоняет запрос на подтверждение
+func (s *DecisionGRPCServer) RejectDecision(ctx context.Context, req *transaction.RejectDecisionRequest) (*transaction.DecisionApproval, error) {
+	identity, err := requireIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := s.decisionService.RejectDecision(req.ApprovalId, identity, req.Comment)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to reject decision")
+	}
+
+	return approvalToProto(approval), nil
+}
+
+func decisionToProto(d *models.TransactionDecision) *transaction.TransactionDecision {
+	resp := &transaction.TransactionDecision{
+		ProcessingId: d.ProcessingID,
+		Decision:     d.Decision,
+		Actor:        d.Actor,
+		Reason:       d.Reason,
+	}
+	if !d.UpdatedAt.IsZero() {
+		resp.UpdatedAt = formatTime(&d.UpdatedAt)
+	}
+	if d.PendingApproval != nil {
+		resp.PendingApproval = approvalToProto(d.PendingApproval)
+	}
+	return resp
+}
+
+func approvalToProto(a *models.DecisionApproval) *transaction.DecisionApproval {
+	return &transaction.DecisionApproval{
+		Id:           a.ID,
+		ProcessingId: a.ProcessingID,
+		FromDecision: a.FromDecision,
+		ToDecision:   a.ToDecision,
+		RequestedBy:  a.RequestedBy,
+		Reason:       a.Reason,
+		Status:       a.Status,
+		ResolvedBy:   a.ResolvedBy,
+		Comment:      a.Comment,
+		ExpiresAt:    formatTime(&a.ExpiresAt),
+		CreatedAt:    formatTime(&a.CreatedAt),
+		ResolvedAt:   formatTime(a.ResolvedAt),
+	}
+}
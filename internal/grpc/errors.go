@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"errors"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/services"
+	"bank-aml-system/internal/validation"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError переводит ошибку сервисного слоя в gRPC статус
+// Ошибки проверки полей передаются в деталях статуса как BadRequest
+func toStatusError(err error, message string) error {
+	if fields, ok := validation.FieldErrors(err); ok {
+		return invalidFieldsError(err.Error(), fields)
+	}
+	switch {
+	case errors.Is(err, services.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, services.ErrUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, services.ErrExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+}
+
+// invalidFieldsError возвращает статус InvalidArgument с нарушениями по полям запроса
+func invalidFieldsError(message string, fields []models.FieldError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(fields))
+	for _, field := range fields {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Message})
+	}
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+	return st.Err()
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"bank-aml-system/internal/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключи метаданных с идентификатором пользователя и его ролями (через запятую)
+// Учитываются, только если аутентификация API отключена
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataUserRoles = "x-user-roles"
+)
+
+// identityFromContext возвращает пользователя, от имени которого выполняется вызов
+// Пользователь, проверенный перехватчиком аутентификации, имеет приоритет над метаданными
+func identityFromContext(ctx context.Context) models.Identity {
+	if identity, ok := ctx.Value(identityKey{}).(models.Identity); ok {
+		return identity
+	}
+	var identity models.Identity
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return identity
+	}
+	if values := md.Get(MetadataUserID); len(values) > 0 {
+		identity.UserID = strings.TrimSpace(values[0])
+	}
+	for _, value := range md.Get(MetadataUserRoles) {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				identity.Roles = append(identity.Roles, role)
+			}
+		}
+	}
+	return identity
+}
+
+// requireIdentity возвращает пользователя вызова или ошибку Unauthenticated, если он не указан
+func requireIdentity(ctx context.Context) (models.Identity, error) {
+	identity := identityFromContext(ctx)
+	if identity.UserID == "" {
+		return identity, status.Errorf(codes.Unauthenticated, "%s metadata is required", MetadataUserID)
+	}
+	return identity, nil
+}
+
+// bindActor подставляет пользователя вызова в поле автора действия field (actor, author и т.п.)
+// Без пользователя вызова остается значение из запроса; значение, не совпадающее с пользователем, отклоняется
+func bindActor(ctx context.Context, field string, actor *string) error {
+	identity := identityFromContext(ctx)
+	if identity.UserID == "" {
+		return nil
+	}
+	if claimed := strings.TrimSpace(*actor); claimed != "" && claimed != identity.UserID {
+		return status.Errorf(codes.PermissionDenied, "%s %q does not match the request user %q", field, claimed, identity.UserID)
+	}
+	*actor = identity.UserID
+	return nil
+}
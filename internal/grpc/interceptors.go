@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// loggingUnaryInterceptor записывает в журнал метод, клиента, код ответа и длительность каждого вызова
+func loggingUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// loggingStreamInterceptor записывает в журнал завершение потокового вызова
+func loggingStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+// logCall записывает результат вызова
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	client := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		client = p.Addr.String()
+	}
+	code := status.Code(err)
+	if code == codes.OK {
+		log.Printf("gRPC %s from %s: %s in %s", method, client, code, time.Since(start))
+		return
+	}
+	log.Printf("gRPC %s from %s: %s in %s: %s", method, client, code, time.Since(start), status.Convert(err).Message())
+}
+
+// recoveryUnaryInterceptor превращает панику обработчика в ошибку Internal, не роняя сервер
+func recoveryUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor превращает панику обработчика потока в ошибку Internal
+func recoveryStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// recoveredError записывает панику со стеком в журнал; клиенту детали не передаются
+func recoveredError(method string, r any) error {
+	log.Printf("Panic in gRPC %s: %v\n%s", method, r, debug.Stack())
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// deadlineInterceptors ограничивают срок выполнения вызовов
+// Срок метода из timeouts (по полному или короткому имени) применяется к унарным и потоковым вызовам,
+// defaultTimeout - только к унарным: подписки и потоки анализа длятся, пока их не закроет клиент.
+// Более ранний срок, переданный клиентом, сохраняется
+func deadlineInterceptors(defaultTimeout time.Duration, timeouts map[string]time.Duration) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		timeout, ok := methodTimeout(timeouts, info.FullMethod)
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		timeout, ok := methodTimeout(timeouts, info.FullMethod)
+		if !ok || timeout <= 0 {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+// methodTimeout возвращает срок метода по полному имени (/transaction.TransactionService/AnalyzeTransaction) или короткому (AnalyzeTransaction)
+func methodTimeout(timeouts map[string]time.Duration, fullMethod string) (time.Duration, bool) {
+	if timeout, ok := timeouts[fullMethod]; ok {
+		return timeout, true
+	}
+	timeout, ok := timeouts[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+	return timeout, ok
+}
+
+// contextStream подменяет контекст потока
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает подмененный контекст
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/ratelimit"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// methodOperations - операции приема, к которым относятся унарные методы, для ограничения частоты запросов
+var methodOperations = map[string]string{
+	"/transaction.TransactionService/AnalyzeTransaction":        ratelimit.OperationTransactions,
+	"/transaction.TransactionService/GenerateRandomTransaction": ratelimit.OperationGenerate,
+}
+
+// streamMessageOperations - операции, к которым относится каждое сообщение потокового метода
+var streamMessageOperations = map[string]string{
+	"/transaction.TransactionService/AnalyzeTransactionStream": ratelimit.OperationTransactions,
+}
+
+// RateLimitInterceptors возвращают перехватчики, ограничивающие частоту запросов клиента к методам приема
+// Унарный вызов сверх ограничения отклоняется с RESOURCE_EXHAUSTED и RetryInfo; чтение следующего сообщения
+// потока задерживается до появления токена, и клиента притормаживает управление потоком HTTP/2.
+// Подключаются после перехватчиков аутентификации: клиент определяется по субъекту учетных данных, без них - по IP-адресу
+func RateLimitInterceptors(limiter *ratelimit.Limiter) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		operation, ok := methodOperations[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		decision := limiter.Allow(rateLimitClient(ctx), operation)
+		if !decision.Allowed {
+			return nil, rateLimitedError(operation, decision)
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		operation, ok := streamMessageOperations[info.FullMethod]
+		if !ok {
+			return handler(srv, ss)
+		}
+		return handler(srv, &rateLimitedStream{
+			ServerStream: ss,
+			limiter:      limiter,
+			client:       rateLimitClient(ss.Context()),
+			operation:    operation,
+		})
+	}
+	return unary, stream
+}
+
+// rateLimitedStream забирает токен на каждое полученное сообщение потока
+type rateLimitedStream struct {
+	grpc.ServerStream
+	limiter   *ratelimit.Limiter
+	client    string
+	operation string
+}
+
+// RecvMsg получает сообщение и ждет токен, прежде чем отдать его обработчику
+func (s *rateLimitedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := s.limiter.Wait(s.Context(), s.client, s.operation); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// rateLimitClient возвращает клиента, для которого ведется корзина токенов
+func rateLimitClient(ctx context.Context) string {
+	if identity, ok := ctx.Value(identityKey{}).(models.Identity); ok {
+		return identity.UserID
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "ip:" + p.Addr.String()
+	}
+	return "ip:unknown"
+}
+
+// rateLimitedError возвращает RESOURCE_EXHAUSTED с подсказкой RetryInfo, через сколько повторить вызов
+func rateLimitedError(operation string, decision ratelimit.Decision) error {
+	st := status.Newf(codes.ResourceExhausted, "rate limit exceeded for %s", operation)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(decision.RetryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"bank-aml-system/config"
-	"bank-aml-system/internal/fraud"
+	"bank-aml-system/internal/auth"
 	"bank-aml-system/internal/generator"
 	"bank-aml-system/internal/kafka"
 	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/ratelimit"
 	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/services"
 	"bank-aml-system/internal/storage"
 
 	"github.com/google/uuid"
@@ -28,32 +30,55 @@ type TransactionGRPCServer struct {
 	transaction.UnimplementedTransactionServiceServer
 	repo         storage.TransactionRepository
 	producer      kafka.Producer
-	redisClient   *redis.Client
-	riskAnalyzer  *fraud.RiskAnalyzer
+	redisClient   redis.ClientInterface
+	riskAnalyzer  services.RiskAnalyzer
 	generator     *generator.TransactionGenerator
+	transactions  services.TransactionService
+	watch         services.TransactionWatchService
+
+	streamConcurrency int // Число транзакций потока, анализируемых одновременно
 }
 
+// defaultStreamConcurrency - число воркеров потока, если оно не задано в конфигурации
+const defaultStreamConcurrency = 8
+
 func NewTransactionGRPCServer(
 	repo storage.TransactionRepository,
 	producer kafka.Producer,
-	redisClient *redis.Client,
-	riskAnalyzer *fraud.RiskAnalyzer,
+	redisClient redis.ClientInterface,
+	riskAnalyzer services.RiskAnalyzer,
+	transactions services.TransactionService,
+	watch services.TransactionWatchService,
+	streamConcurrency int,
 ) *TransactionGRPCServer {
+	if streamConcurrency <= 0 {
+		streamConcurrency = defaultStreamConcurrency
+	}
 	return &TransactionGRPCServer{
 		repo:         repo,
 		producer:     producer,
 		redisClient:  redisClient,
 		riskAnalyzer: riskAnalyzer,
 		generator:    generator.NewTransactionGenerator(),
+		transactions: transactions,
+		watch:        watch,
+
+		streamConcurrency: streamConcurrency,
 	}
 }
 
 // AnalyzeTransaction анализирует транзакцию на предмет рисков через gRPC
 func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *transaction.AnalyzeTransactionRequest) (*transaction.AnalyzeTransactionResponse, error) {
-	// Парсим timestamp
-	timestamp, err := time.Parse(time.RFC3339, req.Timestamp)
-	if err != nil {
-		timestamp = time.Now()
+	// Пустое время операции заменяется временем приема, некорректное отклоняется
+	var timestamp time.Time
+	if req.Timestamp != "" {
+		parsed, err := time.Parse(time.RFC3339, req.Timestamp)
+		if err != nil {
+			return nil, invalidFieldsError("invalid input: timestamp: must be an RFC 3339 timestamp", []models.FieldError{
+				{Field: "timestamp", Message: "must be an RFC 3339 timestamp"},
+			})
+		}
+		timestamp = parsed
 	}
 
 	// Создаем транзакцию из запроса
@@ -65,6 +90,7 @@ func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *tra
 		TransactionType:     req.TransactionType,
 		CounterpartyAccount: req.CounterpartyAccount,
 		CounterpartyBank:    req.CounterpartyBank,
+		CounterpartyBIC:     req.CounterpartyBic,
 		CounterpartyCountry: req.CounterpartyCountry,
 		Channel:             req.Channel,
 		UserID:              req.UserId,
@@ -72,6 +98,11 @@ func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *tra
 		Timestamp:           timestamp,
 	}
 
+	// Те же проверки, что и для REST и пакетной загрузки
+	if err := s.transactions.ValidateTransaction(tx); err != nil {
+		return nil, toStatusError(err, "Failed to validate transaction")
+	}
+
 	// Генерируем processing_id
 	processingID := "proc_" + uuid.New().String()
 
@@ -81,28 +112,6 @@ func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *tra
 		return nil, status.Errorf(codes.Internal, "Failed to save transaction: %v", err)
 	}
 
-	// Отправляем в Kafka для асинхронной обработки
-	event := &models.KafkaTransactionEvent{
-		EventID:   "evt_" + uuid.New().String(),
-		EventType: "transaction_received",
-		Timestamp: time.Now(),
-		Data: models.KafkaTransactionData{
-			ProcessingID:        processingID,
-			TransactionID:       tx.TransactionID,
-			AccountNumber:       tx.AccountNumber,
-			Amount:              tx.Amount,
-			Currency:            tx.Currency,
-			TransactionType:     tx.TransactionType,
-			CounterpartyCountry: tx.CounterpartyCountry,
-			Channel:             tx.Channel,
-		},
-	}
-
-	if err := s.producer.SendTransactionEvent(event); err != nil {
-		log.Printf("Error sending event to Kafka: %v", err)
-		// Продолжаем выполнение, даже если Kafka недоступен
-	}
-
 	// Выполняем синхронный анализ
 	analysis, err := s.riskAnalyzer.AnalyzeTransaction(tx)
 	if err != nil {
@@ -125,6 +134,29 @@ func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *tra
 		log.Printf("Error updating transaction in DB: %v", err)
 	}
 
+	// Отправляем в Kafka для решений, алертов и отчетности уже после сохранения анализа:
+	// fraud-detection-service берет анализ из кэша и не учитывает операцию в профилях счета повторно
+	event := &models.KafkaTransactionEvent{
+		EventID:   "evt_" + uuid.New().String(),
+		EventType: "transaction_received",
+		Timestamp: time.Now(),
+		Data: models.KafkaTransactionData{
+			ProcessingID:        processingID,
+			TransactionID:       tx.TransactionID,
+			AccountNumber:       tx.AccountNumber,
+			Amount:              tx.Amount,
+			Currency:            tx.Currency,
+			TransactionType:     tx.TransactionType,
+			CounterpartyCountry: tx.CounterpartyCountry,
+			Channel:             tx.Channel,
+		},
+	}
+
+	if err := s.producer.SendTransactionEvent(event); err != nil {
+		log.Printf("Error sending event to Kafka: %v", err)
+		// Продолжаем выполнение, даже если Kafka недоступен
+	}
+
 	return &transaction.AnalyzeTransactionResponse{
 		ProcessingId:   processingID,
 		RiskScore:      int32(analysis.RiskScore),
@@ -133,6 +165,7 @@ func (s *TransactionGRPCServer) AnalyzeTransaction(ctx context.Context, req *tra
 		Recommendation: analysis.Recommendation,
 		AnalyzedAt:     analysis.AnalyzedAt.Format(time.RFC3339),
 		Status:         "reviewed",
+		Evidence:       evidenceToProto(analysis.Evidence),
 	}, nil
 }
 
@@ -165,6 +198,7 @@ func (s *TransactionGRPCServer) GetTransactionStatus(ctx context.Context, req *t
 			RiskLevel:         analysis.RiskLevel,
 			Flags:             flags,
 			AnalysisTimestamp: formatTime(analysisTimestamp),
+			Evidence:          evidenceToProto(analysis.Evidence),
 		}, nil
 	}
 
@@ -223,6 +257,84 @@ func (s *TransactionGRPCServer) GenerateRandomTransaction(ctx context.Context, r
 	}, nil
 }
 
+// ListTransactions возвращает страницу транзакций по фильтру
+func (s *TransactionGRPCServer) ListTransactions(ctx context.Context, req *transaction.ListTransactionsRequest) (*transaction.ListTransactionsResponse, error) {
+	search := &models.TransactionSearchRequest{
+		AccountNumber: req.AccountNumber,
+		Counterparty:  req.Counterparty,
+		RiskLevel:     req.RiskLevel,
+		Status:        req.Status,
+		Currency:      req.Currency,
+		Country:       req.Country,
+		Channel:       req.Channel,
+		SortBy:        req.SortBy,
+		Order:         req.Order,
+		Cursor:        req.Cursor,
+		Limit:         int(req.Limit),
+	}
+	if req.MinScore != nil {
+		minScore := int(*req.MinScore)
+		search.MinScore = &minScore
+	}
+	if req.MaxScore != nil {
+		maxScore := int(*req.MaxScore)
+		search.MaxScore = &maxScore
+	}
+	var err error
+	if search.From, err = parseOptionalTime("from", req.From); err != nil {
+		return nil, err
+	}
+	if search.To, err = parseOptionalTime("to", req.To); err != nil {
+		return nil, err
+	}
+
+	page, err := s.transactions.SearchTransactions(search)
+	if err != nil {
+		return nil, toStatusError(err, "Failed to list transactions")
+	}
+
+	resp := &transaction.ListTransactionsResponse{NextCursor: page.NextCursor}
+	for _, t := range page.Transactions {
+		item := &transaction.TransactionSummary{
+			ProcessingId:        t.ProcessingID,
+			TransactionId:       t.TransactionID,
+			AccountNumber:       t.AccountNumber,
+			Amount:              t.Amount,
+			Currency:            t.Currency,
+			TransactionType:     t.TransactionType,
+			CounterpartyAccount: t.CounterpartyAccount,
+			CounterpartyCountry: t.CounterpartyCountry,
+			Channel:             t.Channel,
+			Timestamp:           formatTime(&t.Timestamp),
+			Status:              t.Status,
+			AnalysisTimestamp:   formatTime(t.AnalysisTimestamp),
+			Flags:               t.Flags,
+			Evidence:            evidenceToProto(t.Evidence),
+			CreatedAt:           formatTime(&t.CreatedAt),
+		}
+		if t.RiskScore != nil {
+			item.RiskScore = int32(*t.RiskScore)
+		}
+		if t.RiskLevel != nil {
+			item.RiskLevel = *t.RiskLevel
+		}
+		resp.Transactions = append(resp.Transactions, item)
+	}
+	return resp, nil
+}
+
+// parseOptionalTime разбирает необязательное время в формате RFC3339
+func parseOptionalTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s %q: expected RFC3339", name, value)
+	}
+	return &t, nil
+}
+
 func formatTime(t *time.Time) string {
 	if t == nil {
 		return ""
@@ -230,23 +342,103 @@ func formatTime(t *time.Time) string {
 	return t.Format(time.RFC3339)
 }
 
-// StartGRPCServer запускает gRPC сервер
-func StartGRPCServer(cfg *config.Config, server *TransactionGRPCServer) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
+// evidenceToProto преобразует доказательную базу флагов в protobuf
+func evidenceToProto(evidence map[string][]string) map[string]*transaction.Evidence {
+	if len(evidence) == 0 {
+		return nil
+	}
+	result := make(map[string]*transaction.Evidence, len(evidence))
+	for flag, values := range evidence {
+		result[flag] = &transaction.Evidence{Values: values}
+	}
+	return result
+}
+
+// ServiceRegistrar регистрирует дополнительный сервис на gRPC сервере
+type ServiceRegistrar interface {
+	Register(server *grpc.Server)
+}
+
+// StartGRPCServer запускает gRPC сервер и блокируется до его остановки
+// authenticator == nil отключает аутентификацию: пользователь берется из метаданных x-user-id и x-user-roles,
+// limiter == nil - ограничения частоты вызовов приема.
+// После отмены ctx сервер перестает принимать вызовы и ждет завершения текущих не дольше ShutdownTimeout,
+// затем закрывает оставшиеся соединения: подписки WatchTransactions сами не завершаются
+func StartGRPCServer(ctx context.Context, cfg *config.Config, authenticator *auth.Authenticator, limiter *ratelimit.Limiter, server *TransactionGRPCServer, extra ...ServiceRegistrar) error {
+	creds, err := ServerCredentials(cfg.GRPC)
 	if err != nil {
-		return fmt.Errorf("failed to listen: %v", err)
+		return err
+	}
+
+	// Цепочка перехватчиков: журнал видит итоговый код вызова, в том числе после паники,
+	// срок выполнения действует и на проверку доступа
+	unary := []grpc.UnaryServerInterceptor{loggingUnaryInterceptor, recoveryUnaryInterceptor}
+	stream := []grpc.StreamServerInterceptor{loggingStreamInterceptor, recoveryStreamInterceptor}
+	deadlineUnary, deadlineStream := deadlineInterceptors(cfg.GRPC.DefaultTimeout, cfg.GRPC.MethodTimeouts)
+	unary = append(unary, deadlineUnary)
+	stream = append(stream, deadlineStream)
+	if authenticator != nil {
+		authUnary, authStream := AuthInterceptors(authenticator)
+		unary = append(unary, authUnary)
+		stream = append(stream, authStream)
+	}
+	if limiter != nil {
+		limitUnary, limitStream := RateLimitInterceptors(limiter)
+		unary = append(unary, limitUnary)
+		stream = append(stream, limitStream)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
 	transaction.RegisterTransactionServiceServer(s, server)
-	
+	for _, registrar := range extra {
+		registrar.Register(s)
+	}
+	if authenticator != nil {
+		if err := checkMethodPolicies(s); err != nil {
+			return err
+		}
+	}
+
 	// Включаем reflection API для grpcurl и других инструментов
 	reflection.Register(s)
 
-	log.Printf("gRPC server listening on port %d", cfg.Server.GRPCPort)
-	if err := s.Serve(lis); err != nil {
-		return fmt.Errorf("failed to serve: %v", err)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
 	}
 
+	log.Printf("gRPC server listening on port %d (%s)", cfg.Server.GRPCPort, creds.Info().SecurityProtocol)
+	served := make(chan error, 1)
+	go func() {
+		served <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-served:
+		if err != nil {
+			return fmt.Errorf("failed to serve: %v", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	log.Println("Stopping gRPC server...")
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(cfg.GRPC.ShutdownTimeout):
+		log.Printf("gRPC calls did not finish in %s, closing connections", cfg.GRPC.ShutdownTimeout)
+		s.Stop()
+		<-stopped
+	}
+	log.Println("gRPC server stopped")
 	return nil
 }
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	transaction "bank-aml-system/api/proto"
+)
+
+// AnalyzeTransactionStream анализирует поток транзакций
+// Одновременно анализируется не больше streamConcurrency транзакций; пока все воркеры заняты, новые запросы не читаются,
+// и клиента притормаживает управление потоком HTTP/2. Результаты отправляются по мере готовности, не обязательно
+// в порядке запросов, поэтому клиент сопоставляет их по transaction_id
+func (s *TransactionGRPCServer) AnalyzeTransactionStream(stream grpc.BidiStreamingServer[transaction.AnalyzeTransactionRequest, transaction.AnalyzeTransactionStreamResponse]) error {
+	ctx := stream.Context()
+	requests := make(chan *transaction.AnalyzeTransactionRequest)
+	responses := make(chan *transaction.AnalyzeTransactionStreamResponse)
+
+	var workers sync.WaitGroup
+	for i := 0; i < s.streamConcurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for req := range requests {
+				select {
+				case responses <- s.analyzeStreamRequest(ctx, req):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	// stream.Send нельзя вызывать из нескольких горутин: ответы отправляет одна горутина
+	sendDone := make(chan error, 1)
+	go func() {
+		var sendErr error
+		for resp := range responses {
+			if sendErr == nil {
+				sendErr = stream.Send(resp)
+			}
+		}
+		sendDone <- sendErr
+	}()
+
+	recvErr := receiveStreamRequests(ctx, stream, requests)
+	close(requests)
+	workers.Wait()
+	close(responses)
+
+	if sendErr := <-sendDone; sendErr != nil {
+		return sendErr
+	}
+	return recvErr
+}
+
+// receiveStreamRequests передает запросы потока воркерам до конца потока или отмены контекста
+func receiveStreamRequests(ctx context.Context, stream grpc.BidiStreamingServer[transaction.AnalyzeTransactionRequest, transaction.AnalyzeTransactionStreamResponse], requests chan<- *transaction.AnalyzeTransactionRequest) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case requests <- req:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// analyzeStreamRequest анализирует одну транзакцию потока; ошибка возвращается в ответе, а не закрывает поток
+func (s *TransactionGRPCServer) analyzeStreamRequest(ctx context.Context, req *transaction.AnalyzeTransactionRequest) *transaction.AnalyzeTransactionStreamResponse {
+	resp := &transaction.AnalyzeTransactionStreamResponse{TransactionId: req.TransactionId}
+	if req.TransactionId == "" {
+		resp.Error = &transaction.StreamError{Code: int32(codes.InvalidArgument), Message: "transaction_id is required"}
+		return resp
+	}
+
+	result, err := s.AnalyzeTransaction(ctx, req)
+	if err != nil {
+		st := status.Convert(err)
+		resp.Error = &transaction.StreamError{Code: int32(st.Code()), Message: st.Message()}
+		return resp
+	}
+	resp.Result = result
+	return resp
+}
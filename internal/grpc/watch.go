@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	transaction "bank-aml-system/api/proto"
+)
+
+// WatchTransactions передает клиенту изменения транзакций, пока он не отменит вызов
+// Поток не завершается сам: при отмене клиентом возвращается статус Canceled
+func (s *TransactionGRPCServer) WatchTransactions(req *transaction.WatchTransactionsRequest, stream grpc.ServerStreamingServer[transaction.TransactionUpdate]) error {
+	if s.watch == nil {
+		return status.Error(codes.Unavailable, "transaction watch is not configured")
+	}
+	watchReq := &models.TransactionWatchRequest{
+		AccountNumber: req.AccountNumber,
+		RiskLevel:     req.RiskLevel,
+		Types:         req.Types,
+		ResumeToken:   req.ResumeToken,
+	}
+	if req.MinScore != nil {
+		minScore := int(*req.MinScore)
+		watchReq.MinScore = &minScore
+	}
+
+	filter, err := s.watch.OpenWatch(watchReq)
+	if err != nil {
+		return toStatusError(err, "Failed to open transaction watch")
+	}
+
+	err = s.watch.Watch(stream.Context(), *filter, func(update *models.TransactionUpdate) error {
+		return stream.Send(transactionUpdateToProto(update))
+	})
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return err
+}
+
+// transactionUpdateToProto преобразует событие ленты изменений в protobuf
+func transactionUpdateToProto(update *models.TransactionUpdate) *transaction.TransactionUpdate {
+	resp := &transaction.TransactionUpdate{
+		ResumeToken:         update.ResumeToken,
+		Type:                update.Type,
+		ProcessingId:        update.ProcessingID,
+		TransactionId:       update.TransactionID,
+		AccountNumber:       update.AccountNumber,
+		CounterpartyAccount: update.CounterpartyAccount,
+		Amount:              update.Amount,
+		Currency:            update.Currency,
+		Status:              update.Status,
+		Decision:            update.Decision,
+		AlertId:             update.AlertID,
+		CaseId:              update.CaseID,
+		CreatedAt:           update.CreatedAt.Format(time.RFC3339),
+	}
+	if update.RiskScore != nil {
+		score := int32(*update.RiskScore)
+		resp.RiskScore = &score
+	}
+	if update.RiskLevel != nil {
+		resp.RiskLevel = *update.RiskLevel
+	}
+	return resp
+}
@@ -10,6 +10,15 @@ import (
 type Producer interface {
 	SendTransactionEvent(event *models.KafkaTransactionEvent) error
 
+	// SendTransactionEvents отправляет пакет событий о транзакциях одним запросом к брокерам
+	SendTransactionEvents(events []*models.KafkaTransactionEvent) error
+
+	// SendDecisionEvent уведомляет core banking об изменении решения по транзакции
+	SendDecisionEvent(event *models.KafkaDecisionEvent) error
+
+	// SendHoldEvent уведомляет core banking о приостановке операции и ее завершении по таймеру
+	SendHoldEvent(event *models.KafkaHoldEvent) error
+
 	Close() error
 }
 
@@ -17,6 +17,24 @@ func (m *MockProducer) SendTransactionEvent(event *models.KafkaTransactionEvent)
 	return args.Error(0)
 }
 
+// SendTransactionEvents мок для SendTransactionEvents
+func (m *MockProducer) SendTransactionEvents(events []*models.KafkaTransactionEvent) error {
+	args := m.Called(events)
+	return args.Error(0)
+}
+
+// SendDecisionEvent мок для SendDecisionEvent
+func (m *MockProducer) SendDecisionEvent(event *models.KafkaDecisionEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// SendHoldEvent мок для SendHoldEvent
+func (m *MockProducer) SendHoldEvent(event *models.KafkaHoldEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
 // Close мок для Close
 func (m *MockProducer) Close() error {
 	args := m.Called()
@@ -12,8 +12,9 @@ import (
 )
 
 type ProducerImpl struct {
-	producer sarama.SyncProducer
-	topic    string
+	producer      sarama.SyncProducer
+	topic         string
+	decisionTopic string
 }
 
 func NewProducer(cfg *config.Config) (Producer, error) {
@@ -29,29 +30,75 @@ func NewProducer(cfg *config.Config) (Producer, error) {
 
 	log.Println("Kafka producer created successfully")
 	return &ProducerImpl{
-		producer: producer,
-		topic:    cfg.Kafka.TransactionTopic,
+		producer:      producer,
+		topic:         cfg.Kafka.TransactionTopic,
+		decisionTopic: cfg.Kafka.DecisionTopic,
 	}, nil
 }
 
 func (p *ProducerImpl) SendTransactionEvent(event *models.KafkaTransactionEvent) error {
+	return p.send(p.topic, "", event)
+}
+
+// SendTransactionEvents отправляет пакет событий о транзакциях
+// Ошибка возвращается, если не доставлено хотя бы одно сообщение пакета
+func (p *ProducerImpl) SendTransactionEvents(events []*models.KafkaTransactionEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	msgs := make([]*sarama.ProducerMessage, 0, len(events))
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+		msgs = append(msgs, &sarama.ProducerMessage{
+			Topic:     p.topic,
+			Value:     sarama.StringEncoder(data),
+			Timestamp: time.Now(),
+		})
+	}
+
+	if err := p.producer.SendMessages(msgs); err != nil {
+		return fmt.Errorf("failed to send messages: %w", err)
+	}
+
+	log.Printf("%d messages sent to topic %s", len(msgs), p.topic)
+	return nil
+}
+
+// SendDecisionEvent отправляет событие об изменении решения; ключ processing_id сохраняет порядок решений по транзакции
+func (p *ProducerImpl) SendDecisionEvent(event *models.KafkaDecisionEvent) error {
+	return p.send(p.decisionTopic, event.Data.ProcessingID, event)
+}
+
+// SendHoldEvent отправляет событие о приостановке в топик решений, чтобы сохранить порядок событий по транзакции
+func (p *ProducerImpl) SendHoldEvent(event *models.KafkaHoldEvent) error {
+	return p.send(p.decisionTopic, event.Data.ProcessingID, event)
+}
+
+func (p *ProducerImpl) send(topic, key string, event interface{}) error {
 	data, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
 	msg := &sarama.ProducerMessage{
-		Topic:     p.topic,
+		Topic:     topic,
 		Value:     sarama.StringEncoder(data),
 		Timestamp: time.Now(),
 	}
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
 
 	partition, offset, err := p.producer.SendMessage(msg)
 	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
 
-	log.Printf("Message sent to topic %s, partition %d, offset %d", p.topic, partition, offset)
+	log.Printf("Message sent to topic %s, partition %d, offset %d", topic, partition, offset)
 	return nil
 }
 
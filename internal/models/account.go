@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// Сегменты клиентов
+const (
+	SegmentRetail    = "retail"
+	SegmentSME       = "sme"
+	SegmentCorporate = "corporate"
+)
+
+// Рейтинги риска KYC
+const (
+	KYCRiskLow    = "low"
+	KYCRiskMedium = "medium"
+	KYCRiskHigh   = "high"
+)
+
+// Признаки резидентства
+const (
+	ResidencyResident    = "resident"
+	ResidencyNonResident = "non_resident"
+)
+
+// Customer представляет клиента банка с данными KYC
+type Customer struct {
+	CustomerID    string    `json:"customer_id" binding:"required"`
+	FullName      string    `json:"full_name" binding:"required"`
+	Segment       string    `json:"segment" binding:"required,oneof=retail sme corporate"`
+	Residency     string    `json:"residency" binding:"required,oneof=resident non_resident"`
+	Country       string    `json:"country"`
+	KYCRiskRating string    `json:"kyc_risk_rating" binding:"required,oneof=low medium high"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Account представляет счет клиента
+type Account struct {
+	AccountNumber    string    `json:"account_number" binding:"required"`
+	CustomerID       string    `json:"customer_id" binding:"required"`
+	Currency         string    `json:"currency"`
+	OpenedAt         time.Time `json:"opened_at"`
+	DeclaredTurnover float64   `json:"declared_turnover" binding:"gte=0"` // Заявленный месячный оборот
+	Status           string    `json:"status"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// AccountProfile объединяет счет и владельца - контекст для анализатора рисков
+type AccountProfile struct {
+	Account  Account  `json:"account"`
+	Customer Customer `json:"customer"`
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// Направления операций счета в активности
+const (
+	ActivityDirectionSent     = "sent"     // Счет - счет клиента, отправитель операции
+	ActivityDirectionReceived = "received" // Счет - счет контрагента
+)
+
+// Периоды группировки итогов активности
+const (
+	ActivityPeriodDay   = "day"
+	ActivityPeriodWeek  = "week"
+	ActivityPeriodMonth = "month"
+)
+
+// AccountActivityRequest - запрос активности счета
+type AccountActivityRequest struct {
+	AccountNumber string
+	From          *time.Time // Начало периода по времени операции, включительно
+	To            *time.Time // Конец периода, не включительно
+	Period        string     // Группировка итогов по периодам: day, week или month
+	Limit         int        // Число последних операций в каждом направлении
+}
+
+// AccountActivityFilter задает счет и период для итогов активности
+type AccountActivityFilter struct {
+	AccountNumber string
+	From          *time.Time
+	To            *time.Time
+	Period        string
+}
+
+// AccountActivity - все, что известно об операциях счета, для расследования
+type AccountActivity struct {
+	AccountNumber string     `json:"account_number"`
+	From          *time.Time `json:"from,omitempty"`
+	To            *time.Time `json:"to,omitempty"`
+
+	Sent     []*TransactionSummary `json:"sent"`     // Последние операции, где счет - отправитель
+	Received []*TransactionSummary `json:"received"` // Последние операции, где счет - контрагент
+
+	Totals      *AccountActivityTotals `json:"totals"`             // Итоги по всем операциям периода, а не только по показанным
+	Velocity    *AccountVelocity       `json:"velocity,omitempty"` // Нет, если Redis недоступен
+	ListEntries []*BlacklistEntry      `json:"list_entries"`       // Записи черного и санкционного списков по счету или имени владельца
+}
+
+// AccountActivityTotals - итоги операций счета в разрезах
+// Суммы в разных валютах не складываются: каждая строка относится к одной валюте
+type AccountActivityTotals struct {
+	ByCurrency []*ActivityTotal `json:"by_currency"`
+	ByCountry  []*ActivityTotal `json:"by_country"` // Страна контрагента
+	ByChannel  []*ActivityTotal `json:"by_channel"`
+	ByPeriod   []*ActivityTotal `json:"by_period"` // Дата, неделя (YYYY-Www) или месяц по местному времени операции
+}
+
+// ActivityTotal - число и сумма операций одного направления в одной группе
+type ActivityTotal struct {
+	Direction string  `json:"direction"`
+	Key       string  `json:"key"` // Значение разреза; пусто, если поле операции не заполнено
+	Currency  string  `json:"currency"`
+	Count     int64   `json:"count"`
+	Amount    float64 `json:"amount"`
+}
+
+// AccountVelocity - текущие значения счетчиков Redis, которые используют правила анализа
+type AccountVelocity struct {
+	DailyCount int64               `json:"daily_count"` // Операций за последние сутки
+	FlowWindow string              `json:"flow_window"`
+	Flows      *AccountFlowSummary `json:"flows"`      // Входящие и исходящие потоки за окно
+	FanWindow  string              `json:"fan_window"` // Окно подсчета различных контрагентов
+	FanIn      int64               `json:"fan_in"`     // Различных отправителей за окно (оценка)
+	FanOut     int64               `json:"fan_out"`    // Различных получателей за окно (оценка)
+	Baseline   *AccountBaseline    `json:"baseline,omitempty"`
+}
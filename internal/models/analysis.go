@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+)
+
+// Источники версии анализа
+const (
+	AnalysisSourceIngestion = "ingestion" // Анализ при поступлении операции
+	AnalysisSourceRescore   = "rescore"   // Повторная оценка по запросу
+)
+
+// AnalysisVersion - сохраненный результат одного анализа операции
+// Версии не перезаписываются: каждая повторная оценка добавляет следующую
+type AnalysisVersion struct {
+	ID             int64               `json:"id"`
+	ProcessingID   string              `json:"processing_id"`
+	Version        int                 `json:"version"`
+	RiskScore      int                 `json:"risk_score"`
+	RiskLevel      string              `json:"risk_level"`
+	Recommendation string              `json:"recommendation"`
+	Flags          []string            `json:"flags"`
+	Evidence       map[string][]string `json:"evidence,omitempty"`
+	RulesetVersion string              `json:"ruleset_version,omitempty"`
+	Source         string              `json:"source"`
+	RequestedBy    string              `json:"requested_by,omitempty"`
+	Reason         string              `json:"reason,omitempty"`
+	AnalyzedAt     time.Time           `json:"analyzed_at"`
+	CreatedAt      time.Time           `json:"created_at"`
+
+	Inputs *RuleInputs `json:"-"` // Состояние профилей счета, на котором основана оценка
+}
+
+// RuleInputs - состояние профилей счета на момент анализа операции, от которого зависят правила
+// Сохраняется вместе с результатом: повторная оценка использует его вместо текущих профилей,
+// которые уже учитывают саму операцию и более поздние операции счета.
+// Справочные данные (списки, страны, реестр счетов) берутся текущими - их изменение и есть повод для повторной оценки
+type RuleInputs struct {
+	DailyCount  int64                       `json:"daily_count"`
+	Turnover    *float64                    `json:"turnover,omitempty"` // Исходящий оборот счета за период без самой операции
+	Baseline    *AccountBaseline            `json:"baseline,omitempty"`
+	Beneficiary *BeneficiaryHistory         `json:"beneficiary,omitempty"`
+	Flows       *AccountFlowSummary         `json:"flows,omitempty"`
+	Fans        map[string]*CounterpartyFan `json:"fans,omitempty"` // Ключ - "<счет>:<направление>"
+	Cycles      []FlowCycle                 `json:"cycles,omitempty"`
+}
+
+// RescoreRequest - повторная оценка одной операции
+type RescoreRequest struct {
+	RequestedBy string `json:"requested_by"` // По умолчанию - пользователь запроса
+	Reason      string `json:"reason"`
+}
+
+// BatchRescoreRequest - повторная оценка операций, отобранных фильтром
+// Отбираются только уже проанализированные операции
+type BatchRescoreRequest struct {
+	RequestedBy   string     `json:"requested_by"` // По умолчанию - пользователь запроса
+	Reason        string     `json:"reason"`
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+	RiskLevel     string     `json:"risk_level" binding:"omitempty,oneof=low medium high"`
+	AccountNumber string     `json:"account_number"`
+	Limit         int        `json:"limit"`
+}
+
+// RescoreFilter задает условия отбора операций для повторной оценки
+type RescoreFilter struct {
+	From          *time.Time
+	To            *time.Time
+	RiskLevel     string
+	AccountNumber string
+	Limit         int
+}
+
+// RescoreResult - результат повторной оценки операции в сравнении с предыдущей версией
+type RescoreResult struct {
+	ProcessingID string           `json:"processing_id"`
+	Previous     *AnalysisVersion `json:"previous,omitempty"`
+	Current      *AnalysisVersion `json:"current"`
+	ScoreDelta   int              `json:"score_delta"`
+	AddedFlags   []string         `json:"added_flags"`
+	RemovedFlags []string         `json:"removed_flags"`
+}
+
+// BatchRescoreResult - итог повторной оценки операций по фильтру
+// Ошибки по отдельным операциям не прерывают пакет и перечисляются в failed
+type BatchRescoreResult struct {
+	Selected int              `json:"selected"`
+	Rescored int              `json:"rescored"`
+	Changed  int              `json:"changed"` // Число операций, у которых изменились оценка или флаги
+	Results  []*RescoreResult `json:"results"`
+	Failed   []RescoreFailure `json:"failed,omitempty"`
+}
+
+// RescoreFailure - операция, которую не удалось оценить повторно
+type RescoreFailure struct {
+	ProcessingID string `json:"processing_id"`
+	Error        string `json:"error"`
+}
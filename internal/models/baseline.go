@@ -0,0 +1,42 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// AccountBaseline содержит накопленную статистику поведения счета
+// Используется для оценки отклонения новой транзакции от привычного профиля
+type AccountBaseline struct {
+	AccountNumber    string           `json:"account_number"`
+	TransactionCount int64            `json:"transaction_count"`
+	AmountSum        float64          `json:"amount_sum"`
+	AmountSumSquares float64          `json:"amount_sum_squares"`
+	Countries        map[string]int64 `json:"countries"`
+	Channels         map[string]int64 `json:"channels"`
+	Hours            map[string]int64 `json:"hours"`          // Час суток (00-23) -> количество операций
+	Counterparties   map[string]int64 `json:"counterparties"` // Счет контрагента -> количество операций
+	UpdatedAt        time.Time        `json:"updated_at"`
+}
+
+// MeanAmount возвращает среднюю сумму операции
+func (b *AccountBaseline) MeanAmount() float64 {
+	if b.TransactionCount == 0 {
+		return 0
+	}
+	return b.AmountSum / float64(b.TransactionCount)
+}
+
+// StdDevAmount возвращает стандартное отклонение суммы операции
+func (b *AccountBaseline) StdDevAmount() float64 {
+	if b.TransactionCount == 0 {
+		return 0
+	}
+	mean := b.MeanAmount()
+	variance := b.AmountSumSquares/float64(b.TransactionCount) - mean*mean
+	if variance < 0 {
+		// Погрешность вычислений с плавающей точкой
+		variance = 0
+	}
+	return math.Sqrt(variance)
+}
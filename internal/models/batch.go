@@ -0,0 +1,42 @@
+package models
+
+// Статусы записей пакетной загрузки
+const (
+	BatchRecordAccepted = "accepted" // Сохранена и отправлена на анализ
+	BatchRecordRejected = "rejected" // Не прошла разбор или проверку полей, не сохранена
+	BatchRecordFailed   = "failed"   // Ошибка сохранения или отправки в Kafka
+)
+
+// BatchRecord - запись пакета после разбора
+type BatchRecord struct {
+	Index   int                // Порядковый номер записи в пакете, начиная с 0
+	Line    int                // Номер строки NDJSON; 0 для JSON-массива
+	Request *ProcessingRequest // Нет, если запись не удалось разобрать
+	Error   string             // Ошибка разбора или проверки полей; такая запись не сохраняется
+}
+
+// TransactionBatchItem - транзакция пакета с присвоенным processing_id
+type TransactionBatchItem struct {
+	ProcessingID string
+	Transaction  *Transaction
+}
+
+// BatchRecordResult - результат обработки одной записи пакета
+type BatchRecordResult struct {
+	Index         int          `json:"index"`
+	Line          int          `json:"line,omitempty"`
+	TransactionID string       `json:"transaction_id,omitempty"`
+	ProcessingID  string       `json:"processing_id,omitempty"` // Есть и у failed, если запись сохранена, но не отправлена в Kafka
+	Status        string       `json:"status"`
+	Error         string       `json:"error,omitempty"`
+	Fields        []FieldError `json:"fields,omitempty"` // Ошибки проверки по полям записи
+}
+
+// BatchIngestionResult - итог пакетной загрузки с результатами в порядке записей
+type BatchIngestionResult struct {
+	Total    int                  `json:"total"`
+	Accepted int                  `json:"accepted"`
+	Rejected int                  `json:"rejected"`
+	Failed   int                  `json:"failed"`
+	Results  []*BatchRecordResult `json:"results"`
+}
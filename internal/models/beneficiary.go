@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// BeneficiaryHistory описывает, платил ли счет ранее данному контрагенту
+type BeneficiaryHistory struct {
+	FirstSeenAt        *time.Time `json:"first_seen_at,omitempty"` // nil - платеж этому контрагенту первый
+	BankKnown          bool       `json:"bank_known"`
+	KnownBeneficiaries int64      `json:"known_beneficiaries"`
+	KnownBanks         int64      `json:"known_banks"`
+}
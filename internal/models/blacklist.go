@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+)
+
+// Списки, в которые добавляются записи
+const (
+	BlacklistTypeBlacklist = "blacklist" // Внутренний черный список банка
+	BlacklistTypeSanctions = "sanctions" // Санкционный список
+)
+
+// Основания совпадения при ретроспективной проверке
+const (
+	BlacklistMatchAccount      = "account"              // Операция по счету из записи
+	BlacklistMatchCounterparty = "counterparty_account" // Платеж на счет из записи
+	BlacklistMatchName         = "name"                 // Операция по счету клиента, имя которого совпало с записью
+)
+
+// BlacklistEntry - запись черного или санкционного списка вместе с результатом ретроспективной проверки
+type BlacklistEntry struct {
+	ID            int64      `json:"id"`
+	ListType      string     `json:"list_type"`
+	AccountNumber string     `json:"account_number,omitempty"`
+	Name          string     `json:"name,omitempty"`
+	Reason        string     `json:"reason"`
+	AddedBy       string     `json:"added_by"`
+	LookbackFrom  time.Time  `json:"lookback_from"` // Начало периода ретроспективной проверки
+	ScreenedAt    *time.Time `json:"screened_at,omitempty"`
+	MatchCount    int        `json:"match_count"`
+	CreatedAt     time.Time  `json:"created_at"`
+
+	Matches []*BlacklistMatch `json:"matches,omitempty"`
+}
+
+// BlacklistEntryRequest - добавление записи в черный или санкционный список
+// Должен быть указан счет, имя или оба
+type BlacklistEntryRequest struct {
+	ListType      string `json:"list_type" binding:"required,oneof=blacklist sanctions"`
+	AccountNumber string `json:"account_number"`
+	Name          string `json:"name"`
+	Reason        string `json:"reason" binding:"required"`
+	AddedBy       string `json:"added_by"` // По умолчанию - пользователь запроса
+}
+
+// BlacklistMatch - прошлая операция, найденная ретроспективной проверкой записи
+type BlacklistMatch struct {
+	ID                  int64     `json:"id"`
+	EntryID             int64     `json:"entry_id"`
+	ProcessingID        string    `json:"processing_id"`
+	TransactionID       string    `json:"transaction_id"`
+	AccountNumber       string    `json:"account_number"`
+	CounterpartyAccount string    `json:"counterparty_account,omitempty"`
+	Amount              float64   `json:"amount"`
+	Currency            string    `json:"currency"`
+	TransactionTime     time.Time `json:"transaction_time"`
+	MatchedOn           string    `json:"matched_on"`
+	AlertID             int64     `json:"alert_id,omitempty"`
+	CaseID              int64     `json:"case_id,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// BlacklistFilter задает условия выборки записей списков
+type BlacklistFilter struct {
+	ListType      string
+	AccountNumber string // Записи со счетом или с именем владельца счета
+	Limit         int
+}
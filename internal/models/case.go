@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы алертов
+const (
+	AlertStatusOpen   = "open"
+	AlertStatusClosed = "closed"
+)
+
+// Статусы кейсов
+const (
+	CaseStatusOpen          = "open"
+	CaseStatusAssigned      = "assigned"
+	CaseStatusInvestigating = "investigating"
+	CaseStatusEscalated     = "escalated"
+	CaseStatusClosed        = "closed"
+)
+
+// Решения по закрытому кейсу
+const (
+	CaseResolutionFalsePositive   = "false_positive"
+	CaseResolutionNoFurtherAction = "no_further_action"
+	CaseResolutionSARFiled        = "sar_filed"
+)
+
+// Alert - сигнал анализатора, требующий внимания аналитика
+type Alert struct {
+	ID             int64     `json:"id"`
+	CaseID         int64     `json:"case_id"`
+	ProcessingID   string    `json:"processing_id"`
+	TransactionID  string    `json:"transaction_id"`
+	AccountNumber  string    `json:"account_number"`
+	RiskScore      int       `json:"risk_score"`
+	RiskLevel      string    `json:"risk_level"`
+	Recommendation string    `json:"recommendation"`
+	Flags          []string  `json:"flags"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Case объединяет алерты по одному счету для расследования
+type Case struct {
+	ID            int64      `json:"id"`
+	AccountNumber string     `json:"account_number"`
+	Status        string     `json:"status"`
+	AssignedTo    string     `json:"assigned_to,omitempty"`
+	Resolution    string     `json:"resolution,omitempty"`
+	MaxRiskScore  int        `json:"max_risk_score"`
+	AlertCount    int        `json:"alert_count"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	ClosedAt      *time.Time `json:"closed_at,omitempty"`
+}
+
+// CaseNote - заметка аналитика по кейсу
+type CaseNote struct {
+	ID        int64     `json:"id"`
+	CaseID    int64     `json:"case_id"`
+	Author    string    `json:"author"` // По умолчанию - пользователь запроса
+	Text      string    `json:"text" binding:"required"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CaseAttachment - ссылка на документ, приложенный к кейсу (сам документ хранится во внешней системе)
+type CaseAttachment struct {
+	ID        int64     `json:"id"`
+	CaseID    int64     `json:"case_id"`
+	Name      string    `json:"name" binding:"required"`
+	Reference string    `json:"reference" binding:"required"`
+	AddedBy   string    `json:"added_by"` // По умолчанию - пользователь запроса
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CaseDetails - кейс вместе с алертами, заметками и вложениями
+type CaseDetails struct {
+	Case        Case              `json:"case"`
+	Alerts      []*Alert          `json:"alerts"`
+	Notes       []*CaseNote       `json:"notes"`
+	Attachments []*CaseAttachment `json:"attachments"`
+}
+
+// CaseUpdate - изменение кейса аналитиком; пустые поля не меняются
+type CaseUpdate struct {
+	Status     string `json:"status" binding:"omitempty,oneof=open assigned investigating escalated closed"`
+	AssignedTo string `json:"assigned_to"`
+	Resolution string `json:"resolution" binding:"omitempty,oneof=false_positive no_further_action sar_filed"`
+	Actor      string `json:"actor"` // По умолчанию - пользователь запроса
+}
+
+// CaseFilter задает условия выборки кейсов
+type CaseFilter struct {
+	Status        string
+	AssignedTo    string
+	AccountNumber string
+	Limit         int
+}
+
+// AlertFilter задает условия выборки алертов
+type AlertFilter struct {
+	Status        string
+	AccountNumber string
+	Limit         int
+}
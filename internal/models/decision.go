@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+)
+
+// Решения по транзакции для core banking
+const (
+	DecisionPending  = "pending"  // Анализ еще не выполнен
+	DecisionApproved = "approved" // Операция разрешена
+	DecisionHeld     = "held"     // Операция приостановлена до проверки
+	DecisionReleased = "released" // Приостановленная операция разрешена после проверки
+	DecisionBlocked  = "blocked"  // Операция отклонена
+	DecisionReported = "reported" // По операции направлено сообщение в уполномоченный орган
+)
+
+// DecisionActorSystem - автор решений, принятых автоматически по рекомендации анализатора
+const DecisionActorSystem = "system"
+
+// DecisionEventType - тип события Kafka об изменении решения
+const DecisionEventType = "transaction_decision_changed"
+
+// Статусы запроса на подтверждение решения вторым сотрудником
+const (
+	ApprovalStatusPending  = "pending"
+	ApprovalStatusApproved = "approved"
+	ApprovalStatusRejected = "rejected"
+	ApprovalStatusExpired  = "expired"
+)
+
+// TransactionDecision - текущее решение по транзакции
+type TransactionDecision struct {
+	ProcessingID string    `json:"processing_id"`
+	Decision     string    `json:"decision"`
+	Actor        string    `json:"actor,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	PendingApproval *DecisionApproval `json:"pending_approval,omitempty"` // Ожидающий подтверждения запрос на изменение решения
+}
+
+// DecisionChange - запись истории решений по транзакции
+type DecisionChange struct {
+	ID           int64     `json:"id"`
+	ProcessingID string    `json:"processing_id"`
+	FromDecision string    `json:"from_decision"`
+	ToDecision   string    `json:"to_decision"`
+	Actor        string    `json:"actor"`
+	ApprovedBy   string    `json:"approved_by,omitempty"` // Второй сотрудник, подтвердивший решение
+	Reason       string    `json:"reason"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DecisionApproval - запрос на изменение решения, ожидающий подтверждения вторым сотрудником
+type DecisionApproval struct {
+	ID           int64      `json:"id"`
+	ProcessingID string     `json:"processing_id"`
+	FromDecision string     `json:"from_decision"`
+	ToDecision   string     `json:"to_decision"`
+	RequestedBy  string     `json:"requested_by"`
+	Reason       string     `json:"reason"`
+	Status       string     `json:"status"`
+	ResolvedBy   string     `json:"resolved_by,omitempty"`
+	Comment      string     `json:"comment,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+}
+
+// DecisionApprovalFilter задает условия выборки запросов на подтверждение
+type DecisionApprovalFilter struct {
+	Status       string
+	ProcessingID string
+	Limit        int
+}
+
+// ApprovalRejection - отклонение запроса на подтверждение
+type ApprovalRejection struct {
+	Comment string `json:"comment" binding:"required"`
+}
+
+// DecisionDetails - текущее решение вместе с историей изменений
+type DecisionDetails struct {
+	Decision TransactionDecision `json:"decision"`
+	History  []*DecisionChange   `json:"history"`
+}
+
+// DecisionRequest - изменение решения по транзакции
+// Actor можно не указывать, если пользователь запроса известен; иначе он должен с ним совпадать
+type DecisionRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=approved held released blocked reported"`
+	Actor    string `json:"actor"`
+	Reason   string `json:"reason" binding:"required"`
+}
+
+// KafkaDecisionEvent - событие Kafka об изменении решения по транзакции
+type KafkaDecisionEvent struct {
+	EventID   string            `json:"event_id"`
+	EventType string            `json:"event_type"`
+	Timestamp time.Time         `json:"timestamp"`
+	Data      KafkaDecisionData `json:"data"`
+}
+
+// KafkaDecisionData - данные события об изменении решения
+type KafkaDecisionData struct {
+	ProcessingID  string `json:"processing_id"`
+	TransactionID string `json:"transaction_id"`
+	FromDecision  string `json:"from_decision"`
+	Decision      string `json:"decision"`
+	Actor         string `json:"actor"`
+	ApprovedBy    string `json:"approved_by,omitempty"`
+	Reason        string `json:"reason"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Заключения аналитика по сработавшим флагам
+const (
+	DispositionTruePositive  = "true_positive"
+	DispositionFalsePositive = "false_positive"
+	DispositionInconclusive  = "inconclusive"
+)
+
+// Disposition - заключение аналитика о том, была ли транзакция действительно подозрительной
+// Задается по processing_id транзакции или по ID алерта; на транзакцию хранится одно актуальное заключение
+type Disposition struct {
+	ID           int64     `json:"id"`
+	ProcessingID string    `json:"processing_id"`
+	AlertID      int64     `json:"alert_id,omitempty"`
+	Disposition  string    `json:"disposition" binding:"required,oneof=true_positive false_positive inconclusive"`
+	Analyst      string    `json:"analyst"` // По умолчанию - пользователь запроса
+	Comment      string    `json:"comment,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// FlagPrecision - статистика срабатываний одного флага в рамках версии правил
+type FlagPrecision struct {
+	Flag           string   `json:"flag"`
+	RulesetVersion string   `json:"ruleset_version"`
+	Hits           int      `json:"hits"`           // Транзакций с этим флагом
+	TruePositives  int      `json:"true_positives"` // Подтверждено аналитиком
+	FalsePositives int      `json:"false_positives"`
+	Inconclusive   int      `json:"inconclusive"`
+	Precision      *float64 `json:"precision"` // TP / (TP + FP); nil, пока нет заключений
+	Alerts         int      `json:"alerts"`    // Транзакций с флагом, по которым создан алерт
+	SARs           int      `json:"sars"`      // Из них в кейсах, закрытых с направлением SAR
+	AlertToSAR     *float64 `json:"alert_to_sar"`
+}
+
+// PrecisionReport - отчет о точности флагов за период [From, To)
+type PrecisionReport struct {
+	From  time.Time        `json:"from"`
+	To    time.Time        `json:"to"`
+	Flags []*FlagPrecision `json:"flags"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Направления движения средств по счету
+const (
+	FlowInbound  = "in"
+	FlowOutbound = "out"
+)
+
+// AccountFlowSummary содержит агрегированные входящие и исходящие потоки счета за окно
+type AccountFlowSummary struct {
+	Inflow        float64    `json:"inflow"`
+	Outflow       float64    `json:"outflow"`
+	InflowCount   int64      `json:"inflow_count"`
+	OutflowCount  int64      `json:"outflow_count"`
+	LastInflowAt  *time.Time `json:"last_inflow_at,omitempty"`
+	FirstInflowAt *time.Time `json:"first_inflow_at,omitempty"`
+}
+
+// FlowDirection определяет направление движения средств для транзакции
+func FlowDirection(tx *Transaction) string {
+	if tx.TransactionType == "deposit" {
+		return FlowInbound
+	}
+	return FlowOutbound
+}
+
+// CounterpartyFan описывает число различных контрагентов счета в одном направлении за окно
+type CounterpartyFan struct {
+	AccountNumber  string   `json:"account_number"`
+	Direction      string   `json:"direction"`
+	DistinctCount  int64    `json:"distinct_count"` // Оценка HyperLogLog, включая текущего контрагента
+	Counterparties []string `json:"counterparties"` // Последние контрагенты за окно, начиная с текущего
+}
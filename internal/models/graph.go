@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// TransferEdge - направленное ребро графа переводов: от счета плательщика к счету получателя
+type TransferEdge struct {
+	TransactionID string    `json:"transaction_id"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	Amount        float64   `json:"amount"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// FlowCycle - обнаруженный круговой поток средств A → B → ... → A
+// Edges упорядочены по ходу движения средств, последнее ребро - замыкающая транзакция
+type FlowCycle struct {
+	ID                   int64          `json:"id"`
+	ClosingTransactionID string         `json:"closing_transaction_id"`
+	Accounts             []string       `json:"accounts"`
+	Edges                []TransferEdge `json:"edges"`
+	Length               int            `json:"length"`
+	TotalAmount          float64        `json:"total_amount"`
+	DetectedAt           time.Time      `json:"detected_at"`
+}
+
+// TransferEdgeFromTransaction строит ребро графа для исходящего перевода
+// Возвращает nil, если у транзакции нет счета контрагента или это поступление
+func TransferEdgeFromTransaction(tx *Transaction) *TransferEdge {
+	if tx.CounterpartyAccount == "" || FlowDirection(tx) != FlowOutbound {
+		return nil
+	}
+	return &TransferEdge{
+		TransactionID: tx.TransactionID,
+		From:          tx.AccountNumber,
+		To:            tx.CounterpartyAccount,
+		Amount:        tx.Amount,
+		Timestamp:     tx.Timestamp,
+	}
+}
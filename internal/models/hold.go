@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+)
+
+// Действия по истечении срока проверки приостановленной операции
+const (
+	HoldExpiryRelease  = "release"  // Операция разрешается автоматически
+	HoldExpiryEscalate = "escalate" // Операция остается приостановленной, кейс эскалируется
+)
+
+// Статусы таймера приостановки
+const (
+	HoldStatusActive    = "active"    // Срок проверки еще идет
+	HoldStatusResolved  = "resolved"  // Решение по операции принято до истечения срока
+	HoldStatusReleased  = "released"  // Операция разрешена автоматически по истечении срока
+	HoldStatusEscalated = "escalated" // Кейс эскалирован по истечении срока
+)
+
+// Типы событий Kafka о приостановке операции
+const (
+	HoldEventPlaced    = "transaction_hold_placed"
+	HoldEventReleased  = "transaction_hold_released"
+	HoldEventEscalated = "transaction_hold_escalated"
+)
+
+// TransactionHold - таймер проверки приостановленной операции
+// Хранится в БД, поэтому сроки переживают перезапуск сервиса
+type TransactionHold struct {
+	ID           int64      `json:"id"`
+	ProcessingID string     `json:"processing_id"`
+	ExpiryAction string     `json:"expiry_action"`
+	Status       string     `json:"status"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+}
+
+// TransactionHoldFilter задает условия выборки таймеров приостановки
+type TransactionHoldFilter struct {
+	Status string
+	Limit  int
+}
+
+// KafkaHoldEvent - событие Kafka о приостановке операции и ее завершении по таймеру
+type KafkaHoldEvent struct {
+	EventID   string        `json:"event_id"`
+	EventType string        `json:"event_type"`
+	Timestamp time.Time     `json:"timestamp"`
+	Data      KafkaHoldData `json:"data"`
+}
+
+// KafkaHoldData - данные события о приостановке
+type KafkaHoldData struct {
+	ProcessingID  string    `json:"processing_id"`
+	TransactionID string    `json:"transaction_id"`
+	HoldID        int64     `json:"hold_id"`
+	Status        string    `json:"status"`
+	ExpiryAction  string    `json:"expiry_action"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	CaseID        int64     `json:"case_id,omitempty"` // Эскалированный кейс
+}
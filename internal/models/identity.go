@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Роли пользователей API
+const (
+	RoleIngest     = "ingest"     // Передает транзакции и данные реестра клиентов и счетов (системы-источники)
+	RoleAnalyst    = "analyst"    // Расследует операции, ведет кейсы и предлагает решения
+	RoleApprover   = "approver"   // Подтверждает блокировку и разблокировку операций (принцип четырех глаз)
+	RoleSupervisor = "supervisor" // Руководитель аналитиков: подтверждает решения, ведет списки и отчетность
+	RoleAdmin      = "admin"      // Полный доступ, включая очистку данных
+)
+
+// Identity - пользователь, от имени которого выполняется запрос
+type Identity struct {
+	UserID string   `json:"user_id"`
+	Roles  []string `json:"roles"`
+}
+
+// HasRole проверяет наличие роли у пользователя
+func (i Identity) HasRole(role string) bool {
+	for _, r := range i.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamToken - короткоживущий токен подписки на изменения транзакций
+// Передается в параметре stream_token, так как EventSource в браузере не умеет передавать заголовки
+type StreamToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
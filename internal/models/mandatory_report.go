@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы записи очереди обязательного контроля
+const (
+	MandatoryReportQueued   = "queued"   // Ожидает выгрузки
+	MandatoryReportExported = "exported" // Включена в ежедневную выгрузку
+)
+
+// MandatoryReport - операция, подлежащая обязательному контролю, в очереди на выгрузку
+// Одна операция может попасть в очередь по нескольким правилам
+type MandatoryReport struct {
+	ID              int64      `json:"id"`
+	Rule            string     `json:"rule"`
+	ProcessingID    string     `json:"processing_id"`
+	TransactionID   string     `json:"transaction_id"`
+	AccountNumber   string     `json:"account_number"`
+	CustomerID      string     `json:"customer_id,omitempty"`
+	TransactionType string     `json:"transaction_type"`
+	Amount          float64    `json:"amount"`
+	Currency        string     `json:"currency"`
+	AmountLocal     float64    `json:"amount_local"`
+	DailyTotalLocal float64    `json:"daily_total_local,omitempty"` // Сумма операций с наличными клиента за день (для правила агрегирования)
+	BusinessDate    string     `json:"business_date"`               // Дата операции (UTC), YYYY-MM-DD
+	TransactionTime time.Time  `json:"transaction_time"`
+	Status          string     `json:"status"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ExportedAt      *time.Time `json:"exported_at,omitempty"`
+}
+
+// MandatoryReportFilter задает условия выборки очереди обязательного контроля
+type MandatoryReportFilter struct {
+	BusinessDate string
+	Status       string
+	Rule         string
+	Limit        int
+}
+
+// MandatoryReportExport - ежедневная выгрузка очереди обязательного контроля
+type MandatoryReportExport struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+	Count       int
+}
+
+// TransactionRecord - сохраненная транзакция вместе с processing_id
+type TransactionRecord struct {
+	ProcessingID string      `json:"processing_id"`
+	Transaction  Transaction `json:"transaction"`
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+)
+
+// Статусы сообщения о подозрительной деятельности
+const (
+	SARStatusGenerated = "generated" // Сформировано и прошло проверку
+	SARStatusSubmitted = "submitted" // Загружено на портал регулятора
+	SARStatusAccepted  = "accepted"  // Принято регулятором
+	SARStatusRejected  = "rejected"  // Отклонено регулятором
+)
+
+// SARReport - сообщение goAML, сформированное по кейсу
+type SARReport struct {
+	ID                 int64      `json:"id"`
+	CaseID             int64      `json:"case_id"`
+	ReportCode         string     `json:"report_code"`
+	ReferenceNumber    string     `json:"reference_number"`              // Номер сообщения в системе банка (entity_reference)
+	RegulatorReference string     `json:"regulator_reference,omitempty"` // Номер, присвоенный порталом регулятора
+	Status             string     `json:"status"`
+	TransactionCount   int        `json:"transaction_count"`
+	CreatedBy          string     `json:"created_by"`
+	Comment            string     `json:"comment,omitempty"`
+	XML                []byte     `json:"-"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	SubmittedAt        *time.Time `json:"submitted_at,omitempty"`
+}
+
+// SARReportRequest - запрос на формирование сообщения по кейсу
+type SARReportRequest struct {
+	ReportCode string `json:"report_code" binding:"omitempty,oneof=STR SAR"`
+	Reason     string `json:"reason" binding:"required"` // Обоснование подозрений для регулятора
+	Action     string `json:"action"`                    // Принятые банком меры
+	Actor      string `json:"actor"`                     // По умолчанию - пользователь запроса
+}
+
+// SARReportUpdate - изменение статуса сообщения после подачи регулятору
+type SARReportUpdate struct {
+	Status             string `json:"status" binding:"required,oneof=submitted accepted rejected"`
+	RegulatorReference string `json:"regulator_reference"`
+	Comment            string `json:"comment"`
+	Actor              string `json:"actor"` // По умолчанию - пользователь запроса
+}
+
+// SARReportFilter задает условия выборки сообщений
+type SARReportFilter struct {
+	CaseID int64
+	Status string
+	Limit  int
+}
+
+// CaseTransaction - операция кейса вместе с флагами анализа
+type CaseTransaction struct {
+	ProcessingID string      `json:"processing_id"`
+	Transaction  Transaction `json:"transaction"`
+	Flags        []string    `json:"flags"`
+}
@@ -5,17 +5,20 @@ import (
 )
 
 // Transaction представляет банковскую транзакцию
+// Коды валют и стран проверяются по ISO 4217 и ISO 3166-1 alpha-2 (только заглавные буквы), BIC - по ISO 9362.
+// Номера счетов в формате IBAN дополнительно проверяются по контрольной сумме (validation.Validator)
 type Transaction struct {
-	TransactionID       string    `json:"transaction_id" binding:"required"`
+	TransactionID       string    `json:"transaction_id" binding:"required,max=64"`
 	AccountNumber       string    `json:"account_number" binding:"required"`
 	Amount              float64   `json:"amount" binding:"required,gt=0"`
-	Currency            string    `json:"currency" binding:"required"`
-	TransactionType     string    `json:"transaction_type" binding:"required"`
+	Currency            string    `json:"currency" binding:"required,iso4217"`
+	TransactionType     string    `json:"transaction_type" binding:"required,oneof=transfer international_transfer withdrawal deposit payment"`
 	CounterpartyAccount string    `json:"counterparty_account"`
-	CounterpartyBank    string    `json:"counterparty_bank"`
-	CounterpartyCountry string    `json:"counterparty_country"`
-	Timestamp           time.Time `json:"timestamp"`
-	Channel             string    `json:"channel"`
+	CounterpartyBank    string    `json:"counterparty_bank"`                        // Наименование банка контрагента
+	CounterpartyBIC     string    `json:"counterparty_bic" binding:"omitempty,bic"` // BIC (SWIFT) банка контрагента
+	CounterpartyCountry string    `json:"counterparty_country" binding:"omitempty,iso3166_1_alpha2"`
+	Timestamp           time.Time `json:"timestamp"` // Не задано - время приема
+	Channel             string    `json:"channel" binding:"omitempty,oneof=online mobile branch atm card"`
 	UserID              string    `json:"user_id"`
 	BranchID            string    `json:"branch_id"`
 }
@@ -58,6 +61,7 @@ type TransactionStatusResponse struct {
 	RiskLevel         *string   `json:"risk_level,omitempty"`
 	AnalysisTimestamp *time.Time `json:"analysis_timestamp,omitempty"`
 	Flags             []string  `json:"flags,omitempty"`
+	Evidence          map[string][]string `json:"evidence,omitempty"`
 }
 
 // RiskAnalysis представляет результат анализа рисков
@@ -67,6 +71,9 @@ type RiskAnalysis struct {
 	Flags         []string `json:"flags"`
 	Recommendation string  `json:"recommendation"`
 	AnalyzedAt    time.Time `json:"analyzed_at"`
+	Evidence      map[string][]string `json:"evidence,omitempty"` // Факты, на которых основаны флаги (например, контрагенты fan_in)
+	RulesetVersion string `json:"ruleset_version,omitempty"` // Версия набора правил, которым выполнен анализ
+	Inputs         *RuleInputs `json:"rule_inputs,omitempty"`   // Состояние профилей счета, на котором основана оценка
 }
 
 // KafkaTransactionEvent представляет событие транзакции в Kafka
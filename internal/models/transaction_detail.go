@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Типы событий хронологии операции
+const (
+	TransactionEventReceived            = "received"             // Операция принята и сохранена
+	TransactionEventAnalyzed            = "analyzed"             // Анализ при поступлении
+	TransactionEventRescored            = "rescored"             // Повторная оценка
+	TransactionEventAlertRaised         = "alert_raised"         // Создан алерт
+	TransactionEventDecisionChanged     = "decision_changed"     // Изменено решение
+	TransactionEventApprovalRequested   = "approval_requested"   // Изменение решения ждет подтверждения
+	TransactionEventApprovalResolved    = "approval_resolved"    // Запрос на подтверждение обработан
+	TransactionEventHoldPlaced          = "hold_placed"          // Запущен таймер проверки приостановленной операции
+	TransactionEventHoldResolved        = "hold_resolved"        // Таймер проверки завершен
+	TransactionEventDispositionRecorded = "disposition_recorded" // Аналитик дал заключение
+)
+
+// TransactionDetail - все сохраненные данные об операции для расследования
+type TransactionDetail struct {
+	ProcessingID string      `json:"processing_id"`
+	Transaction  Transaction `json:"transaction"` // Операция в том виде, в каком она была принята
+	Status       string      `json:"status"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+
+	Analysis    *AnalysisVersion     `json:"analysis,omitempty"` // Текущий результат анализа; нет, пока анализ не выполнен
+	Analyses    []*AnalysisVersion   `json:"analyses"`           // Все сохраненные версии анализа, начиная с первой
+	Decision    *TransactionDecision `json:"decision"`
+	Alert       *Alert               `json:"alert,omitempty"`
+	Hold        *TransactionHold     `json:"hold,omitempty"`
+	Disposition *Disposition         `json:"disposition,omitempty"`
+
+	Timeline []*TransactionEvent `json:"timeline"` // События по операции в порядке времени
+}
+
+// TransactionEvent - событие в хронологии операции
+type TransactionEvent struct {
+	Type    string    `json:"type"`
+	At      time.Time `json:"at"`
+	Actor   string    `json:"actor,omitempty"`
+	Summary string    `json:"summary"`
+	Details string    `json:"details,omitempty"` // Причина или комментарий
+}
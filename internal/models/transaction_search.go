@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+)
+
+// Поля сортировки списка транзакций
+const (
+	TransactionSortCreatedAt = "created_at" // Порядок поступления в систему
+	TransactionSortTimestamp = "timestamp"  // Время совершения операции
+	TransactionSortAmount    = "amount"
+	TransactionSortRiskScore = "risk_score" // Непроанализированные операции идут ниже любой оценки
+)
+
+// Направления сортировки
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// TransactionSearchRequest - условия поиска транзакций и параметры страницы
+// Пустые условия не ограничивают выборку; cursor берется из next_cursor предыдущей страницы
+type TransactionSearchRequest struct {
+	AccountNumber string
+	Counterparty  string // Счет контрагента
+	RiskLevel     string
+	MinScore      *int
+	MaxScore      *int
+	Status        string
+	Currency      string
+	Country       string // Страна контрагента
+	Channel       string
+	From          *time.Time // Время операции, включительно
+	To            *time.Time // Время операции, не включительно
+	SortBy        string
+	Order         string
+	Cursor        string
+	Limit         int
+}
+
+// TransactionSearchFilter - условия выборки транзакций для хранилища
+type TransactionSearchFilter struct {
+	AccountNumber string
+	Counterparty  string
+	RiskLevel     string
+	MinScore      *int
+	MaxScore      *int
+	Status        string
+	Currency      string
+	Country       string
+	Channel       string
+	From          *time.Time
+	To            *time.Time
+	SortBy        string
+	Order         string
+	After         *TransactionCursor // Позиция последней записи предыдущей страницы
+	Limit         int
+}
+
+// TransactionCursor - позиция в списке транзакций: значение поля сортировки и ID последней записи
+// Сортировка и направление сохраняются в курсоре, чтобы курсор не применялся к другому порядку
+type TransactionCursor struct {
+	SortBy      string  `json:"s"`
+	Order       string  `json:"o"`
+	SortKey     float64 `json:"k"`
+	SortKeyNull bool    `json:"n,omitempty"` // У записи нет значения поля сортировки (операция без оценки)
+	ID          int64   `json:"id"`
+}
+
+// TransactionSummary - транзакция в результатах поиска
+type TransactionSummary struct {
+	ID                  int64               `json:"-"`
+	SortKey             float64             `json:"-"` // Значение поля сортировки для курсора
+	SortKeyNull         bool                `json:"-"` // Поле сортировки не заполнено
+	ProcessingID        string              `json:"processing_id"`
+	TransactionID       string              `json:"transaction_id"`
+	AccountNumber       string              `json:"account_number"`
+	Amount              float64             `json:"amount"`
+	Currency            string              `json:"currency"`
+	TransactionType     string              `json:"transaction_type"`
+	CounterpartyAccount string              `json:"counterparty_account,omitempty"`
+	CounterpartyCountry string              `json:"counterparty_country,omitempty"`
+	Channel             string              `json:"channel,omitempty"`
+	Timestamp           time.Time           `json:"timestamp"`
+	Status              string              `json:"status"`
+	RiskScore           *int                `json:"risk_score,omitempty"`
+	RiskLevel           *string             `json:"risk_level,omitempty"`
+	AnalysisTimestamp   *time.Time          `json:"analysis_timestamp,omitempty"`
+	Flags               []string            `json:"flags"`
+	Evidence            map[string][]string `json:"evidence,omitempty"`
+	CreatedAt           time.Time           `json:"created_at"`
+}
+
+// TransactionPage - страница результатов поиска транзакций
+// next_cursor отсутствует на последней странице
+type TransactionPage struct {
+	Transactions []*TransactionSummary `json:"transactions"`
+	NextCursor   string                `json:"next_cursor,omitempty"`
+}
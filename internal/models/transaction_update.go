@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+)
+
+// TransactionWatchRequest - условия подписки на ленту изменений транзакций
+type TransactionWatchRequest struct {
+	AccountNumber string   // Счет клиента или контрагента
+	RiskLevel     string   // Уровень риска на момент события
+	MinScore      *int     // Минимальная оценка риска на момент события
+	Types         []string // Типы событий; пусто - все
+	ResumeToken   string   // Токен последнего полученного события; пусто - только новые события
+}
+
+// TransactionUpdateFilter - позиция и условия чтения ленты изменений
+type TransactionUpdateFilter struct {
+	AfterID       int64
+	AccountNumber string
+	RiskLevel     string
+	MinScore      *int
+	Types         []string
+	Limit         int
+}
+
+// TransactionUpdate - событие ленты изменений транзакций
+// Поля транзакции - снимок на момент события, а не текущее состояние
+type TransactionUpdate struct {
+	ID                  int64     `json:"-"`
+	ResumeToken         string    `json:"resume_token"` // Передается при переподключении, чтобы продолжить ленту после этого события
+	Type                string    `json:"type"`         // received, analyzed, alert_raised или decision_changed
+	ProcessingID        string    `json:"processing_id"`
+	TransactionID       string    `json:"transaction_id"`
+	AccountNumber       string    `json:"account_number"`
+	CounterpartyAccount string    `json:"counterparty_account,omitempty"`
+	Amount              float64   `json:"amount"`
+	Currency            string    `json:"currency"`
+	Status              string    `json:"status"`
+	RiskScore           *int      `json:"risk_score,omitempty"`
+	RiskLevel           *string   `json:"risk_level,omitempty"`
+	Decision            string    `json:"decision,omitempty"` // Для decision_changed
+	AlertID             int64     `json:"alert_id,omitempty"` // Для alert_raised
+	CaseID              int64     `json:"case_id,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// TransactionUpdatePage - события ленты и позиция, с которой продолжать чтение
+type TransactionUpdatePage struct {
+	Updates []*TransactionUpdate
+	NextID  int64 // Последнее просмотренное событие, включая не подошедшие под условия
+}
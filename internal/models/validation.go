@@ -0,0 +1,7 @@
+package models
+
+// FieldError - ошибка проверки одного поля запроса
+type FieldError struct {
+	Field   string `json:"field"`   // Имя поля в JSON, например currency
+	Message string `json:"message"` // Что не так со значением
+}
@@ -0,0 +1,187 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"bank-aml-system/config"
+)
+
+// Операции приема, для которых действуют ограничения; общие для REST и gRPC
+const (
+	OperationTransactions = "transactions" // Прием одной транзакции, в том числе сообщения потока
+	OperationBatch        = "batch"        // Пакетная загрузка
+	OperationGenerate     = "generate"     // Генерация случайной транзакции
+)
+
+// Store хранит корзины токенов
+// Реализуется redis.Client, чтобы ограничения действовали на все экземпляры сервиса
+type Store interface {
+	TakeToken(bucket string, rate float64, burst int) (bool, time.Duration, error)
+}
+
+// Decision - результат проверки ограничения
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration // Через сколько появится следующий токен, если запрос отклонен
+}
+
+// Limiter ограничивает частоту запросов каждого клиента к операциям приема (token bucket)
+// Корзина заводится на пару клиент + операция. Если общее хранилище недоступно, ограничения
+// временно действуют по локальным корзинам экземпляра, а запросы не отклоняются из-за ошибки хранилища
+type Limiter struct {
+	limits       map[string]config.RateLimit
+	clientLimits map[string]config.RateLimit
+	store        Store
+	local        *MemoryStore
+	degraded     atomic.Bool
+}
+
+// NewLimiter создает ограничитель по конфигурации; store может быть nil - тогда корзины хранятся в памяти
+// Возвращает nil, если ограничения отключены
+func NewLimiter(cfg config.RateLimitConfig, store Store) *Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &Limiter{
+		limits:       cfg.Limits,
+		clientLimits: cfg.ClientLimits,
+		store:        store,
+		local:        NewMemoryStore(),
+	}
+}
+
+// Allow забирает токен клиента для операции
+// Операция без ограничения разрешается всегда
+func (l *Limiter) Allow(client, operation string) Decision {
+	limit, ok := l.limit(client, operation)
+	if !ok {
+		return Decision{Allowed: true}
+	}
+
+	bucket := operation + ":" + client
+	if l.store != nil {
+		allowed, retryAfter, err := l.store.TakeToken(bucket, limit.Rate, limit.Burst)
+		if err == nil {
+			if l.degraded.Swap(false) {
+				log.Println("Rate limit store is available again")
+			}
+			return Decision{Allowed: allowed, RetryAfter: retryAfter}
+		}
+		if !l.degraded.Swap(true) {
+			log.Printf("Warning: rate limit store failed, using local limits: %v", err)
+		}
+	}
+
+	allowed, retryAfter, _ := l.local.TakeToken(bucket, limit.Rate, limit.Burst)
+	return Decision{Allowed: allowed, RetryAfter: retryAfter}
+}
+
+// Wait ждет токен клиента для операции; используется для сообщений потоков, которые не отклоняются,
+// а притормаживаются. Возвращает ошибку контекста, если он завершился раньше
+func (l *Limiter) Wait(ctx context.Context, client, operation string) error {
+	for {
+		decision := l.Allow(client, operation)
+		if decision.Allowed {
+			return nil
+		}
+		timer := time.NewTimer(decision.RetryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// limit возвращает ограничение клиента для операции: собственное, если задано, иначе общее
+func (l *Limiter) limit(client, operation string) (config.RateLimit, bool) {
+	if limit, ok := l.clientLimits[client+"/"+operation]; ok {
+		return limit, true
+	}
+	limit, ok := l.limits[operation]
+	return limit, ok
+}
+
+// RetryAfterSeconds округляет время ожидания вверх до целых секунд для заголовка Retry-After (не меньше 1)
+func RetryAfterSeconds(retryAfter time.Duration) int {
+	return int(math.Max(1, math.Ceil(retryAfter.Seconds())))
+}
+
+// sweepInterval - как часто локальное хранилище удаляет заполнившиеся корзины
+const sweepInterval = time.Minute
+
+// MemoryStore хранит корзины токенов в памяти экземпляра
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// memoryBucket - корзина токенов
+type memoryBucket struct {
+	tokens float64
+	burst  float64
+	rate   float64
+	at     time.Time
+}
+
+// NewMemoryStore создает хранилище корзин в памяти
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+}
+
+// TakeToken забирает токен из корзины bucket, пополняемой со скоростью rate токенов в секунду до емкости burst
+func (s *MemoryStore) TakeToken(bucket string, rate float64, burst int) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[bucket]
+	if !ok {
+		b = &memoryBucket{tokens: float64(burst), at: now}
+		s.buckets[bucket] = b
+	}
+	b.rate, b.burst = rate, float64(burst)
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	wait := (1 - b.tokens) / rate
+	return false, time.Duration(wait * float64(time.Second)), nil
+}
+
+// sweep удаляет корзины, которые успели заполниться: новая корзина создается полной
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= b.burst {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+// refill пополняет корзину за время с последнего обращения
+func (b *memoryBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.at).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.at = now
+}
@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingStore - общее хранилище, которое недоступно
+type failingStore struct {
+	calls int
+}
+
+func (s *failingStore) TakeToken(string, float64, int) (bool, time.Duration, error) {
+	s.calls++
+	return false, 0, errors.New("connection refused")
+}
+
+func TestNewLimiter_Disabled(t *testing.T) {
+	assert.Nil(t, NewLimiter(config.RateLimitConfig{Enabled: false}, nil))
+}
+
+func TestMemoryStore_TakeToken(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	// Полная корзина пропускает всплеск в пределах емкости
+	for i := 0; i < 3; i++ {
+		allowed, _, err := store.TakeToken("transactions:core", 2, 3)
+		require.NoError(t, err)
+		assert.True(t, allowed, "request %d", i)
+	}
+
+	allowed, retryAfter, err := store.TakeToken("transactions:core", 2, 3)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Другие клиенты не затронуты
+	allowed, _, _ = store.TakeToken("transactions:other", 2, 3)
+	assert.True(t, allowed)
+
+	// За полсекунды пополняется один токен
+	now = now.Add(500 * time.Millisecond)
+	allowed, _, _ = store.TakeToken("transactions:core", 2, 3)
+	assert.True(t, allowed)
+	allowed, _, _ = store.TakeToken("transactions:core", 2, 3)
+	assert.False(t, allowed)
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	store.TakeToken("transactions:core", 1, 1)
+	store.TakeToken("batch:core", 0.001, 1)
+
+	now = now.Add(2 * sweepInterval)
+	store.TakeToken("generate:core", 1, 1)
+
+	assert.NotContains(t, store.buckets, "transactions:core")
+	assert.Contains(t, store.buckets, "batch:core")
+}
+
+func TestLimiter_ClientLimitsOverrideDefaults(t *testing.T) {
+	limiter := NewLimiter(config.RateLimitConfig{
+		Enabled:      true,
+		Limits:       map[string]config.RateLimit{OperationTransactions: {Rate: 1, Burst: 1}},
+		ClientLimits: map[string]config.RateLimit{"core-banking/" + OperationTransactions: {Rate: 1, Burst: 3}},
+	}, nil)
+
+	assert.True(t, limiter.Allow("partner", OperationTransactions).Allowed)
+	assert.False(t, limiter.Allow("partner", OperationTransactions).Allowed)
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.Allow("core-banking", OperationTransactions).Allowed)
+	}
+	decision := limiter.Allow("core-banking", OperationTransactions)
+	assert.False(t, decision.Allowed)
+	assert.Greater(t, decision.RetryAfter, time.Duration(0))
+
+	// Операция без ограничения разрешается всегда
+	for i := 0; i < 10; i++ {
+		assert.True(t, limiter.Allow("partner", OperationBatch).Allowed)
+	}
+}
+
+func TestLimiter_FallsBackToLocalStore(t *testing.T) {
+	store := &failingStore{}
+	limiter := NewLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Limits:  map[string]config.RateLimit{OperationTransactions: {Rate: 1, Burst: 2}},
+	}, store)
+
+	assert.True(t, limiter.Allow("core-banking", OperationTransactions).Allowed)
+	assert.True(t, limiter.Allow("core-banking", OperationTransactions).Allowed)
+	assert.False(t, limiter.Allow("core-banking", OperationTransactions).Allowed)
+	assert.Equal(t, 3, store.calls)
+}
+
+func TestLimiter_WaitStopsOnContext(t *testing.T) {
+	limiter := NewLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Limits:  map[string]config.RateLimit{OperationTransactions: {Rate: 0.001, Burst: 1}},
+	}, nil)
+	require.NoError(t, limiter.Wait(context.Background(), "core-banking", OperationTransactions))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx, "core-banking", OperationTransactions), context.DeadlineExceeded)
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	assert.Equal(t, 1, RetryAfterSeconds(0))
+	assert.Equal(t, 1, RetryAfterSeconds(200*time.Millisecond))
+	assert.Equal(t, 3, RetryAfterSeconds(2100*time.Millisecond))
+}
@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// baselineTTL - срок хранения поведенческого профиля неактивного счета
+const baselineTTL = 90 * 24 * time.Hour
+
+func baselineKey(accountNumber, suffix string) string {
+	if suffix == "" {
+		return fmt.Sprintf("baseline:account:%s", accountNumber)
+	}
+	return fmt.Sprintf("baseline:account:%s:%s", accountNumber, suffix)
+}
+
+// GetAccountBaseline получает поведенческий профиль счета (nil, если истории нет)
+func (c *Client) GetAccountBaseline(accountNumber string) (*models.AccountBaseline, error) {
+	ctx := context.Background()
+
+	pipe := c.rdb.Pipeline()
+	statsCmd := pipe.HGetAll(ctx, baselineKey(accountNumber, ""))
+	countriesCmd := pipe.HGetAll(ctx, baselineKey(accountNumber, "countries"))
+	channelsCmd := pipe.HGetAll(ctx, baselineKey(accountNumber, "channels"))
+	hoursCmd := pipe.HGetAll(ctx, baselineKey(accountNumber, "hours"))
+	counterpartiesCmd := pipe.HGetAll(ctx, baselineKey(accountNumber, "counterparties"))
+	if _, err := pipe.Exec(ctx); err != nil && err != redisv9.Nil {
+		return nil, fmt.Errorf("failed to get baseline: %w", err)
+	}
+
+	stats := statsCmd.Val()
+	if len(stats) == 0 {
+		return nil, nil
+	}
+
+	baseline := &models.AccountBaseline{
+		AccountNumber:  accountNumber,
+		Countries:      parseCounters(countriesCmd.Val()),
+		Channels:       parseCounters(channelsCmd.Val()),
+		Hours:          parseCounters(hoursCmd.Val()),
+		Counterparties: parseCounters(counterpartiesCmd.Val()),
+	}
+	baseline.TransactionCount, _ = strconv.ParseInt(stats["count"], 10, 64)
+	baseline.AmountSum, _ = strconv.ParseFloat(stats["amount_sum"], 64)
+	baseline.AmountSumSquares, _ = strconv.ParseFloat(stats["amount_sum_squares"], 64)
+	if updatedAt, err := strconv.ParseInt(stats["updated_at"], 10, 64); err == nil {
+		baseline.UpdatedAt = time.Unix(updatedAt, 0)
+	}
+
+	return baseline, nil
+}
+
+// UpdateAccountBaseline добавляет транзакцию в поведенческий профиль счета
+// Все счетчики инкрементальные, поэтому параллельные обновления не теряются
+func (c *Client) UpdateAccountBaseline(accountNumber string, tx *models.Transaction) error {
+	ctx := context.Background()
+	statsKey := baselineKey(accountNumber, "")
+
+	pipe := c.rdb.TxPipeline()
+	pipe.HIncrBy(ctx, statsKey, "count", 1)
+	pipe.HIncrByFloat(ctx, statsKey, "amount_sum", tx.Amount)
+	pipe.HIncrByFloat(ctx, statsKey, "amount_sum_squares", tx.Amount*tx.Amount)
+	pipe.HSet(ctx, statsKey, "updated_at", time.Now().Unix())
+	pipe.Expire(ctx, statsKey, baselineTTL)
+
+	counters := map[string]string{
+		"countries":      tx.CounterpartyCountry,
+		"channels":       tx.Channel,
+		"hours":          fmt.Sprintf("%02d", tx.Timestamp.Hour()),
+		"counterparties": tx.CounterpartyAccount,
+	}
+	for suffix, field := range counters {
+		if field == "" {
+			continue
+		}
+		key := baselineKey(accountNumber, suffix)
+		pipe.HIncrBy(ctx, key, field, 1)
+		pipe.Expire(ctx, key, baselineTTL)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func parseCounters(values map[string]string) map[string]int64 {
+	counters := make(map[string]int64, len(values))
+	for field, value := range values {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			counters[field] = n
+		}
+	}
+	return counters
+}
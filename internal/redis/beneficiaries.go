@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// beneficiaryTTL - срок, после которого давно не использованный получатель снова считается новым
+const beneficiaryTTL = 180 * 24 * time.Hour
+
+// GetBeneficiaryHistory получает историю платежей счета указанному контрагенту и банку
+func (c *Client) GetBeneficiaryHistory(accountNumber, counterpartyAccount, counterpartyBank string) (*models.BeneficiaryHistory, error) {
+	ctx := context.Background()
+	accountsKey := fmt.Sprintf("beneficiaries:account:%s", accountNumber)
+	banksKey := fmt.Sprintf("beneficiaries:account:%s:banks", accountNumber)
+
+	pipe := c.rdb.Pipeline()
+	firstSeenCmd := pipe.HGet(ctx, accountsKey, counterpartyAccount)
+	knownCmd := pipe.HLen(ctx, accountsKey)
+	knownBanksCmd := pipe.HLen(ctx, banksKey)
+	var bankCmd *redisv9.BoolCmd
+	if counterpartyBank != "" {
+		bankCmd = pipe.HExists(ctx, banksKey, counterpartyBank)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redisv9.Nil {
+		return nil, fmt.Errorf("failed to get beneficiary history: %w", err)
+	}
+
+	history := &models.BeneficiaryHistory{
+		KnownBeneficiaries: knownCmd.Val(),
+		KnownBanks:         knownBanksCmd.Val(),
+	}
+	if bankCmd != nil {
+		history.BankKnown = bankCmd.Val()
+	}
+	if ts, err := strconv.ParseInt(firstSeenCmd.Val(), 10, 64); err == nil {
+		firstSeen := time.Unix(ts, 0)
+		history.FirstSeenAt = &firstSeen
+	}
+
+	return history, nil
+}
+
+// RecordBeneficiary запоминает контрагента и его банк как известных для счета
+// Время первого платежа не перезаписывается
+func (c *Client) RecordBeneficiary(accountNumber, counterpartyAccount, counterpartyBank string, seenAt time.Time) error {
+	ctx := context.Background()
+	accountsKey := fmt.Sprintf("beneficiaries:account:%s", accountNumber)
+	banksKey := fmt.Sprintf("beneficiaries:account:%s:banks", accountNumber)
+
+	pipe := c.rdb.TxPipeline()
+	pipe.HSetNX(ctx, accountsKey, counterpartyAccount, seenAt.Unix())
+	pipe.Expire(ctx, accountsKey, beneficiaryTTL)
+	if counterpartyBank != "" {
+		pipe.HSetNX(ctx, banksKey, counterpartyBank, seenAt.Unix())
+		pipe.Expire(ctx, banksKey, beneficiaryTTL)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -14,6 +14,9 @@ func (c *Client) ClearTransactionData() error {
 		"transaction:*",
 		"risk_stats:*",
 		"limits:account:*",
+		"baseline:account:*",
+		"beneficiaries:account:*",
+		"graph:edges:*",
 	}
 
 	for _, pattern := range patterns {
@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+const (
+	// fanRetention - сколько храним почасовые HyperLogLog контрагентов; окно правила не может быть больше
+	fanRetention = 7 * 24 * time.Hour
+	// fanRecentLimit - сколько последних контрагентов храним для отображения в анализе
+	fanRecentLimit = 100
+	// fanEvidenceLimit - сколько контрагентов возвращаем в сводке
+	fanEvidenceLimit = 20
+)
+
+// fanBucketKey - почасовой HyperLogLog различных контрагентов счета
+func fanBucketKey(accountNumber, direction string, at time.Time) string {
+	return fmt.Sprintf("limits:account:%s:fan_%s:%s", accountNumber, direction, at.UTC().Format("2006010215"))
+}
+
+// fanRecentKey - последние контрагенты счета со временем последней операции
+func fanRecentKey(accountNumber, direction string) string {
+	return fmt.Sprintf("limits:account:%s:fan_%s:recent", accountNumber, direction)
+}
+
+// RecordCounterparty учитывает контрагента во входящем или исходящем веере счета
+func (c *Client) RecordCounterparty(accountNumber, direction, counterparty string, at time.Time) error {
+	ctx := context.Background()
+	bucketKey := fanBucketKey(accountNumber, direction, at)
+	recentKey := fanRecentKey(accountNumber, direction)
+
+	pipe := c.rdb.TxPipeline()
+	pipe.PFAdd(ctx, bucketKey, counterparty)
+	pipe.Expire(ctx, bucketKey, fanRetention)
+	pipe.ZAdd(ctx, recentKey, redisv9.Z{Score: float64(at.Unix()), Member: counterparty})
+	pipe.ZRemRangeByScore(ctx, recentKey, "-inf", strconv.FormatInt(at.Add(-fanRetention).Unix(), 10))
+	pipe.ZRemRangeByRank(ctx, recentKey, 0, -fanRecentLimit-1)
+	pipe.Expire(ctx, recentKey, fanRetention)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetCounterpartyFan оценивает число различных контрагентов счета за окно, заканчивающееся в at
+// Кандидат (контрагент текущей операции) учитывается, даже если еще не был записан; пустой кандидат не учитывается
+func (c *Client) GetCounterpartyFan(accountNumber, direction, candidate string, window time.Duration, at time.Time) (*models.CounterpartyFan, error) {
+	ctx := context.Background()
+	if window > fanRetention {
+		window = fanRetention
+	}
+
+	var keys []string
+	for bucket := at.Add(-window).Truncate(time.Hour); !bucket.After(at); bucket = bucket.Add(time.Hour) {
+		keys = append(keys, fanBucketKey(accountNumber, direction, bucket))
+	}
+	recentKey := fanRecentKey(accountNumber, direction)
+	since := strconv.FormatInt(at.Add(-window).Unix(), 10)
+
+	pipe := c.rdb.Pipeline()
+	countCmd := pipe.PFCount(ctx, keys...)
+	candidateCmd := pipe.ZScore(ctx, recentKey, candidate)
+	recentCmd := pipe.ZRevRangeByScore(ctx, recentKey, &redisv9.ZRangeBy{
+		Min:   since,
+		Max:   "+inf",
+		Count: fanEvidenceLimit,
+	})
+	if _, err := pipe.Exec(ctx); err != nil && err != redisv9.Nil {
+		return nil, fmt.Errorf("failed to get counterparty fan: %w", err)
+	}
+
+	fan := &models.CounterpartyFan{
+		AccountNumber:  accountNumber,
+		Direction:      direction,
+		DistinctCount:  countCmd.Val(),
+		Counterparties: []string{},
+	}
+
+	// Кандидата нет среди контрагентов окна - он добавит единицу к оценке
+	if candidate != "" {
+		fan.Counterparties = append(fan.Counterparties, candidate)
+		score, err := candidateCmd.Result()
+		if err == redisv9.Nil || score < float64(at.Add(-window).Unix()) {
+			fan.DistinctCount++
+		}
+	}
+
+	for _, counterparty := range recentCmd.Val() {
+		if counterparty != candidate && len(fan.Counterparties) < fanEvidenceLimit {
+			fan.Counterparties = append(fan.Counterparties, counterparty)
+		}
+	}
+
+	return fan, nil
+}
@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// flowsRetention - сколько храним историю потоков по счету; окно правила не может быть больше
+const flowsRetention = config.AccountFlowsRetention
+
+func flowsKey(accountNumber, direction string) string {
+	return fmt.Sprintf("limits:account:%s:flows:%s", accountNumber, direction)
+}
+
+// RecordAccountFlow добавляет операцию во входящий или исходящий поток счета
+// Элементы sorted set имеют вид "<transaction_id>:<amount>", score - unix-время операции
+func (c *Client) RecordAccountFlow(accountNumber, direction, transactionID string, amount float64, at time.Time) error {
+	ctx := context.Background()
+	key := flowsKey(accountNumber, direction)
+	member := fmt.Sprintf("%s:%s", transactionID, strconv.FormatFloat(amount, 'f', -1, 64))
+
+	pipe := c.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redisv9.Z{Score: float64(at.Unix()), Member: member})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(at.Add(-flowsRetention).Unix(), 10))
+	pipe.Expire(ctx, key, flowsRetention)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetAccountFlows возвращает сводку входящих и исходящих потоков счета начиная с since
+func (c *Client) GetAccountFlows(accountNumber string, since time.Time) (*models.AccountFlowSummary, error) {
+	ctx := context.Background()
+	rangeBy := &redisv9.ZRangeBy{Min: strconv.FormatInt(since.Unix(), 10), Max: "+inf"}
+
+	pipe := c.rdb.Pipeline()
+	inCmd := pipe.ZRangeByScoreWithScores(ctx, flowsKey(accountNumber, models.FlowInbound), rangeBy)
+	outCmd := pipe.ZRangeByScoreWithScores(ctx, flowsKey(accountNumber, models.FlowOutbound), rangeBy)
+	if _, err := pipe.Exec(ctx); err != nil && err != redisv9.Nil {
+		return nil, fmt.Errorf("failed to get account flows: %w", err)
+	}
+
+	summary := &models.AccountFlowSummary{}
+	for _, z := range inCmd.Val() {
+		summary.Inflow += flowAmount(z.Member)
+		summary.InflowCount++
+		at := time.Unix(int64(z.Score), 0)
+		if summary.FirstInflowAt == nil {
+			summary.FirstInflowAt = &at
+		}
+		summary.LastInflowAt = &at
+	}
+	for _, z := range outCmd.Val() {
+		summary.Outflow += flowAmount(z.Member)
+		summary.OutflowCount++
+	}
+
+	return summary, nil
+}
+
+// flowAmount извлекает сумму из элемента потока
+func flowAmount(member interface{}) float64 {
+	s, _ := member.(string)
+	idx := strings.LastIndex(s, ":")
+	if idx < 0 {
+		return 0
+	}
+	amount, _ := strconv.ParseFloat(s[idx+1:], 64)
+	return amount
+}
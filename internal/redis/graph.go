@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// graphEdgesRetention - сколько храним ребра графа переводов; окно поиска циклов не может быть больше
+const graphEdgesRetention = 7 * 24 * time.Hour
+
+func graphEdgesKey(accountNumber string) string {
+	return fmt.Sprintf("graph:edges:%s", accountNumber)
+}
+
+// RecordTransferEdge добавляет перевод в граф как исходящее ребро счета плательщика
+func (c *Client) RecordTransferEdge(edge *models.TransferEdge) error {
+	ctx := context.Background()
+	key := graphEdgesKey(edge.From)
+
+	member, err := json.Marshal(edge)
+	if err != nil {
+		return fmt.Errorf("failed to marshal transfer edge: %w", err)
+	}
+
+	pipe := c.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redisv9.Z{Score: float64(edge.Timestamp.Unix()), Member: string(member)})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(edge.Timestamp.Add(-graphEdgesRetention).Unix(), 10))
+	pipe.Expire(ctx, key, graphEdgesRetention)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetTransferEdges возвращает исходящие переводы счета начиная с since в хронологическом порядке
+func (c *Client) GetTransferEdges(accountNumber string, since time.Time) ([]models.TransferEdge, error) {
+	ctx := context.Background()
+
+	members, err := c.rdb.ZRangeByScore(ctx, graphEdgesKey(accountNumber), &redisv9.ZRangeBy{
+		Min: strconv.FormatInt(since.Unix(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil && err != redisv9.Nil {
+		return nil, fmt.Errorf("failed to get transfer edges: %w", err)
+	}
+
+	edges := make([]models.TransferEdge, 0, len(members))
+	for _, member := range members {
+		var edge models.TransferEdge
+		if err := json.Unmarshal([]byte(member), &edge); err != nil {
+			continue
+		}
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
@@ -1,6 +1,8 @@
 package redis
 
 import (
+	"time"
+
 	"bank-aml-system/internal/models"
 )
 
@@ -23,6 +25,36 @@ type ClientInterface interface {
 	// GetAccountDailyCount получает количество транзакций по счету за день
 	GetAccountDailyCount(accountNumber string) (int64, error)
 	
+	// GetAccountBaseline получает поведенческий профиль счета
+	GetAccountBaseline(accountNumber string) (*models.AccountBaseline, error)
+	
+	// UpdateAccountBaseline добавляет транзакцию в поведенческий профиль счета
+	UpdateAccountBaseline(accountNumber string, tx *models.Transaction) error
+	
+	// GetBeneficiaryHistory получает историю платежей счета контрагенту и его банку
+	GetBeneficiaryHistory(accountNumber, counterpartyAccount, counterpartyBank string) (*models.BeneficiaryHistory, error)
+	
+	// RecordBeneficiary запоминает контрагента как известного для счета
+	RecordBeneficiary(accountNumber, counterpartyAccount, counterpartyBank string, seenAt time.Time) error
+	
+	// RecordAccountFlow добавляет операцию во входящий или исходящий поток счета
+	RecordAccountFlow(accountNumber, direction, transactionID string, amount float64, at time.Time) error
+	
+	// GetAccountFlows возвращает сводку потоков счета начиная с since
+	GetAccountFlows(accountNumber string, since time.Time) (*models.AccountFlowSummary, error)
+	
+	// RecordTransferEdge добавляет перевод в граф переводов между счетами
+	RecordTransferEdge(edge *models.TransferEdge) error
+	
+	// GetTransferEdges возвращает исходящие переводы счета начиная с since
+	GetTransferEdges(accountNumber string, since time.Time) ([]models.TransferEdge, error)
+	
+	// RecordCounterparty учитывает контрагента во входящем или исходящем веере счета
+	RecordCounterparty(accountNumber, direction, counterparty string, at time.Time) error
+	
+	// GetCounterpartyFan оценивает число различных контрагентов счета за окно
+	GetCounterpartyFan(accountNumber, direction, candidate string, window time.Duration, at time.Time) (*models.CounterpartyFan, error)
+	
 	// IsAccountBlacklisted проверяет, находится ли счет в черном списке
 	IsAccountBlacklisted(accountNumber string) (bool, error)
 	
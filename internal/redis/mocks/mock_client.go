@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"time"
+
 	"bank-aml-system/internal/models"
 
 	"github.com/stretchr/testify/mock"
@@ -44,6 +46,81 @@ func (m *MockClientInterface) GetAccountDailyCount(accountNumber string) (int64,
 	return args.Get(0).(int64), args.Error(1)
 }
 
+// GetAccountBaseline мок для GetAccountBaseline
+func (m *MockClientInterface) GetAccountBaseline(accountNumber string) (*models.AccountBaseline, error) {
+	args := m.Called(accountNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountBaseline), args.Error(1)
+}
+
+// UpdateAccountBaseline мок для UpdateAccountBaseline
+func (m *MockClientInterface) UpdateAccountBaseline(accountNumber string, tx *models.Transaction) error {
+	args := m.Called(accountNumber, tx)
+	return args.Error(0)
+}
+
+// GetBeneficiaryHistory мок для GetBeneficiaryHistory
+func (m *MockClientInterface) GetBeneficiaryHistory(accountNumber, counterpartyAccount, counterpartyBank string) (*models.BeneficiaryHistory, error) {
+	args := m.Called(accountNumber, counterpartyAccount, counterpartyBank)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BeneficiaryHistory), args.Error(1)
+}
+
+// RecordBeneficiary мок для RecordBeneficiary
+func (m *MockClientInterface) RecordBeneficiary(accountNumber, counterpartyAccount, counterpartyBank string, seenAt time.Time) error {
+	args := m.Called(accountNumber, counterpartyAccount, counterpartyBank, seenAt)
+	return args.Error(0)
+}
+
+// RecordAccountFlow мок для RecordAccountFlow
+func (m *MockClientInterface) RecordAccountFlow(accountNumber, direction, transactionID string, amount float64, at time.Time) error {
+	args := m.Called(accountNumber, direction, transactionID, amount, at)
+	return args.Error(0)
+}
+
+// GetAccountFlows мок для GetAccountFlows
+func (m *MockClientInterface) GetAccountFlows(accountNumber string, since time.Time) (*models.AccountFlowSummary, error) {
+	args := m.Called(accountNumber, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountFlowSummary), args.Error(1)
+}
+
+// RecordTransferEdge мок для RecordTransferEdge
+func (m *MockClientInterface) RecordTransferEdge(edge *models.TransferEdge) error {
+	args := m.Called(edge)
+	return args.Error(0)
+}
+
+// GetTransferEdges мок для GetTransferEdges
+func (m *MockClientInterface) GetTransferEdges(accountNumber string, since time.Time) ([]models.TransferEdge, error) {
+	args := m.Called(accountNumber, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.TransferEdge), args.Error(1)
+}
+
+// RecordCounterparty мок для RecordCounterparty
+func (m *MockClientInterface) RecordCounterparty(accountNumber, direction, counterparty string, at time.Time) error {
+	args := m.Called(accountNumber, direction, counterparty, at)
+	return args.Error(0)
+}
+
+// GetCounterpartyFan мок для GetCounterpartyFan
+func (m *MockClientInterface) GetCounterpartyFan(accountNumber, direction, candidate string, window time.Duration, at time.Time) (*models.CounterpartyFan, error) {
+	args := m.Called(accountNumber, direction, candidate, window, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CounterpartyFan), args.Error(1)
+}
+
 // IsAccountBlacklisted мок для IsAccountBlacklisted
 func (m *MockClientInterface) IsAccountBlacklisted(accountNumber string) (bool, error) {
 	args := m.Called(accountNumber)
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	redisv9 "github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript атомарно пополняет корзину по времени сервера Redis и забирает из нее один токен
+// Время берется у Redis, чтобы часы экземпляров сервиса не влияли на общее состояние.
+// Возвращает {1, 0}, если запрос разрешен, иначе {0, секунды до появления токена}
+var tokenBucketScript = redisv9.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = (1 - tokens) / rate
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, tostring(wait)}
+`)
+
+// TakeToken забирает токен из корзины bucket, пополняемой со скоростью rate токенов в секунду до емкости burst
+// Если токенов нет, возвращает false и время, через которое появится следующий. Корзина удаляется, когда
+// успевает заполниться полностью
+func (c *Client) TakeToken(bucket string, rate float64, burst int) (bool, time.Duration, error) {
+	ctx := context.Background()
+	key := fmt.Sprintf("ratelimit:%s", bucket)
+
+	result, err := tokenBucketScript.Run(ctx, c.rdb, []string{key}, rate, burst).Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+	if len(result) != 2 {
+		return false, 0, fmt.Errorf("unexpected rate limit script result: %v", result)
+	}
+
+	allowed, _ := result[0].(int64)
+	waitStr, _ := result[1].(string)
+	wait, err := strconv.ParseFloat(waitStr, 64)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid rate limit wait %q: %w", waitStr, err)
+	}
+	return allowed == 1, time.Duration(wait * float64(time.Second)), nil
+}
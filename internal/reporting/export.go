@@ -0,0 +1,138 @@
+package reporting
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// Форматы ежедневной выгрузки
+const (
+	FormatCSV = "csv"
+	FormatXML = "xml"
+)
+
+// csvHeader - колонки CSV-выгрузки
+var csvHeader = []string{
+	"rule", "business_date", "transaction_time", "processing_id", "transaction_id", "account_number",
+	"customer_id", "transaction_type", "amount", "currency", "amount_local", "daily_total_local",
+}
+
+// cashReport - корневой элемент XML-выгрузки
+type cashReport struct {
+	XMLName       xml.Name        `xml:"cash_transaction_report"`
+	BusinessDate  string          `xml:"business_date,attr"`
+	CurrencyLocal string          `xml:"currency_local,attr"`
+	Count         int             `xml:"count,attr"`
+	Transactions  []cashReportRow `xml:"transaction"`
+}
+
+type cashReportRow struct {
+	Rule            string `xml:"rule,attr"`
+	ProcessingID    string `xml:"processing_id"`
+	TransactionID   string `xml:"transaction_id"`
+	TransactionTime string `xml:"transaction_time"`
+	AccountNumber   string `xml:"account_number"`
+	CustomerID      string `xml:"customer_id,omitempty"`
+	TransactionType string `xml:"transaction_type"`
+	Amount          string `xml:"amount"`
+	Currency        string `xml:"currency"`
+	AmountLocal     string `xml:"amount_local"`
+	DailyTotalLocal string `xml:"daily_total_local,omitempty"`
+}
+
+// Export формирует выгрузку очереди за день в формате csv или xml
+func Export(reports []*models.MandatoryReport, businessDate, currencyLocal, format string) (*models.MandatoryReportExport, error) {
+	var content []byte
+	var contentType string
+	var err error
+
+	switch format {
+	case FormatCSV:
+		content, err = exportCSV(reports)
+		contentType = "text/csv; charset=utf-8"
+	case FormatXML:
+		content, err = exportXML(reports, businessDate, currencyLocal)
+		contentType = "application/xml; charset=utf-8"
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MandatoryReportExport{
+		FileName:    fmt.Sprintf("cash-transactions-%s.%s", businessDate, format),
+		ContentType: contentType,
+		Content:     content,
+		Count:       len(reports),
+	}, nil
+}
+
+func exportCSV(reports []*models.MandatoryReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	for _, r := range reports {
+		if err := w.Write([]string{
+			r.Rule, r.BusinessDate, r.TransactionTime.UTC().Format(time.RFC3339), r.ProcessingID, r.TransactionID,
+			r.AccountNumber, r.CustomerID, r.TransactionType, formatAmount(r.Amount), r.Currency,
+			formatAmountLocal(r), formatOptionalAmount(r.DailyTotalLocal),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func exportXML(reports []*models.MandatoryReport, businessDate, currencyLocal string) ([]byte, error) {
+	doc := cashReport{BusinessDate: businessDate, CurrencyLocal: currencyLocal, Count: len(reports)}
+	for _, r := range reports {
+		doc.Transactions = append(doc.Transactions, cashReportRow{
+			Rule:            r.Rule,
+			ProcessingID:    r.ProcessingID,
+			TransactionID:   r.TransactionID,
+			TransactionTime: r.TransactionTime.UTC().Format(time.RFC3339),
+			AccountNumber:   r.AccountNumber,
+			CustomerID:      r.CustomerID,
+			TransactionType: r.TransactionType,
+			Amount:          formatAmount(r.Amount),
+			Currency:        r.Currency,
+			AmountLocal:     formatAmountLocal(r),
+			DailyTotalLocal: formatOptionalAmount(r.DailyTotalLocal),
+		})
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func formatOptionalAmount(amount float64) string {
+	if amount == 0 {
+		return ""
+	}
+	return formatAmount(amount)
+}
+
+// formatAmountLocal оставляет сумму в местной валюте пустой, если курс не был задан и пересчета не было
+func formatAmountLocal(r *models.MandatoryReport) string {
+	if r.Rule == RuleCashUnconverted {
+		return ""
+	}
+	return formatAmount(r.AmountLocal)
+}
@@ -0,0 +1,139 @@
+package reporting
+
+import (
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+)
+
+// BusinessDateFormat - формат даты операции в очереди и выгрузках
+const BusinessDateFormat = "2006-01-02"
+
+// Правила обязательного контроля
+const (
+	RuleCashThreshold      = "cash_threshold"       // Операция с наличными на сумму не ниже порога
+	RuleCashDailyAggregate = "cash_daily_aggregate" // Операции с наличными клиента за день в сумме не ниже порога
+	RuleCashUnconverted    = "cash_unconverted"     // Операция с наличными в валюте без заданного курса: сумма пересчитывается вручную
+)
+
+// Rules - набор правил обязательного контроля операций с наличными
+// В отличие от правил анализа рисков не начисляет баллы: операция либо подлежит контролю, либо нет
+type Rules struct {
+	cfg           config.ReportingConfig
+	localCurrency string
+	rates         map[string]float64
+	cashTypes     map[string]bool
+}
+
+// NewRules создает набор правил; суммы в иных валютах пересчитываются по курсам rates
+func NewRules(cfg config.ReportingConfig, localCurrency string, rates map[string]float64) *Rules {
+	cashTypes := make(map[string]bool, len(cfg.CashTypes))
+	for _, t := range cfg.CashTypes {
+		cashTypes[t] = true
+	}
+	return &Rules{cfg: cfg, localCurrency: localCurrency, rates: rates, cashTypes: cashTypes}
+}
+
+// Enabled проверяет, включен ли обязательный контроль
+func (r *Rules) Enabled() bool {
+	return r.cfg.Enabled && r.cfg.CashThreshold > 0
+}
+
+// AggregateDaily проверяет, нужно ли суммировать операции клиента за день
+func (r *Rules) AggregateDaily() bool {
+	return r.cfg.AggregateDaily
+}
+
+// CashTypes возвращает типы транзакций, считающиеся операциями с наличными
+func (r *Rules) CashTypes() []string {
+	return r.cfg.CashTypes
+}
+
+// IsCash проверяет, является ли транзакция операцией с наличными
+func (r *Rules) IsCash(tx *models.Transaction) bool {
+	return r.cashTypes[tx.TransactionType]
+}
+
+// ToLocal пересчитывает сумму в местную валюту; false, если курс валюты не задан
+func (r *Rules) ToLocal(amount float64, currency string) (float64, bool) {
+	if currency == "" || strings.EqualFold(currency, r.localCurrency) {
+		return amount, true
+	}
+	rate, ok := r.rates[strings.ToUpper(currency)]
+	if !ok {
+		return 0, false
+	}
+	return amount * rate, true
+}
+
+// DayBounds возвращает границы календарного дня (UTC) операции
+func DayBounds(ts time.Time) (time.Time, time.Time) {
+	start := ts.UTC().Truncate(24 * time.Hour)
+	return start, start.Add(24 * time.Hour)
+}
+
+// Evaluate отбирает операции, подлежащие контролю, по текущей транзакции
+// sameDay - операции с наличными клиента за тот же день, включая текущую
+// Операцию в валюте без заданного курса нельзя сравнить с порогом, поэтому она ставится в очередь
+// по правилу cash_unconverted без суммы в местной валюте; в дневную сумму такие операции не входят
+func (r *Rules) Evaluate(current *models.TransactionRecord, customerID string, sameDay []*models.TransactionRecord) []*models.MandatoryReport {
+	if !r.Enabled() || !r.IsCash(&current.Transaction) {
+		return nil
+	}
+
+	var reports []*models.MandatoryReport
+	if amount, ok := r.ToLocal(current.Transaction.Amount, current.Transaction.Currency); !ok {
+		reports = append(reports, r.newReport(RuleCashUnconverted, current, customerID, 0, 0))
+	} else if amount >= r.cfg.CashThreshold {
+		reports = append(reports, r.newReport(RuleCashThreshold, current, customerID, amount, 0))
+	}
+
+	if !r.cfg.AggregateDaily || len(sameDay) < 2 {
+		return reports
+	}
+
+	total := 0.0
+	amounts := make([]float64, len(sameDay))
+	for i, record := range sameDay {
+		amount, ok := r.ToLocal(record.Transaction.Amount, record.Transaction.Currency)
+		if !ok {
+			amounts[i] = -1
+			continue
+		}
+		amounts[i] = amount
+		total += amount
+	}
+	if total < r.cfg.CashThreshold {
+		return reports
+	}
+	// При превышении порога в очередь попадают все операции клиента за день;
+	// уже поставленные ранее записи хранилище пропускает
+	for i, record := range sameDay {
+		if amounts[i] < 0 {
+			continue
+		}
+		reports = append(reports, r.newReport(RuleCashDailyAggregate, record, customerID, amounts[i], total))
+	}
+	return reports
+}
+
+func (r *Rules) newReport(rule string, record *models.TransactionRecord, customerID string, amountLocal, dailyTotal float64) *models.MandatoryReport {
+	tx := &record.Transaction
+	return &models.MandatoryReport{
+		Rule:            rule,
+		ProcessingID:    record.ProcessingID,
+		TransactionID:   tx.TransactionID,
+		AccountNumber:   tx.AccountNumber,
+		CustomerID:      customerID,
+		TransactionType: tx.TransactionType,
+		Amount:          tx.Amount,
+		Currency:        tx.Currency,
+		AmountLocal:     amountLocal,
+		DailyTotalLocal: dailyTotal,
+		BusinessDate:    tx.Timestamp.UTC().Format(BusinessDateFormat),
+		TransactionTime: tx.Timestamp,
+		Status:          models.MandatoryReportQueued,
+	}
+}
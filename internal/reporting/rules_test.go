@@ -0,0 +1,199 @@
+package reporting
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.ReportingConfig{
+	Enabled:        true,
+	CashThreshold:  1000000,
+	CashTypes:      []string{"deposit", "withdrawal"},
+	AggregateDaily: true,
+}
+
+func newTestRules(cfg config.ReportingConfig) *Rules {
+	return NewRules(cfg, "RUB", map[string]float64{"USD": 90})
+}
+
+func record(id, txType string, amount float64, currency string, hour int) *models.TransactionRecord {
+	return &models.TransactionRecord{
+		ProcessingID: "proc-" + id,
+		Transaction: models.Transaction{
+			TransactionID:   id,
+			AccountNumber:   "40817810000000000001",
+			Amount:          amount,
+			Currency:        currency,
+			TransactionType: txType,
+			Timestamp:       time.Date(2024, 1, 15, hour, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func rulesOf(reports []*models.MandatoryReport) []string {
+	var rules []string
+	for _, r := range reports {
+		rules = append(rules, r.Rule+":"+r.ProcessingID)
+	}
+	return rules
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.ReportingConfig
+		current *models.TransactionRecord
+		sameDay []*models.TransactionRecord
+		want    []string
+	}{
+		{
+			name:    "deposit at threshold",
+			cfg:     testConfig,
+			current: record("T1", "deposit", 1000000, "RUB", 10),
+			want:    []string{"cash_threshold:proc-T1"},
+		},
+		{
+			name:    "foreign currency converted",
+			cfg:     testConfig,
+			current: record("T1", "withdrawal", 12000, "USD", 10),
+			want:    []string{"cash_threshold:proc-T1"},
+		},
+		{
+			name:    "below threshold",
+			cfg:     testConfig,
+			current: record("T1", "deposit", 999999, "RUB", 10),
+		},
+		{
+			name:    "non-cash transaction ignored",
+			cfg:     testConfig,
+			current: record("T1", "transfer", 5000000, "RUB", 10),
+		},
+		{
+			name:    "unknown currency queued unconverted",
+			cfg:     testConfig,
+			current: record("T1", "deposit", 500, "EUR", 10),
+			want:    []string{"cash_unconverted:proc-T1"},
+		},
+		{
+			name:    "unconverted operation left out of daily total",
+			cfg:     testConfig,
+			current: record("T2", "withdrawal", 5000, "GBP", 12),
+			sameDay: []*models.TransactionRecord{
+				record("T1", "deposit", 600000, "RUB", 9),
+				record("T2", "withdrawal", 5000, "GBP", 12),
+			},
+			want: []string{"cash_unconverted:proc-T2"},
+		},
+		{
+			name:    "same-day aggregate reaches threshold",
+			cfg:     testConfig,
+			current: record("T2", "withdrawal", 400000, "RUB", 12),
+			sameDay: []*models.TransactionRecord{
+				record("T1", "deposit", 600000, "RUB", 9),
+				record("T2", "withdrawal", 400000, "RUB", 12),
+			},
+			want: []string{"cash_daily_aggregate:proc-T1", "cash_daily_aggregate:proc-T2"},
+		},
+		{
+			name:    "aggregation disabled",
+			cfg:     config.ReportingConfig{Enabled: true, CashThreshold: 1000000, CashTypes: []string{"deposit"}},
+			current: record("T2", "deposit", 400000, "RUB", 12),
+			sameDay: []*models.TransactionRecord{
+				record("T1", "deposit", 600000, "RUB", 9),
+				record("T2", "deposit", 400000, "RUB", 12),
+			},
+		},
+		{
+			name:    "rules disabled",
+			cfg:     config.ReportingConfig{CashThreshold: 1000000, CashTypes: []string{"deposit"}},
+			current: record("T1", "deposit", 5000000, "RUB", 10),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports := newTestRules(tt.cfg).Evaluate(tt.current, "C-1", tt.sameDay)
+			assert.Equal(t, tt.want, rulesOf(reports))
+		})
+	}
+}
+
+func TestEvaluate_AggregateCarriesDailyTotal(t *testing.T) {
+	reports := newTestRules(testConfig).Evaluate(
+		record("T2", "deposit", 5000, "USD", 12), "C-1",
+		[]*models.TransactionRecord{record("T1", "deposit", 600000, "RUB", 9), record("T2", "deposit", 5000, "USD", 12)},
+	)
+
+	require.Len(t, reports, 2)
+	for _, r := range reports {
+		assert.Equal(t, 1050000.0, r.DailyTotalLocal)
+		assert.Equal(t, "C-1", r.CustomerID)
+		assert.Equal(t, "2024-01-15", r.BusinessDate)
+		assert.Equal(t, models.MandatoryReportQueued, r.Status)
+	}
+	assert.Equal(t, 450000.0, reports[1].AmountLocal)
+}
+
+func TestDayBounds(t *testing.T) {
+	from, to := DayBounds(time.Date(2024, 1, 15, 1, 30, 0, 0, time.FixedZone("MSK", 3*3600)))
+	assert.Equal(t, time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), to)
+}
+
+func testReports() []*models.MandatoryReport {
+	return newTestRules(testConfig).Evaluate(record("T1", "deposit", 1500000, "RUB", 10), "C-1", nil)
+}
+
+func TestExport_CSV(t *testing.T) {
+	export, err := Export(testReports(), "2024-01-15", "RUB", FormatCSV)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewReader(export.Content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, csvHeader, rows[0])
+	assert.Equal(t, []string{
+		"cash_threshold", "2024-01-15", "2024-01-15T10:00:00Z", "proc-T1", "T1", "40817810000000000001",
+		"C-1", "deposit", "1500000.00", "RUB", "1500000.00", "",
+	}, rows[1])
+	assert.Equal(t, "cash-transactions-2024-01-15.csv", export.FileName)
+	assert.Equal(t, 1, export.Count)
+}
+
+func TestExport_XML(t *testing.T) {
+	export, err := Export(testReports(), "2024-01-15", "RUB", FormatXML)
+	require.NoError(t, err)
+
+	doc := string(export.Content)
+	assert.True(t, strings.HasPrefix(doc, "<?xml"))
+	assert.Contains(t, doc, `<cash_transaction_report business_date="2024-01-15" currency_local="RUB" count="1">`)
+	assert.Contains(t, doc, `<transaction rule="cash_threshold">`)
+	assert.Contains(t, doc, "<amount_local>1500000.00</amount_local>")
+	assert.NotContains(t, doc, "daily_total_local")
+}
+
+func TestExport_UnconvertedAmountLeftEmpty(t *testing.T) {
+	reports := newTestRules(testConfig).Evaluate(record("T1", "deposit", 500, "EUR", 10), "C-1", nil)
+
+	export, err := Export(reports, "2024-01-15", "RUB", FormatCSV)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(bytes.NewReader(export.Content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"cash_unconverted", "500.00", "EUR", ""}, []string{rows[1][0], rows[1][8], rows[1][9], rows[1][10]})
+}
+
+func TestExport_UnsupportedFormat(t *testing.T) {
+	_, err := Export(nil, "2024-01-15", "RUB", "pdf")
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/storage"
+)
+
+// maxActivityListEntries - наибольшее число записей списков в активности счета
+const maxActivityListEntries = 500
+
+// defaultVelocityWindow - окно счетчиков, если оно не задано в конфигурации
+const defaultVelocityWindow = 24 * time.Hour
+
+// VelocityWindows задает окна счетчиков Redis, показываемых в активности счета
+// Совпадают с окнами правил, чтобы аналитик видел те же значения, что и анализатор
+type VelocityWindows struct {
+	Flows time.Duration // Окно входящих и исходящих потоков (правило транзитного движения средств)
+	Fan   time.Duration // Окно подсчета различных контрагентов (правила веерных операций)
+}
+
+// AccountActivityServiceImpl реализует интерфейс AccountActivityService
+type AccountActivityServiceImpl struct {
+	transactions storage.TransactionRepository
+	activity     storage.AccountActivityRepository
+	blacklist    storage.BlacklistRepository
+	redisClient  redis.ClientInterface // Опционально: без Redis счетчики и флаги операций не показываются
+	windows      VelocityWindows
+}
+
+// NewAccountActivityService создает новый сервис активности счета
+func NewAccountActivityService(
+	transactions storage.TransactionRepository,
+	activity storage.AccountActivityRepository,
+	blacklist storage.BlacklistRepository,
+	redisClient redis.ClientInterface,
+	windows VelocityWindows,
+) AccountActivityService {
+	if windows.Flows <= 0 {
+		windows.Flows = defaultVelocityWindow
+	}
+	if windows.Fan <= 0 {
+		windows.Fan = defaultVelocityWindow
+	}
+	return &AccountActivityServiceImpl{
+		transactions: transactions,
+		activity:     activity,
+		blacklist:    blacklist,
+		redisClient:  redisClient,
+		windows:      windows,
+	}
+}
+
+// GetAccountActivity возвращает операции счета в обоих направлениях, итоги за период, счетчики Redis и записи списков
+// Счет не обязан быть в реестре: операции по счетам контрагентов других банков тоже показываются
+func (s *AccountActivityServiceImpl) GetAccountActivity(req *models.AccountActivityRequest) (*models.AccountActivity, error) {
+	accountNumber := strings.TrimSpace(req.AccountNumber)
+	if accountNumber == "" {
+		return nil, fmt.Errorf("%w: account_number is required", ErrInvalidInput)
+	}
+	period := req.Period
+	switch period {
+	case "":
+		period = models.ActivityPeriodDay
+	case models.ActivityPeriodDay, models.ActivityPeriodWeek, models.ActivityPeriodMonth:
+	default:
+		return nil, fmt.Errorf("%w: unknown period %q", ErrInvalidInput, period)
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	limit := req.Limit
+	if limit < 0 || limit > maxTransactionPageSize {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, maxTransactionPageSize)
+	}
+	if limit == 0 {
+		limit = defaultTransactionPageSize
+	}
+
+	activity := &models.AccountActivity{AccountNumber: accountNumber, From: req.From, To: req.To}
+
+	recent := models.TransactionSearchFilter{
+		From:   req.From,
+		To:     req.To,
+		SortBy: models.TransactionSortTimestamp,
+		Order:  models.SortOrderDesc,
+		Limit:  limit,
+	}
+	sentFilter := recent
+	sentFilter.AccountNumber = accountNumber
+	sent, err := s.transactions.SearchTransactions(sentFilter)
+	if err != nil {
+		return nil, err
+	}
+	activity.Sent = attachCachedFlags(s.redisClient, sent)
+
+	receivedFilter := recent
+	receivedFilter.Counterparty = accountNumber
+	received, err := s.transactions.SearchTransactions(receivedFilter)
+	if err != nil {
+		return nil, err
+	}
+	activity.Received = attachCachedFlags(s.redisClient, received)
+
+	activity.Totals, err = s.activity.GetAccountActivityTotals(models.AccountActivityFilter{
+		AccountNumber: accountNumber,
+		From:          req.From,
+		To:            req.To,
+		Period:        period,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activity.ListEntries, err = s.blacklist.ListBlacklistEntries(models.BlacklistFilter{
+		AccountNumber: accountNumber,
+		Limit:         maxActivityListEntries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if activity.ListEntries == nil {
+		activity.ListEntries = []*models.BlacklistEntry{}
+	}
+
+	if s.redisClient != nil {
+		activity.Velocity = s.velocity(accountNumber, time.Now())
+	}
+	return activity, nil
+}
+
+// velocity читает текущие счетчики счета из Redis
+// Ошибка Redis не мешает показать остальную активность: счетчики в этом случае не возвращаются
+func (s *AccountActivityServiceImpl) velocity(accountNumber string, now time.Time) *models.AccountVelocity {
+	v := &models.AccountVelocity{
+		FlowWindow: s.windows.Flows.String(),
+		FanWindow:  s.windows.Fan.String(),
+	}
+	var err error
+	if v.DailyCount, err = s.redisClient.GetAccountDailyCount(accountNumber); err != nil {
+		log.Printf("Error getting daily count for account %s: %v", accountNumber, err)
+		return nil
+	}
+	if v.Flows, err = s.redisClient.GetAccountFlows(accountNumber, now.Add(-s.windows.Flows)); err != nil {
+		log.Printf("Error getting flows for account %s: %v", accountNumber, err)
+		return nil
+	}
+	fanIn, err := s.redisClient.GetCounterpartyFan(accountNumber, models.FlowInbound, "", s.windows.Fan, now)
+	if err != nil {
+		log.Printf("Error getting fan-in for account %s: %v", accountNumber, err)
+		return nil
+	}
+	fanOut, err := s.redisClient.GetCounterpartyFan(accountNumber, models.FlowOutbound, "", s.windows.Fan, now)
+	if err != nil {
+		log.Printf("Error getting fan-out for account %s: %v", accountNumber, err)
+		return nil
+	}
+	v.FanIn, v.FanOut = fanIn.DistinctCount, fanOut.DistinctCount
+	if v.Baseline, err = s.redisClient.GetAccountBaseline(accountNumber); err != nil {
+		log.Printf("Error getting baseline for account %s: %v", accountNumber, err)
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	redismocks "bank-aml-system/internal/redis/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type accountActivityFixture struct {
+	transactions *storagemocks.MockTransactionRepository
+	activity     *storagemocks.MockAccountActivityRepository
+	blacklist    *storagemocks.MockBlacklistRepository
+	redis        *redismocks.MockClientInterface
+}
+
+func newAccountActivityFixture() *accountActivityFixture {
+	return &accountActivityFixture{
+		transactions: new(storagemocks.MockTransactionRepository),
+		activity:     new(storagemocks.MockAccountActivityRepository),
+		blacklist:    new(storagemocks.MockBlacklistRepository),
+		redis:        new(redismocks.MockClientInterface),
+	}
+}
+
+// expectStorage настраивает операции, итоги и записи списков счета ACC-1
+func (f *accountActivityFixture) expectStorage(from, to *time.Time) {
+	f.transactions.On("SearchTransactions", models.TransactionSearchFilter{
+		AccountNumber: "ACC-1", From: from, To: to,
+		SortBy: models.TransactionSortTimestamp, Order: models.SortOrderDesc, Limit: 20,
+	}).Return([]*models.TransactionSummary{{ProcessingID: "proc-sent", AccountNumber: "ACC-1"}}, nil)
+	f.transactions.On("SearchTransactions", models.TransactionSearchFilter{
+		Counterparty: "ACC-1", From: from, To: to,
+		SortBy: models.TransactionSortTimestamp, Order: models.SortOrderDesc, Limit: 20,
+	}).Return(nil, nil)
+	f.activity.On("GetAccountActivityTotals", models.AccountActivityFilter{
+		AccountNumber: "ACC-1", From: from, To: to, Period: models.ActivityPeriodMonth,
+	}).Return(&models.AccountActivityTotals{
+		ByCurrency: []*models.ActivityTotal{{Direction: models.ActivityDirectionSent, Key: "RUB", Currency: "RUB", Count: 1, Amount: 1000}},
+	}, nil)
+	f.blacklist.On("ListBlacklistEntries", models.BlacklistFilter{AccountNumber: "ACC-1", Limit: maxActivityListEntries}).
+		Return([]*models.BlacklistEntry{{ID: 5, ListType: models.BlacklistTypeSanctions, AccountNumber: "ACC-1"}}, nil)
+}
+
+func TestAccountActivityService_GetAccountActivity_WithVelocity(t *testing.T) {
+	f := newAccountActivityFixture()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	f.expectStorage(&from, &to)
+
+	f.redis.On("GetAnalysis", "proc-sent").Return(&models.RiskAnalysis{Flags: []string{"large_amount"}}, nil)
+	f.redis.On("GetAccountDailyCount", "ACC-1").Return(int64(7), nil)
+	f.redis.On("GetAccountFlows", "ACC-1", mock.AnythingOfType("time.Time")).
+		Return(&models.AccountFlowSummary{Inflow: 5000, InflowCount: 2}, nil)
+	f.redis.On("GetCounterpartyFan", "ACC-1", models.FlowInbound, "", 12*time.Hour, mock.AnythingOfType("time.Time")).
+		Return(&models.CounterpartyFan{DistinctCount: 4}, nil)
+	f.redis.On("GetCounterpartyFan", "ACC-1", models.FlowOutbound, "", 12*time.Hour, mock.AnythingOfType("time.Time")).
+		Return(&models.CounterpartyFan{DistinctCount: 1}, nil)
+	f.redis.On("GetAccountBaseline", "ACC-1").Return(nil, nil)
+
+	service := NewAccountActivityService(f.transactions, f.activity, f.blacklist, f.redis, VelocityWindows{Flows: time.Hour, Fan: 12 * time.Hour})
+	activity, err := service.GetAccountActivity(&models.AccountActivityRequest{
+		AccountNumber: " ACC-1 ", From: &from, To: &to, Period: models.ActivityPeriodMonth, Limit: 20,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ACC-1", activity.AccountNumber)
+	require.Len(t, activity.Sent, 1)
+	assert.Equal(t, []string{"large_amount"}, activity.Sent[0].Flags)
+	assert.NotNil(t, activity.Received)
+	assert.Empty(t, activity.Received)
+	assert.Len(t, activity.Totals.ByCurrency, 1)
+	require.Len(t, activity.ListEntries, 1)
+	assert.Equal(t, models.BlacklistTypeSanctions, activity.ListEntries[0].ListType)
+	require.NotNil(t, activity.Velocity)
+	assert.Equal(t, int64(7), activity.Velocity.DailyCount)
+	assert.Equal(t, 5000.0, activity.Velocity.Flows.Inflow)
+	assert.Equal(t, int64(4), activity.Velocity.FanIn)
+	assert.Equal(t, int64(1), activity.Velocity.FanOut)
+	assert.Equal(t, "1h0m0s", activity.Velocity.FlowWindow)
+	f.transactions.AssertExpectations(t)
+	f.activity.AssertExpectations(t)
+}
+
+func TestAccountActivityService_GetAccountActivity_RedisErrorOmitsVelocity(t *testing.T) {
+	f := newAccountActivityFixture()
+	f.expectStorage(nil, nil)
+	f.redis.On("GetAnalysis", mock.Anything).Return(nil, errors.New("connection refused"))
+	f.redis.On("GetAccountDailyCount", "ACC-1").Return(int64(0), errors.New("connection refused"))
+
+	service := NewAccountActivityService(f.transactions, f.activity, f.blacklist, f.redis, VelocityWindows{})
+	activity, err := service.GetAccountActivity(&models.AccountActivityRequest{
+		AccountNumber: "ACC-1", Period: models.ActivityPeriodMonth, Limit: 20,
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, activity.Velocity)
+	assert.Equal(t, []string{}, activity.Sent[0].Flags)
+}
+
+func TestAccountActivityService_GetAccountActivity_WithoutRedis(t *testing.T) {
+	f := newAccountActivityFixture()
+	f.expectStorage(nil, nil)
+
+	service := NewAccountActivityService(f.transactions, f.activity, f.blacklist, nil, VelocityWindows{})
+	activity, err := service.GetAccountActivity(&models.AccountActivityRequest{
+		AccountNumber: "ACC-1", Period: models.ActivityPeriodMonth, Limit: 20,
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, activity.Velocity)
+	assert.Equal(t, []string{}, activity.Sent[0].Flags)
+}
+
+func TestAccountActivityService_GetAccountActivity_InvalidInput(t *testing.T) {
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	cases := map[string]*models.AccountActivityRequest{
+		"missing account": {AccountNumber: " "},
+		"unknown period":  {AccountNumber: "ACC-1", Period: "year"},
+		"period order":    {AccountNumber: "ACC-1", From: &from, To: &to},
+		"limit":           {AccountNumber: "ACC-1", Limit: maxTransactionPageSize + 1},
+	}
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := newAccountActivityFixture()
+			service := NewAccountActivityService(f.transactions, f.activity, f.blacklist, nil, VelocityWindows{})
+
+			activity, err := service.GetAccountActivity(req)
+
+			assert.Nil(t, activity)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			f.transactions.AssertNotCalled(t, "SearchTransactions", mock.Anything)
+		})
+	}
+}
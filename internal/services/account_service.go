@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// AccountServiceImpl реализует интерфейс AccountService
+type AccountServiceImpl struct {
+	repo storage.AccountRepository
+}
+
+// NewAccountService создает новый сервис реестра клиентов и счетов
+func NewAccountService(repo storage.AccountRepository) AccountService {
+	return &AccountServiceImpl{repo: repo}
+}
+
+// SaveCustomer регистрирует или обновляет клиента
+func (s *AccountServiceImpl) SaveCustomer(customer *models.Customer) (*models.Customer, error) {
+	if err := validateCustomer(customer); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SaveCustomer(customer); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetCustomer(customer.CustomerID)
+}
+
+// GetCustomer возвращает клиента по customer_id
+func (s *AccountServiceImpl) GetCustomer(customerID string) (*models.Customer, error) {
+	return s.repo.GetCustomer(customerID)
+}
+
+// ListCustomers возвращает список клиентов
+func (s *AccountServiceImpl) ListCustomers(limit int) ([]*models.Customer, error) {
+	return s.repo.ListCustomers(limit)
+}
+
+// SaveAccount регистрирует или обновляет счет
+func (s *AccountServiceImpl) SaveAccount(account *models.Account) (*models.Account, error) {
+	if account.AccountNumber == "" || account.CustomerID == "" {
+		return nil, fmt.Errorf("%w: account_number and customer_id are required", ErrInvalidInput)
+	}
+	if account.DeclaredTurnover < 0 {
+		return nil, fmt.Errorf("%w: declared_turnover must not be negative", ErrInvalidInput)
+	}
+
+	customer, err := s.repo.GetCustomer(account.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, fmt.Errorf("%w: customer %s", ErrNotFound, account.CustomerID)
+	}
+
+	if account.OpenedAt.IsZero() {
+		account.OpenedAt = time.Now()
+	}
+	if account.Status == "" {
+		account.Status = "active"
+	}
+	account.Currency = strings.ToUpper(account.Currency)
+
+	if err := s.repo.SaveAccount(account); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAccount(account.AccountNumber)
+}
+
+// GetAccount возвращает счет вместе с данными владельца
+func (s *AccountServiceImpl) GetAccount(accountNumber string) (*models.AccountProfile, error) {
+	return s.repo.GetAccountProfile(accountNumber)
+}
+
+// ListAccounts возвращает счета клиента
+func (s *AccountServiceImpl) ListAccounts(customerID string) ([]*models.Account, error) {
+	return s.repo.ListAccounts(customerID)
+}
+
+// validateCustomer проверяет справочные значения клиента
+// REST проверяет их через binding-теги, но gRPC обращается к сервису напрямую
+func validateCustomer(customer *models.Customer) error {
+	if customer.CustomerID == "" || customer.FullName == "" {
+		return fmt.Errorf("%w: customer_id and full_name are required", ErrInvalidInput)
+	}
+
+	switch customer.Segment {
+	case models.SegmentRetail, models.SegmentSME, models.SegmentCorporate:
+	default:
+		return fmt.Errorf("%w: unknown segment %q", ErrInvalidInput, customer.Segment)
+	}
+
+	switch customer.Residency {
+	case models.ResidencyResident, models.ResidencyNonResident:
+	default:
+		return fmt.Errorf("%w: unknown residency %q", ErrInvalidInput, customer.Residency)
+	}
+
+	switch customer.KYCRiskRating {
+	case models.KYCRiskLow, models.KYCRiskMedium, models.KYCRiskHigh:
+	default:
+		return fmt.Errorf("%w: unknown kyc_risk_rating %q", ErrInvalidInput, customer.KYCRiskRating)
+	}
+
+	customer.Country = strings.ToUpper(customer.Country)
+	return nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountService_SaveCustomer_Success(t *testing.T) {
+	mockRepo := new(storagemocks.MockAccountRepository)
+	service := NewAccountService(mockRepo)
+
+	customer := &models.Customer{
+		CustomerID:    "CUST-1",
+		FullName:      "Иванов Иван",
+		Segment:       models.SegmentRetail,
+		Residency:     models.ResidencyResident,
+		Country:       "ru",
+		KYCRiskRating: models.KYCRiskLow,
+	}
+
+	mockRepo.On("SaveCustomer", customer).Return(nil)
+	mockRepo.On("GetCustomer", "CUST-1").Return(customer, nil)
+
+	saved, err := service.SaveCustomer(customer)
+	require.NoError(t, err)
+	assert.Equal(t, "RU", saved.Country)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAccountService_SaveCustomer_InvalidSegment(t *testing.T) {
+	mockRepo := new(storagemocks.MockAccountRepository)
+	service := NewAccountService(mockRepo)
+
+	customer := &models.Customer{
+		CustomerID:    "CUST-1",
+		FullName:      "ООО Ромашка",
+		Segment:       "vip",
+		Residency:     models.ResidencyResident,
+		KYCRiskRating: models.KYCRiskLow,
+	}
+
+	saved, err := service.SaveCustomer(customer)
+	assert.Nil(t, saved)
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+	mockRepo.AssertNotCalled(t, "SaveCustomer", mock.Anything)
+}
+
+func TestAccountService_SaveAccount_Success(t *testing.T) {
+	mockRepo := new(storagemocks.MockAccountRepository)
+	service := NewAccountService(mockRepo)
+
+	account := &models.Account{
+		AccountNumber:    "ACC123456",
+		CustomerID:       "CUST-1",
+		Currency:         "rub",
+		DeclaredTurnover: 500000.0,
+	}
+
+	mockRepo.On("GetCustomer", "CUST-1").Return(&models.Customer{CustomerID: "CUST-1"}, nil)
+	mockRepo.On("SaveAccount", account).Return(nil)
+	mockRepo.On("GetAccount", "ACC123456").Return(account, nil)
+
+	saved, err := service.SaveAccount(account)
+	require.NoError(t, err)
+	assert.Equal(t, "RUB", saved.Currency)
+	assert.Equal(t, "active", saved.Status)
+	assert.WithinDuration(t, time.Now(), saved.OpenedAt, time.Minute)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAccountService_SaveAccount_UnknownCustomer(t *testing.T) {
+	mockRepo := new(storagemocks.MockAccountRepository)
+	service := NewAccountService(mockRepo)
+
+	account := &models.Account{AccountNumber: "ACC123456", CustomerID: "CUST-404"}
+	mockRepo.On("GetCustomer", "CUST-404").Return(nil, nil)
+
+	saved, err := service.SaveAccount(account)
+	assert.Nil(t, saved)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	mockRepo.AssertNotCalled(t, "SaveAccount", mock.Anything)
+}
+
+func TestAccountService_SaveAccount_NegativeTurnover(t *testing.T) {
+	mockRepo := new(storagemocks.MockAccountRepository)
+	service := NewAccountService(mockRepo)
+
+	saved, err := service.SaveAccount(&models.Account{
+		AccountNumber:    "ACC123456",
+		CustomerID:       "CUST-1",
+		DeclaredTurnover: -1,
+	})
+	assert.Nil(t, saved)
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"bank-aml-system/internal/models"
+)
+
+// approverRoles - роли, которым разрешено подтверждать и отклонять решения по принципу четырех глаз
+var approverRoles = []string{models.RoleApprover, models.RoleSupervisor}
+
+// canApprove проверяет, может ли пользователь подтверждать решения
+func canApprove(identity models.Identity) bool {
+	for _, role := range approverRoles {
+		if identity.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveActor возвращает автора действия: пользователя запроса, а если он не известен - указанного в запросе
+// Автор в запросе, не совпадающий с пользователем запроса, отклоняется: иначе можно действовать от чужого имени
+func resolveActor(claimed string, identity models.Identity) (string, error) {
+	claimed = strings.TrimSpace(claimed)
+	if identity.UserID == "" {
+		return claimed, nil
+	}
+	if claimed != "" && claimed != identity.UserID {
+		return "", fmt.Errorf("%w: actor %q does not match the request user %q", ErrForbidden, claimed, identity.UserID)
+	}
+	return identity.UserID, nil
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/storage"
+)
+
+// defaultRescoreBatchLimit - число операций в пакетной повторной оценке, если лимит не указан
+const defaultRescoreBatchLimit = 100
+
+// maxRescoreBatchLimit - наибольшее число операций в одной пакетной повторной оценке
+const maxRescoreBatchLimit = 1000
+
+// AnalysisServiceImpl реализует интерфейс AnalysisService
+type AnalysisServiceImpl struct {
+	repo         storage.AnalysisRepository
+	transactions storage.TransactionRepository
+	analyzer     RiskAnalyzer          // Опционально: без анализатора повторная оценка недоступна
+	redisClient  redis.ClientInterface // Опционально: кэш результата анализа для запросов статуса
+}
+
+// NewAnalysisService создает новый сервис версий анализа
+func NewAnalysisService(
+	repo storage.AnalysisRepository,
+	transactions storage.TransactionRepository,
+	analyzer RiskAnalyzer,
+	redisClient redis.ClientInterface,
+) AnalysisService {
+	return &AnalysisServiceImpl{repo: repo, transactions: transactions, analyzer: analyzer, redisClient: redisClient}
+}
+
+// RecordAnalysis сохраняет результат анализа при поступлении операции очередной версией
+func (s *AnalysisServiceImpl) RecordAnalysis(processingID string, analysis *models.RiskAnalysis) (*models.AnalysisVersion, error) {
+	version := newAnalysisVersion(processingID, analysis, models.AnalysisSourceIngestion)
+	if err := s.repo.SaveAnalysisVersion(version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// Rescore повторно оценивает операцию и сохраняет новую версию; текущий результат операции обновляется,
+// а предыдущие версии остаются в истории для сравнения
+func (s *AnalysisServiceImpl) Rescore(processingID string, req *models.RescoreRequest) (*models.RescoreResult, error) {
+	requestedBy := strings.TrimSpace(req.RequestedBy)
+	if requestedBy == "" {
+		return nil, fmt.Errorf("%w: requested_by is required", ErrInvalidInput)
+	}
+	if s.analyzer == nil {
+		return nil, fmt.Errorf("%w: risk analyzer is not configured", ErrUnavailable)
+	}
+
+	status, err := s.transactions.GetTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+	if status.AnalysisTimestamp == nil {
+		return nil, fmt.Errorf("%w: transaction %s has not been analyzed yet", ErrConflict, processingID)
+	}
+
+	tx, err := s.transactions.GetFullTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+
+	previous, err := s.latestVersion(status)
+	if err != nil {
+		return nil, err
+	}
+
+	// Оценка по состоянию профилей на момент анализа: текущие профили уже учитывают эту и более поздние операции
+	inputs, err := s.recordedInputs(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if inputs == nil {
+		log.Printf("Rescoring %s without recorded rule inputs: current account profiles are used", processingID)
+	}
+
+	analysis, err := s.analyzer.RescoreTransaction(tx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	current := newAnalysisVersion(processingID, analysis, models.AnalysisSourceRescore)
+	// Состояние, снятое с текущих профилей, не сохраняется: оно уже включает более поздние операции
+	current.Inputs = inputs
+	current.RequestedBy = requestedBy
+	current.Reason = strings.TrimSpace(req.Reason)
+	if err := s.repo.SaveAnalysisVersion(current); err != nil {
+		return nil, err
+	}
+
+	if err := s.transactions.UpdateTransactionAnalysis(processingID, analysis.RiskScore, analysis.RiskLevel, analysis.AnalyzedAt); err != nil {
+		return nil, err
+	}
+	if s.redisClient != nil {
+		if err := s.redisClient.SaveAnalysis(processingID, analysis); err != nil {
+			log.Printf("Error caching rescored analysis for %s: %v", processingID, err)
+		}
+	}
+
+	return compareVersions(previous, current), nil
+}
+
+// RescoreBatch повторно оценивает операции по фильтру; ошибка по одной операции не прерывает пакет
+func (s *AnalysisServiceImpl) RescoreBatch(req *models.BatchRescoreRequest) (*models.BatchRescoreResult, error) {
+	if strings.TrimSpace(req.RequestedBy) == "" {
+		return nil, fmt.Errorf("%w: requested_by is required", ErrInvalidInput)
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	switch req.RiskLevel {
+	case "", "low", "medium", "high":
+	default:
+		return nil, fmt.Errorf("%w: unknown risk level %q", ErrInvalidInput, req.RiskLevel)
+	}
+	limit := req.Limit
+	if limit < 0 || limit > maxRescoreBatchLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, maxRescoreBatchLimit)
+	}
+	if limit == 0 {
+		limit = defaultRescoreBatchLimit
+	}
+	if s.analyzer == nil {
+		return nil, fmt.Errorf("%w: risk analyzer is not configured", ErrUnavailable)
+	}
+
+	ids, err := s.repo.ListProcessingIDsForRescore(models.RescoreFilter{
+		From:          req.From,
+		To:            req.To,
+		RiskLevel:     req.RiskLevel,
+		AccountNumber: strings.TrimSpace(req.AccountNumber),
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.BatchRescoreResult{Selected: len(ids), Results: []*models.RescoreResult{}}
+	single := &models.RescoreRequest{RequestedBy: req.RequestedBy, Reason: req.Reason}
+	for _, id := range ids {
+		rescored, err := s.Rescore(id, single)
+		if err != nil {
+			log.Printf("Error rescoring transaction %s: %v", id, err)
+			result.Failed = append(result.Failed, models.RescoreFailure{ProcessingID: id, Error: err.Error()})
+			continue
+		}
+		result.Rescored++
+		if rescored.ScoreDelta != 0 || len(rescored.AddedFlags) > 0 || len(rescored.RemovedFlags) > 0 {
+			result.Changed++
+		}
+		result.Results = append(result.Results, rescored)
+	}
+	return result, nil
+}
+
+// ListVersions возвращает все версии анализа операции, начиная с первой
+func (s *AnalysisServiceImpl) ListVersions(processingID string) ([]*models.AnalysisVersion, error) {
+	status, err := s.transactions.GetTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+	versions, err := s.repo.ListAnalysisVersions(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if versions == nil {
+		versions = []*models.AnalysisVersion{}
+	}
+	return versions, nil
+}
+
+// latestVersion возвращает последнюю версию анализа операции
+// Для операций, проанализированных до ведения истории, первой версией сохраняется текущий результат из БД
+// (флаги и рекомендация берутся из кэша Redis, если он еще не истек)
+func (s *AnalysisServiceImpl) latestVersion(status *models.TransactionStatus) (*models.AnalysisVersion, error) {
+	versions, err := s.repo.ListAnalysisVersions(status.ProcessingID)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+	if status.RiskScore == nil || status.RiskLevel == nil {
+		return nil, nil
+	}
+
+	analysis := &models.RiskAnalysis{
+		RiskScore:  *status.RiskScore,
+		RiskLevel:  *status.RiskLevel,
+		AnalyzedAt: *status.AnalysisTimestamp,
+	}
+	if s.redisClient != nil {
+		if cached, err := s.redisClient.GetAnalysis(status.ProcessingID); err == nil && cached != nil && cached.RiskScore == analysis.RiskScore {
+			analysis = cached
+		}
+	}
+
+	baseline := newAnalysisVersion(status.ProcessingID, analysis, models.AnalysisSourceIngestion)
+	if err := s.repo.SaveAnalysisVersion(baseline); err != nil {
+		return nil, err
+	}
+	return baseline, nil
+}
+
+// recordedInputs возвращает состояние профилей счета, сохраненное при первом анализе операции
+func (s *AnalysisServiceImpl) recordedInputs(processingID string) (*models.RuleInputs, error) {
+	versions, err := s.repo.ListAnalysisVersions(processingID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.Inputs != nil {
+			return v.Inputs, nil
+		}
+	}
+	return nil, nil
+}
+
+// newAnalysisVersion создает версию анализа из результата анализатора
+func newAnalysisVersion(processingID string, analysis *models.RiskAnalysis, source string) *models.AnalysisVersion {
+	flags := analysis.Flags
+	if flags == nil {
+		flags = []string{}
+	}
+	return &models.AnalysisVersion{
+		ProcessingID:   processingID,
+		RiskScore:      analysis.RiskScore,
+		RiskLevel:      analysis.RiskLevel,
+		Recommendation: analysis.Recommendation,
+		Flags:          flags,
+		Evidence:       analysis.Evidence,
+		RulesetVersion: analysis.RulesetVersion,
+		Source:         source,
+		AnalyzedAt:     analysis.AnalyzedAt,
+		CreatedAt:      time.Now(),
+		Inputs:         analysis.Inputs,
+	}
+}
+
+// compareVersions сравнивает новую версию анализа с предыдущей
+func compareVersions(previous, current *models.AnalysisVersion) *models.RescoreResult {
+	result := &models.RescoreResult{
+		ProcessingID: current.ProcessingID,
+		Previous:     previous,
+		Current:      current,
+		AddedFlags:   current.Flags,
+		RemovedFlags: []string{},
+	}
+	if previous == nil {
+		return result
+	}
+	result.ScoreDelta = current.RiskScore - previous.RiskScore
+	result.AddedFlags = flagsDifference(current.Flags, previous.Flags)
+	result.RemovedFlags = flagsDifference(previous.Flags, current.Flags)
+	return result
+}
+
+// flagsDifference возвращает флаги из a, которых нет в b
+func flagsDifference(a, b []string) []string {
+	present := make(map[string]bool, len(b))
+	for _, flag := range b {
+		present[flag] = true
+	}
+	diff := []string{}
+	for _, flag := range a {
+		if !present[flag] {
+			diff = append(diff, flag)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type analysisServiceFixture struct {
+	service  AnalysisService
+	repo     *storagemocks.MockAnalysisRepository
+	txRepo   *storagemocks.MockTransactionRepository
+	analyzer *servicemocks.MockRiskAnalyzer
+}
+
+func newAnalysisServiceFixture() *analysisServiceFixture {
+	f := &analysisServiceFixture{
+		repo:     new(storagemocks.MockAnalysisRepository),
+		txRepo:   new(storagemocks.MockTransactionRepository),
+		analyzer: new(servicemocks.MockRiskAnalyzer),
+	}
+	f.service = NewAnalysisService(f.repo, f.txRepo, f.analyzer, nil)
+	return f
+}
+
+// expectAnalyzed настраивает проанализированную операцию с текущей оценкой score
+func (f *analysisServiceFixture) expectAnalyzed(processingID string, score int, level string) *models.Transaction {
+	analyzedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	f.txRepo.On("GetTransactionByProcessingID", processingID).Return(&models.TransactionStatus{
+		ProcessingID:      processingID,
+		RiskScore:         &score,
+		RiskLevel:         &level,
+		AnalysisTimestamp: &analyzedAt,
+	}, nil)
+	tx := &models.Transaction{TransactionID: "TXN-" + processingID, AccountNumber: "ACC-1", Amount: 1000}
+	f.txRepo.On("GetFullTransactionByProcessingID", processingID).Return(tx, nil)
+	return tx
+}
+
+func TestAnalysisService_Rescore_SavesNewVersionAndComparesWithPrevious(t *testing.T) {
+	f := newAnalysisServiceFixture()
+	tx := f.expectAnalyzed("proc-1", 40, "medium")
+
+	recorded := &models.RuleInputs{DailyCount: 3}
+	f.repo.On("ListAnalysisVersions", "proc-1").Return([]*models.AnalysisVersion{
+		{ProcessingID: "proc-1", Version: 1, RiskScore: 40, RiskLevel: "medium", Flags: []string{"large_amount", "night_time"}, RulesetVersion: "1.0", Inputs: recorded},
+	}, nil)
+	analyzedAt := time.Now()
+	f.analyzer.On("RescoreTransaction", tx, recorded).Return(&models.RiskAnalysis{
+		RiskScore:      75,
+		RiskLevel:      "high",
+		Flags:          []string{"large_amount", "new_beneficiary"},
+		Recommendation: "require_verification",
+		AnalyzedAt:     analyzedAt,
+		RulesetVersion: "2.0",
+	}, nil)
+	f.repo.On("SaveAnalysisVersion", mock.MatchedBy(func(v *models.AnalysisVersion) bool {
+		return v.Source == models.AnalysisSourceRescore && v.RequestedBy == "analyst-1" && v.RulesetVersion == "2.0" && v.Inputs == recorded
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.AnalysisVersion).Version = 2
+	}).Return(nil)
+	f.txRepo.On("UpdateTransactionAnalysis", "proc-1", 75, "high", analyzedAt).Return(nil)
+
+	result, err := f.service.Rescore("proc-1", &models.RescoreRequest{RequestedBy: "analyst-1", Reason: "rules 2.0 rollout"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Previous.Version)
+	assert.Equal(t, 2, result.Current.Version)
+	assert.Equal(t, 35, result.ScoreDelta)
+	assert.Equal(t, []string{"new_beneficiary"}, result.AddedFlags)
+	assert.Equal(t, []string{"night_time"}, result.RemovedFlags)
+	f.repo.AssertExpectations(t)
+	f.txRepo.AssertExpectations(t)
+}
+
+func TestAnalysisService_Rescore_BackfillsBaselineVersion(t *testing.T) {
+	f := newAnalysisServiceFixture()
+	tx := f.expectAnalyzed("proc-1", 20, "low")
+
+	f.repo.On("ListAnalysisVersions", "proc-1").Return(nil, nil)
+	f.repo.On("SaveAnalysisVersion", mock.MatchedBy(func(v *models.AnalysisVersion) bool {
+		return v.Source == models.AnalysisSourceIngestion && v.RiskScore == 20
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.AnalysisVersion).Version = 1
+	}).Return(nil).Once()
+	f.analyzer.On("RescoreTransaction", tx, (*models.RuleInputs)(nil)).Return(&models.RiskAnalysis{RiskScore: 20, RiskLevel: "low"}, nil)
+	f.repo.On("SaveAnalysisVersion", mock.MatchedBy(func(v *models.AnalysisVersion) bool {
+		return v.Source == models.AnalysisSourceRescore
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.AnalysisVersion).Version = 2
+	}).Return(nil).Once()
+	f.txRepo.On("UpdateTransactionAnalysis", "proc-1", 20, "low", mock.Anything).Return(nil)
+
+	result, err := f.service.Rescore("proc-1", &models.RescoreRequest{RequestedBy: "analyst-1"})
+	require.NoError(t, err)
+	require.NotNil(t, result.Previous)
+	assert.Equal(t, 1, result.Previous.Version)
+	assert.Zero(t, result.ScoreDelta)
+	f.repo.AssertExpectations(t)
+}
+
+func TestAnalysisService_Rescore_NotAnalyzedYet(t *testing.T) {
+	f := newAnalysisServiceFixture()
+	f.txRepo.On("GetTransactionByProcessingID", "proc-1").Return(&models.TransactionStatus{ProcessingID: "proc-1", Status: "pending_review"}, nil)
+
+	_, err := f.service.Rescore("proc-1", &models.RescoreRequest{RequestedBy: "analyst-1"})
+	assert.ErrorIs(t, err, ErrConflict)
+	f.analyzer.AssertNotCalled(t, "RescoreTransaction", mock.Anything, mock.Anything)
+}
+
+func TestAnalysisService_Rescore_WithoutAnalyzer(t *testing.T) {
+	service := NewAnalysisService(new(storagemocks.MockAnalysisRepository), new(storagemocks.MockTransactionRepository), nil, nil)
+
+	_, err := service.Rescore("proc-1", &models.RescoreRequest{RequestedBy: "analyst-1"})
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestAnalysisService_RescoreBatch_CollectsFailures(t *testing.T) {
+	f := newAnalysisServiceFixture()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f.repo.On("ListProcessingIDsForRescore", models.RescoreFilter{From: &from, RiskLevel: "medium", Limit: defaultRescoreBatchLimit}).
+		Return([]string{"proc-1", "proc-2"}, nil)
+	tx := f.expectAnalyzed("proc-1", 40, "medium")
+	f.repo.On("ListAnalysisVersions", "proc-1").Return([]*models.AnalysisVersion{{Version: 1, RiskScore: 40, Flags: []string{}}}, nil)
+	f.analyzer.On("RescoreTransaction", tx, (*models.RuleInputs)(nil)).Return(&models.RiskAnalysis{RiskScore: 45, RiskLevel: "medium"}, nil)
+	f.repo.On("SaveAnalysisVersion", mock.Anything).Return(nil)
+	f.txRepo.On("UpdateTransactionAnalysis", "proc-1", 45, "medium", mock.Anything).Return(nil)
+	f.txRepo.On("GetTransactionByProcessingID", "proc-2").Return(nil, errors.New("db locked"))
+
+	result, err := f.service.RescoreBatch(&models.BatchRescoreRequest{RequestedBy: "analyst-1", From: &from, RiskLevel: "medium"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Selected)
+	assert.Equal(t, 1, result.Rescored)
+	assert.Equal(t, 1, result.Changed)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "proc-2", result.Failed[0].ProcessingID)
+}
+
+func TestAnalysisService_RescoreBatch_RejectsInvalidLimit(t *testing.T) {
+	f := newAnalysisServiceFixture()
+
+	_, err := f.service.RescoreBatch(&models.BatchRescoreRequest{RequestedBy: "analyst-1", Limit: maxRescoreBatchLimit + 1})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	f.repo.AssertNotCalled(t, "ListProcessingIDsForRescore", mock.Anything)
+}
+
+func TestAnalysisService_ListVersions_NotFound(t *testing.T) {
+	f := newAnalysisServiceFixture()
+	f.txRepo.On("GetTransactionByProcessingID", "missing").Return(nil, nil)
+
+	_, err := f.service.ListVersions("missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/storage"
+)
+
+// defaultRetroLookback - глубина ретроспективной проверки, если она не задана в конфигурации
+const defaultRetroLookback = 90 * 24 * time.Hour
+
+// retroMatchRiskScore - оценка риска операции, найденной ретроспективной проверкой
+const retroMatchRiskScore = 100
+
+// BlacklistServiceImpl реализует интерфейс BlacklistService
+type BlacklistServiceImpl struct {
+	repo        storage.BlacklistRepository
+	cases       CaseService
+	redisClient redis.ClientInterface // Опционально: без Redis новые счета не попадают в проверку в реальном времени
+	lookback    time.Duration
+}
+
+// NewBlacklistService создает новый сервис черного и санкционного списков
+func NewBlacklistService(
+	repo storage.BlacklistRepository,
+	cases CaseService,
+	redisClient redis.ClientInterface,
+	lookback time.Duration,
+) BlacklistService {
+	if lookback <= 0 {
+		lookback = defaultRetroLookback
+	}
+	return &BlacklistServiceImpl{repo: repo, cases: cases, redisClient: redisClient, lookback: lookback}
+}
+
+// AddEntry добавляет запись в список и проверяет операции за период lookback
+// Счет из записи сразу попадает в черный список Redis, чтобы новые платежи отмечались правилом blacklisted_counterparty
+func (s *BlacklistServiceImpl) AddEntry(req *models.BlacklistEntryRequest) (*models.BlacklistEntry, error) {
+	entry := &models.BlacklistEntry{
+		ListType:      req.ListType,
+		AccountNumber: strings.TrimSpace(req.AccountNumber),
+		Name:          strings.TrimSpace(req.Name),
+		Reason:        strings.TrimSpace(req.Reason),
+		AddedBy:       strings.TrimSpace(req.AddedBy),
+	}
+	if entry.ListType != models.BlacklistTypeBlacklist && entry.ListType != models.BlacklistTypeSanctions {
+		return nil, fmt.Errorf("%w: unknown list type %q", ErrInvalidInput, entry.ListType)
+	}
+	if entry.AccountNumber == "" && entry.Name == "" {
+		return nil, fmt.Errorf("%w: account_number or name is required", ErrInvalidInput)
+	}
+	if entry.Reason == "" || entry.AddedBy == "" {
+		return nil, fmt.Errorf("%w: reason and added_by are required", ErrInvalidInput)
+	}
+
+	now := time.Now()
+	entry.CreatedAt = now
+	entry.LookbackFrom = now.Add(-s.lookback)
+	if err := s.repo.CreateBlacklistEntry(entry); err != nil {
+		return nil, err
+	}
+
+	if entry.AccountNumber != "" && s.redisClient != nil {
+		if err := s.redisClient.AddToBlacklist(entry.AccountNumber); err != nil {
+			log.Printf("Error adding %s to Redis blacklist: %v", entry.AccountNumber, err)
+		}
+	}
+
+	if err := s.screen(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetEntry возвращает запись списка вместе с результатами ретроспективной проверки
+func (s *BlacklistServiceImpl) GetEntry(id int64) (*models.BlacklistEntry, error) {
+	entry, err := s.repo.GetBlacklistEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("%w: blacklist entry %d", ErrNotFound, id)
+	}
+	if entry.Matches, err = s.repo.ListBlacklistMatches(id); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ListEntries возвращает записи списков без совпадений (их число - в match_count)
+func (s *BlacklistServiceImpl) ListEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error) {
+	if filter.ListType != "" && filter.ListType != models.BlacklistTypeBlacklist && filter.ListType != models.BlacklistTypeSanctions {
+		return nil, fmt.Errorf("%w: unknown list type %q", ErrInvalidInput, filter.ListType)
+	}
+	return s.repo.ListBlacklistEntries(filter)
+}
+
+// screen ищет прошлые операции по счету и по счетам клиентов с совпавшим именем и создает по ним алерты
+// Ошибка создания алерта не прерывает проверку: совпадение сохраняется без алерта
+func (s *BlacklistServiceImpl) screen(entry *models.BlacklistEntry) error {
+	var accountNumbers []string
+	if entry.AccountNumber != "" {
+		accountNumbers = append(accountNumbers, entry.AccountNumber)
+	}
+	if entry.Name != "" {
+		numbers, err := s.repo.ListAccountNumbersByCustomerName(entry.Name)
+		if err != nil {
+			return err
+		}
+		for _, number := range numbers {
+			if number != entry.AccountNumber {
+				accountNumbers = append(accountNumbers, number)
+			}
+		}
+	}
+
+	records, err := s.repo.ListTransactionsInvolvingAccounts(accountNumbers, entry.LookbackFrom)
+	if err != nil {
+		return err
+	}
+
+	analysis := &models.RiskAnalysis{
+		RiskScore:      retroMatchRiskScore,
+		RiskLevel:      "high",
+		Flags:          []string{"retro_" + entry.ListType + "_match"},
+		Recommendation: "require_verification",
+		AnalyzedAt:     time.Now(),
+		Evidence:       map[string][]string{"blacklist_entry": {strconv.FormatInt(entry.ID, 10)}},
+	}
+
+	matches := make([]*models.BlacklistMatch, 0, len(records))
+	for _, record := range records {
+		tx := &record.Transaction
+		match := &models.BlacklistMatch{
+			ProcessingID:        record.ProcessingID,
+			TransactionID:       tx.TransactionID,
+			AccountNumber:       tx.AccountNumber,
+			CounterpartyAccount: tx.CounterpartyAccount,
+			Amount:              tx.Amount,
+			Currency:            tx.Currency,
+			TransactionTime:     tx.Timestamp,
+			MatchedOn:           matchedOn(entry, tx),
+		}
+
+		// Операция могла уже получить алерт при анализе, в том числе в закрытом кейсе:
+		// совпадение дописывается в него, а кейс открывается повторно
+		alert, err := s.cases.RaiseRetroAlert(record.ProcessingID, tx, analysis, &models.CaseNote{
+			Author: entry.AddedBy,
+			Text: fmt.Sprintf("Retro-screening: transaction %s matches %s entry %d (%s): %s",
+				tx.TransactionID, entry.ListType, entry.ID, match.MatchedOn, entry.Reason),
+		})
+		if err != nil {
+			log.Printf("Error raising retro-screening alert for %s (blacklist entry %d): %v", record.ProcessingID, entry.ID, err)
+		} else if alert != nil {
+			match.AlertID = alert.ID
+			match.CaseID = alert.CaseID
+		}
+		matches = append(matches, match)
+	}
+
+	screenedAt := time.Now()
+	if err := s.repo.SaveBlacklistMatches(entry.ID, matches, screenedAt); err != nil {
+		return err
+	}
+	entry.ScreenedAt = &screenedAt
+	entry.MatchCount = len(matches)
+	entry.Matches = matches
+	return nil
+}
+
+// matchedOn определяет основание совпадения операции с записью
+func matchedOn(entry *models.BlacklistEntry, tx *models.Transaction) string {
+	switch {
+	case entry.AccountNumber != "" && tx.CounterpartyAccount == entry.AccountNumber:
+		return models.BlacklistMatchCounterparty
+	case entry.AccountNumber != "" && tx.AccountNumber == entry.AccountNumber:
+		return models.BlacklistMatchAccount
+	default:
+		return models.BlacklistMatchName
+	}
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	redismocks "bank-aml-system/internal/redis/mocks"
+	servicemocks "bank-aml-system/internal/services/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlacklistService() (BlacklistService, *storagemocks.MockBlacklistRepository, *servicemocks.MockCaseService, *redismocks.MockClientInterface) {
+	repo := new(storagemocks.MockBlacklistRepository)
+	cases := new(servicemocks.MockCaseService)
+	redisClient := new(redismocks.MockClientInterface)
+	return NewBlacklistService(repo, cases, redisClient, 24*time.Hour), repo, cases, redisClient
+}
+
+func TestBlacklistService_AddEntry_ScreensHistory(t *testing.T) {
+	service, repo, cases, redisClient := newTestBlacklistService()
+
+	repo.On("CreateBlacklistEntry", mock.MatchedBy(func(e *models.BlacklistEntry) bool {
+		return e.AccountNumber == "BAD-1" && e.CreatedAt.Sub(e.LookbackFrom) == 24*time.Hour
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.BlacklistEntry).ID = 5
+	}).Return(nil)
+	redisClient.On("AddToBlacklist", "BAD-1").Return(nil)
+	repo.On("ListAccountNumbersByCustomerName", "Ivan Petrov").Return([]string{"ACC-2"}, nil)
+	repo.On("ListTransactionsInvolvingAccounts", []string{"BAD-1", "ACC-2"}, mock.Anything).Return([]*models.TransactionRecord{
+		{ProcessingID: "proc-1", Transaction: models.Transaction{TransactionID: "T1", AccountNumber: "ACC-1", CounterpartyAccount: "BAD-1"}},
+		{ProcessingID: "proc-2", Transaction: models.Transaction{TransactionID: "T2", AccountNumber: "ACC-2"}},
+	}, nil)
+	cases.On("RaiseRetroAlert", "proc-1", mock.Anything, mock.MatchedBy(func(a *models.RiskAnalysis) bool {
+		return a.Recommendation == "require_verification" && a.Flags[0] == "retro_sanctions_match"
+	}), mock.MatchedBy(func(n *models.CaseNote) bool {
+		return n.Author == "compliance-1" && strings.Contains(n.Text, "sanctions entry 5")
+	})).Return(&models.Alert{ID: 10, CaseID: 3}, nil)
+	cases.On("RaiseRetroAlert", "proc-2", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db locked"))
+	repo.On("SaveBlacklistMatches", int64(5), mock.Anything, mock.Anything).Return(nil)
+
+	entry, err := service.AddEntry(&models.BlacklistEntryRequest{
+		ListType:      models.BlacklistTypeSanctions,
+		AccountNumber: "BAD-1",
+		Name:          "Ivan Petrov",
+		Reason:        "sanctions list update",
+		AddedBy:       "compliance-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, entry.Matches, 2)
+	assert.Equal(t, 2, entry.MatchCount)
+	assert.Equal(t, models.BlacklistMatchCounterparty, entry.Matches[0].MatchedOn)
+	assert.Equal(t, int64(10), entry.Matches[0].AlertID)
+	assert.Equal(t, models.BlacklistMatchName, entry.Matches[1].MatchedOn)
+	assert.Zero(t, entry.Matches[1].AlertID)
+	redisClient.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestBlacklistService_AddEntry_RequiresAccountOrName(t *testing.T) {
+	service, repo, _, _ := newTestBlacklistService()
+
+	_, err := service.AddEntry(&models.BlacklistEntryRequest{
+		ListType: models.BlacklistTypeBlacklist,
+		Reason:   "fraud",
+		AddedBy:  "analyst-1",
+	})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	repo.AssertNotCalled(t, "CreateBlacklistEntry", mock.Anything)
+}
+
+func TestBlacklistService_GetEntry_NotFound(t *testing.T) {
+	service, repo, _, _ := newTestBlacklistService()
+
+	repo.On("GetBlacklistEntry", int64(9)).Return(nil, nil)
+
+	_, err := service.GetEntry(9)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,354 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// caseTransitions описывает допустимые переходы статусов кейса
+var caseTransitions = map[string][]string{
+	models.CaseStatusOpen:          {models.CaseStatusAssigned, models.CaseStatusClosed},
+	models.CaseStatusAssigned:      {models.CaseStatusOpen, models.CaseStatusInvestigating, models.CaseStatusEscalated, models.CaseStatusClosed},
+	models.CaseStatusInvestigating: {models.CaseStatusAssigned, models.CaseStatusEscalated, models.CaseStatusClosed},
+	models.CaseStatusEscalated:     {models.CaseStatusInvestigating, models.CaseStatusClosed},
+	models.CaseStatusClosed:        {models.CaseStatusOpen},
+}
+
+// caseResolutions - допустимые решения по закрытому кейсу
+var caseResolutions = map[string]bool{
+	models.CaseResolutionFalsePositive:   true,
+	models.CaseResolutionNoFurtherAction: true,
+	models.CaseResolutionSARFiled:        true,
+}
+
+// CaseServiceImpl реализует интерфейс CaseService
+type CaseServiceImpl struct {
+	repo storage.CaseRepository
+}
+
+// NewCaseService создает новый сервис алертов и кейсов
+func NewCaseService(repo storage.CaseRepository) CaseService {
+	return &CaseServiceImpl{repo: repo}
+}
+
+// requiresAlert проверяет, требует ли результат анализа внимания аналитика
+func requiresAlert(analysis *models.RiskAnalysis) bool {
+	return analysis.RiskLevel == "high" || analysis.Recommendation == "require_verification"
+}
+
+// RaiseAlert создает алерт и добавляет его в незакрытый кейс счета (или открывает новый)
+// Повторный вызов для той же транзакции возвращает уже созданный алерт
+func (s *CaseServiceImpl) RaiseAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis) (*models.Alert, error) {
+	if analysis == nil || !requiresAlert(analysis) {
+		return nil, nil
+	}
+
+	existing, err := s.repo.GetAlertByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	c, err := s.repo.GetActiveCaseByAccount(tx.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		c = &models.Case{
+			AccountNumber: tx.AccountNumber,
+			Status:        models.CaseStatusOpen,
+			MaxRiskScore:  analysis.RiskScore,
+		}
+		if err := s.repo.CreateCase(c); err != nil {
+			return nil, err
+		}
+	} else if analysis.RiskScore > c.MaxRiskScore {
+		c.MaxRiskScore = analysis.RiskScore
+		if err := s.repo.UpdateCase(c); err != nil {
+			return nil, err
+		}
+	}
+
+	alert := &models.Alert{
+		CaseID:         c.ID,
+		ProcessingID:   processingID,
+		TransactionID:  tx.TransactionID,
+		AccountNumber:  tx.AccountNumber,
+		RiskScore:      analysis.RiskScore,
+		RiskLevel:      analysis.RiskLevel,
+		Recommendation: analysis.Recommendation,
+		Flags:          analysis.Flags,
+		Status:         models.AlertStatusOpen,
+	}
+	if alert.Flags == nil {
+		alert.Flags = []string{}
+	}
+	if err := s.repo.SaveAlert(alert); err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+// RaiseRetroAlert отражает совпадение ретроспективной проверки по уже проанализированной операции
+// Алерт по операции один (processing_id уникален), поэтому при его наличии совпадение дописывается в него:
+// флаги объединяются, оценка повышается, а закрытый кейс открывается повторно, чтобы совпадение не осталось в архиве
+func (s *CaseServiceImpl) RaiseRetroAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis, note *models.CaseNote) (*models.Alert, error) {
+	existing, err := s.repo.GetAlertByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		alert, err := s.RaiseAlert(processingID, tx, analysis)
+		if err != nil || alert == nil {
+			return alert, err
+		}
+		return alert, s.addRetroNote(alert.CaseID, note)
+	}
+
+	c, err := s.repo.GetCase(existing.CaseID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: case %d of alert %d", ErrNotFound, existing.CaseID, existing.ID)
+	}
+
+	caseChanged := false
+	if c.Status == models.CaseStatusClosed {
+		if err := applyCaseStatus(c, models.CaseStatusOpen, ""); err != nil {
+			return nil, err
+		}
+		c.Status = models.CaseStatusOpen
+		caseChanged = true
+	}
+	if analysis.RiskScore > c.MaxRiskScore {
+		c.MaxRiskScore = analysis.RiskScore
+		caseChanged = true
+	}
+	if caseChanged {
+		// Повторное открытие кейса возвращает его алерты в работу
+		if err := s.repo.UpdateCase(c); err != nil {
+			return nil, err
+		}
+	}
+
+	existing.Flags = mergeFlags(existing.Flags, analysis.Flags)
+	if analysis.RiskScore > existing.RiskScore {
+		existing.RiskScore = analysis.RiskScore
+		existing.RiskLevel = analysis.RiskLevel
+		existing.Recommendation = analysis.Recommendation
+	}
+	existing.Status = models.AlertStatusOpen
+	if err := s.repo.UpdateAlert(existing); err != nil {
+		return nil, err
+	}
+
+	return existing, s.addRetroNote(c.ID, note)
+}
+
+// addRetroNote добавляет к кейсу заметку о ретроспективном совпадении
+func (s *CaseServiceImpl) addRetroNote(caseID int64, note *models.CaseNote) error {
+	if note == nil {
+		return nil
+	}
+	note.CaseID = caseID
+	return s.repo.AddCaseNote(note)
+}
+
+// mergeFlags добавляет к флагам алерта новые, сохраняя порядок и без повторов
+func mergeFlags(flags, added []string) []string {
+	merged := append([]string{}, flags...)
+	for _, flag := range added {
+		found := false
+		for _, f := range merged {
+			if f == flag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, flag)
+		}
+	}
+	return merged
+}
+
+// ListAlerts возвращает алерты по фильтру
+func (s *CaseServiceImpl) ListAlerts(filter models.AlertFilter) ([]*models.Alert, error) {
+	return s.repo.ListAlerts(filter)
+}
+
+// ListCases возвращает кейсы по фильтру
+func (s *CaseServiceImpl) ListCases(filter models.CaseFilter) ([]*models.Case, error) {
+	if filter.Status != "" {
+		if _, ok := caseTransitions[filter.Status]; !ok {
+			return nil, fmt.Errorf("%w: unknown case status %q", ErrInvalidInput, filter.Status)
+		}
+	}
+	return s.repo.ListCases(filter)
+}
+
+// GetCase возвращает кейс вместе с алертами, заметками и вложениями
+func (s *CaseServiceImpl) GetCase(id int64) (*models.CaseDetails, error) {
+	c, err := s.repo.GetCase(id)
+	if err != nil || c == nil {
+		return nil, err
+	}
+
+	details := &models.CaseDetails{Case: *c}
+	if details.Alerts, err = s.repo.ListCaseAlerts(id); err != nil {
+		return nil, err
+	}
+	if details.Notes, err = s.repo.ListCaseNotes(id); err != nil {
+		return nil, err
+	}
+	if details.Attachments, err = s.repo.ListCaseAttachments(id); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// UpdateCase меняет статус, исполнителя или решение по кейсу
+// Каждое изменение фиксируется заметкой от имени actor
+func (s *CaseServiceImpl) UpdateCase(id int64, update *models.CaseUpdate) (*models.Case, error) {
+	if strings.TrimSpace(update.Actor) == "" {
+		return nil, fmt.Errorf("%w: actor is required", ErrInvalidInput)
+	}
+
+	c, err := s.repo.GetCase(id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: case %d", ErrNotFound, id)
+	}
+
+	var changes []string
+	if update.AssignedTo != "" && update.AssignedTo != c.AssignedTo {
+		changes = append(changes, fmt.Sprintf("assigned_to: %q -> %q", c.AssignedTo, update.AssignedTo))
+		c.AssignedTo = update.AssignedTo
+	}
+
+	target := update.Status
+	if target == "" && c.Status == models.CaseStatusOpen && c.AssignedTo != "" {
+		// Назначение исполнителя на открытый кейс переводит его в работу
+		target = models.CaseStatusAssigned
+	}
+
+	if target != "" && target != c.Status {
+		if !caseTransitionAllowed(c.Status, target) {
+			return nil, fmt.Errorf("%w: case cannot move from %s to %s", ErrConflict, c.Status, target)
+		}
+		if err := applyCaseStatus(c, target, update.Resolution); err != nil {
+			return nil, err
+		}
+		changes = append(changes, fmt.Sprintf("status: %s -> %s", c.Status, target))
+		c.Status = target
+	} else if update.Resolution != "" {
+		return nil, fmt.Errorf("%w: resolution can only be set when closing a case", ErrInvalidInput)
+	}
+
+	if len(changes) == 0 {
+		return c, nil
+	}
+
+	if err := s.repo.UpdateCase(c); err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddCaseNote(&models.CaseNote{
+		CaseID: c.ID,
+		Author: update.Actor,
+		Text:   strings.Join(changes, "; "),
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetCase(id)
+}
+
+// AddNote добавляет заметку к кейсу
+func (s *CaseServiceImpl) AddNote(caseID int64, note *models.CaseNote) (*models.CaseNote, error) {
+	if strings.TrimSpace(note.Author) == "" || strings.TrimSpace(note.Text) == "" {
+		return nil, fmt.Errorf("%w: author and text are required", ErrInvalidInput)
+	}
+	if err := s.ensureCaseExists(caseID); err != nil {
+		return nil, err
+	}
+
+	note.CaseID = caseID
+	if err := s.repo.AddCaseNote(note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+// AddAttachment добавляет ссылку на документ к кейсу
+func (s *CaseServiceImpl) AddAttachment(caseID int64, attachment *models.CaseAttachment) (*models.CaseAttachment, error) {
+	if attachment.Name == "" || attachment.Reference == "" || attachment.AddedBy == "" {
+		return nil, fmt.Errorf("%w: name, reference and added_by are required", ErrInvalidInput)
+	}
+	if err := s.ensureCaseExists(caseID); err != nil {
+		return nil, err
+	}
+
+	attachment.CaseID = caseID
+	if err := s.repo.AddCaseAttachment(attachment); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *CaseServiceImpl) ensureCaseExists(caseID int64) error {
+	c, err := s.repo.GetCase(caseID)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return fmt.Errorf("%w: case %d", ErrNotFound, caseID)
+	}
+	return nil
+}
+
+// caseTransitionAllowed проверяет переход статуса по caseTransitions
+func caseTransitionAllowed(from, to string) bool {
+	for _, allowed := range caseTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// applyCaseStatus применяет побочные эффекты перехода в новый статус
+func applyCaseStatus(c *models.Case, target, resolution string) error {
+	switch target {
+	case models.CaseStatusAssigned:
+		if c.AssignedTo == "" {
+			return fmt.Errorf("%w: assigned_to is required to assign a case", ErrInvalidInput)
+		}
+	case models.CaseStatusOpen:
+		// Возврат в очередь или повторное открытие снимает исполнителя и решение
+		c.AssignedTo = ""
+		c.Resolution = ""
+		c.ClosedAt = nil
+	case models.CaseStatusClosed:
+		if !caseResolutions[resolution] {
+			return fmt.Errorf("%w: resolution must be one of false_positive, no_further_action, sar_filed", ErrInvalidInput)
+		}
+		now := time.Now()
+		c.Resolution = resolution
+		c.ClosedAt = &now
+	}
+	if resolution != "" && target != models.CaseStatusClosed {
+		return fmt.Errorf("%w: resolution can only be set when closing a case", ErrInvalidInput)
+	}
+	return nil
+}
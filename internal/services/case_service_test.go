@@ -0,0 +1,203 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var highRiskAnalysis = &models.RiskAnalysis{
+	RiskScore:      85,
+	RiskLevel:      "high",
+	Flags:          []string{"large_amount", "pass_through"},
+	Recommendation: "require_verification",
+}
+
+func TestCaseService_RaiseAlert_OpensCase(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	tx := &models.Transaction{TransactionID: "TXN-1", AccountNumber: "ACC123456"}
+
+	mockRepo.On("GetAlertByProcessingID", "proc-1").Return(nil, nil)
+	mockRepo.On("GetActiveCaseByAccount", "ACC123456").Return(nil, nil)
+	mockRepo.On("CreateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.AccountNumber == "ACC123456" && c.Status == models.CaseStatusOpen && c.MaxRiskScore == 85
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Case).ID = 7
+	}).Return(nil)
+	mockRepo.On("SaveAlert", mock.MatchedBy(func(a *models.Alert) bool {
+		return a.CaseID == 7 && a.ProcessingID == "proc-1" && len(a.Flags) == 2
+	})).Return(nil)
+
+	alert, err := service.RaiseAlert("proc-1", tx, highRiskAnalysis)
+	require.NoError(t, err)
+	require.NotNil(t, alert)
+	assert.Equal(t, int64(7), alert.CaseID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCaseService_RaiseAlert_AddsToActiveCase(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	tx := &models.Transaction{TransactionID: "TXN-2", AccountNumber: "ACC123456"}
+	active := &models.Case{ID: 3, AccountNumber: "ACC123456", Status: models.CaseStatusInvestigating, MaxRiskScore: 75}
+
+	mockRepo.On("GetAlertByProcessingID", "proc-2").Return(nil, nil)
+	mockRepo.On("GetActiveCaseByAccount", "ACC123456").Return(active, nil)
+	mockRepo.On("UpdateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.ID == 3 && c.MaxRiskScore == 85 && c.Status == models.CaseStatusInvestigating
+	})).Return(nil)
+	mockRepo.On("SaveAlert", mock.MatchedBy(func(a *models.Alert) bool { return a.CaseID == 3 })).Return(nil)
+
+	alert, err := service.RaiseAlert("proc-2", tx, highRiskAnalysis)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), alert.CaseID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCaseService_RaiseAlert_LowRiskIgnored(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	analysis := &models.RiskAnalysis{RiskScore: 20, RiskLevel: "low", Recommendation: "auto_approve"}
+	alert, err := service.RaiseAlert("proc-3", &models.Transaction{AccountNumber: "ACC123456"}, analysis)
+	require.NoError(t, err)
+	assert.Nil(t, alert)
+	mockRepo.AssertNotCalled(t, "SaveAlert", mock.Anything)
+}
+
+func TestCaseService_RaiseRetroAlert_ReopensClosedCase(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	tx := &models.Transaction{TransactionID: "TXN-4", AccountNumber: "ACC123456"}
+	closedAt := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	// Операция уже получила алерт при анализе, кейс закрыт как ложное срабатывание
+	existing := &models.Alert{
+		ID: 12, CaseID: 4, ProcessingID: "proc-4", RiskScore: 70, RiskLevel: "high",
+		Flags: []string{"large_amount"}, Status: models.AlertStatusClosed,
+	}
+	closed := &models.Case{
+		ID: 4, AccountNumber: "ACC123456", Status: models.CaseStatusClosed, AssignedTo: "analyst-1",
+		Resolution: models.CaseResolutionFalsePositive, MaxRiskScore: 70, ClosedAt: &closedAt,
+	}
+	retro := &models.RiskAnalysis{
+		RiskScore: 100, RiskLevel: "high", Recommendation: "require_verification",
+		Flags: []string{"retro_sanctions_match"},
+	}
+
+	mockRepo.On("GetAlertByProcessingID", "proc-4").Return(existing, nil)
+	mockRepo.On("GetCase", int64(4)).Return(closed, nil)
+	mockRepo.On("UpdateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.ID == 4 && c.Status == models.CaseStatusOpen && c.Resolution == "" && c.ClosedAt == nil && c.MaxRiskScore == 100
+	})).Return(nil)
+	mockRepo.On("UpdateAlert", mock.MatchedBy(func(a *models.Alert) bool {
+		return a.ID == 12 && a.Status == models.AlertStatusOpen && a.RiskScore == 100 &&
+			assert.ObjectsAreEqual([]string{"large_amount", "retro_sanctions_match"}, a.Flags)
+	})).Return(nil)
+	mockRepo.On("AddCaseNote", mock.MatchedBy(func(n *models.CaseNote) bool {
+		return n.CaseID == 4 && n.Author == "compliance-1"
+	})).Return(nil)
+
+	alert, err := service.RaiseRetroAlert("proc-4", tx, retro, &models.CaseNote{Author: "compliance-1", Text: "retro match"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), alert.ID)
+	assert.Equal(t, int64(4), alert.CaseID)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "SaveAlert", mock.Anything)
+}
+
+func TestCaseService_UpdateCase_AssignOpenCase(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	mockRepo.On("GetCase", int64(1)).Return(&models.Case{ID: 1, Status: models.CaseStatusOpen}, nil).Once()
+	mockRepo.On("UpdateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.Status == models.CaseStatusAssigned && c.AssignedTo == "analyst-1"
+	})).Return(nil)
+	mockRepo.On("AddCaseNote", mock.MatchedBy(func(n *models.CaseNote) bool {
+		return n.CaseID == 1 && n.Author == "lead-1"
+	})).Return(nil)
+	mockRepo.On("GetCase", int64(1)).Return(&models.Case{ID: 1, Status: models.CaseStatusAssigned, AssignedTo: "analyst-1"}, nil).Once()
+
+	updated, err := service.UpdateCase(1, &models.CaseUpdate{AssignedTo: "analyst-1", Actor: "lead-1"})
+	require.NoError(t, err)
+	assert.Equal(t, models.CaseStatusAssigned, updated.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCaseService_UpdateCase_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		current models.Case
+		update  models.CaseUpdate
+		wantErr error
+	}{
+		{
+			name:    "transition not allowed",
+			current: models.Case{ID: 1, Status: models.CaseStatusOpen},
+			update:  models.CaseUpdate{Status: models.CaseStatusEscalated, Actor: "analyst-1"},
+			wantErr: ErrConflict,
+		},
+		{
+			name:    "close without resolution",
+			current: models.Case{ID: 1, Status: models.CaseStatusInvestigating, AssignedTo: "analyst-1"},
+			update:  models.CaseUpdate{Status: models.CaseStatusClosed, Actor: "analyst-1"},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "resolution without closing",
+			current: models.Case{ID: 1, Status: models.CaseStatusAssigned, AssignedTo: "analyst-1"},
+			update:  models.CaseUpdate{Status: models.CaseStatusInvestigating, Resolution: "sar_filed", Actor: "analyst-1"},
+			wantErr: ErrInvalidInput,
+		},
+		{
+			name:    "assign without analyst",
+			current: models.Case{ID: 1, Status: models.CaseStatusOpen},
+			update:  models.CaseUpdate{Status: models.CaseStatusAssigned, Actor: "lead-1"},
+			wantErr: ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(storagemocks.MockCaseRepository)
+			service := NewCaseService(mockRepo)
+
+			current := tt.current
+			mockRepo.On("GetCase", int64(1)).Return(&current, nil)
+
+			_, err := service.UpdateCase(1, &tt.update)
+			assert.True(t, errors.Is(err, tt.wantErr), "unexpected error: %v", err)
+			mockRepo.AssertNotCalled(t, "UpdateCase", mock.Anything)
+		})
+	}
+}
+
+func TestCaseService_UpdateCase_Close(t *testing.T) {
+	mockRepo := new(storagemocks.MockCaseRepository)
+	service := NewCaseService(mockRepo)
+
+	mockRepo.On("GetCase", int64(1)).Return(&models.Case{ID: 1, Status: models.CaseStatusEscalated, AssignedTo: "analyst-1"}, nil)
+	mockRepo.On("UpdateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.Status == models.CaseStatusClosed && c.Resolution == models.CaseResolutionSARFiled && c.ClosedAt != nil
+	})).Return(nil)
+	mockRepo.On("AddCaseNote", mock.AnythingOfType("*models.CaseNote")).Return(nil)
+
+	_, err := service.UpdateCase(1, &models.CaseUpdate{
+		Status:     models.CaseStatusClosed,
+		Resolution: models.CaseResolutionSARFiled,
+		Actor:      "mlro",
+	})
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
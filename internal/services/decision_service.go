@@ -0,0 +1,430 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/kafka"
+	"bank-aml-system/internal/logger"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+// decisionTransitions описывает допустимые переходы решений по транзакции
+var decisionTransitions = map[string][]string{
+	models.DecisionPending:  {models.DecisionApproved, models.DecisionHeld, models.DecisionBlocked},
+	models.DecisionApproved: {models.DecisionReported},
+	models.DecisionHeld:     {models.DecisionReleased, models.DecisionBlocked, models.DecisionReported},
+	models.DecisionReleased: {models.DecisionReported},
+	models.DecisionBlocked:  {models.DecisionReleased, models.DecisionReported},
+	models.DecisionReported: {},
+}
+
+// fourEyesDecisions - решения, которые по внутренней политике вступают в силу только после
+// подтверждения вторым сотрудником с ролью approver или supervisor
+var fourEyesDecisions = map[string]bool{
+	models.DecisionBlocked:  true,
+	models.DecisionReleased: true,
+}
+
+// defaultApprovalTTL - срок подтверждения, если он не задан в конфигурации
+const defaultApprovalTTL = 24 * time.Hour
+
+// recommendationDecisions сопоставляет рекомендацию анализатора автоматическому решению
+var recommendationDecisions = map[string]string{
+	"auto_approve":         models.DecisionApproved,
+	"log_only":             models.DecisionApproved,
+	"require_verification": models.DecisionHeld,
+}
+
+// DecisionServiceImpl реализует интерфейс DecisionService
+type DecisionServiceImpl struct {
+	repo         storage.DecisionRepository
+	transactions storage.TransactionRepository
+	producer     kafka.Producer // Опционально: без producer события не публикуются
+	approvalTTL  time.Duration
+}
+
+// NewDecisionService создает новый сервис решений по транзакциям
+func NewDecisionService(
+	repo storage.DecisionRepository,
+	transactions storage.TransactionRepository,
+	producer kafka.Producer,
+	approvalTTL time.Duration,
+) DecisionService {
+	if approvalTTL <= 0 {
+		approvalTTL = defaultApprovalTTL
+	}
+	return &DecisionServiceImpl{repo: repo, transactions: transactions, producer: producer, approvalTTL: approvalTTL}
+}
+
+// GetDecision возвращает текущее решение по транзакции вместе с историей
+func (s *DecisionServiceImpl) GetDecision(processingID string) (*models.DecisionDetails, error) {
+	if _, err := s.getTransaction(processingID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.currentDecision(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if current.PendingApproval, err = s.pendingApproval(processingID); err != nil {
+		return nil, err
+	}
+	history, err := s.repo.ListDecisionHistory(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = []*models.DecisionChange{}
+	}
+
+	return &models.DecisionDetails{Decision: *current, History: history}, nil
+}
+
+// ChangeDecision меняет решение по транзакции по запросу аналитика или core banking
+// Блокировка и разблокировка не применяются сразу: создается запрос на подтверждение вторым сотрудником,
+// а в ответе возвращается текущее решение с этим запросом
+// Инициатор берется из initiator; actor из запроса используется, только если пользователь запроса не известен
+func (s *DecisionServiceImpl) ChangeDecision(processingID string, req *models.DecisionRequest, initiator models.Identity) (*models.TransactionDecision, error) {
+	actor, err := resolveActor(req.Actor, initiator)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if actor == "" {
+		return nil, fmt.Errorf("%w: actor is required", ErrInvalidInput)
+	}
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidInput)
+	}
+	if _, ok := decisionTransitions[req.Decision]; !ok || req.Decision == models.DecisionPending {
+		return nil, fmt.Errorf("%w: unknown decision %q", ErrInvalidInput, req.Decision)
+	}
+	if fourEyesDecisions[req.Decision] && initiator.UserID == "" {
+		// Без известного инициатора подтверждающий мог бы указать чужое имя и подтвердить собственный запрос
+		return nil, fmt.Errorf("%w: %s decision must be requested by an identified user", ErrForbidden, req.Decision)
+	}
+
+	tx, err := s.getTransaction(processingID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.currentDecision(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if !decisionTransitionAllowed(current.Decision, req.Decision) {
+		return nil, fmt.Errorf("%w: decision cannot move from %s to %s", ErrConflict, current.Decision, req.Decision)
+	}
+
+	if fourEyesDecisions[req.Decision] {
+		approval, err := s.requestApproval(current, req.Decision, actor, reason)
+		if err != nil {
+			return nil, err
+		}
+		current.PendingApproval = approval
+		return current, nil
+	}
+
+	return s.apply(tx, &models.DecisionChange{FromDecision: current.Decision, ToDecision: req.Decision, Actor: actor, Reason: reason})
+}
+
+// ListApprovals возвращает запросы на подтверждение решений
+func (s *DecisionServiceImpl) ListApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error) {
+	if _, err := s.repo.ExpireDecisionApprovals(time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.ListDecisionApprovals(filter)
+}
+
+// ApproveDecision подтверждает запрос и применяет решение
+// Подтвердить может только пользователь с ролью approver или supervisor, не являющийся инициатором запроса
+func (s *DecisionServiceImpl) ApproveDecision(approvalID int64, approver models.Identity) (*models.TransactionDecision, error) {
+	approval, err := s.getPendingApproval(approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if !canApprove(approver) {
+		return nil, fmt.Errorf("%w: role %s or %s is required to approve decisions", ErrForbidden, models.RoleApprover, models.RoleSupervisor)
+	}
+	if approver.UserID == approval.RequestedBy {
+		return nil, fmt.Errorf("%w: decision must be approved by a user other than the initiator", ErrForbidden)
+	}
+
+	tx, err := s.getTransaction(approval.ProcessingID)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := s.apply(tx, &models.DecisionChange{
+		FromDecision: approval.FromDecision,
+		ToDecision:   approval.ToDecision,
+		Actor:        approval.RequestedBy,
+		ApprovedBy:   approver.UserID,
+		Reason:       approval.Reason,
+	})
+	if err == errDecisionRaced {
+		// Решение изменилось после создания запроса: подтверждать нечего
+		approval.Status = models.ApprovalStatusRejected
+		approval.ResolvedBy = approver.UserID
+		approval.Comment = "decision changed since the request was created"
+		if _, resolveErr := s.repo.ResolveDecisionApproval(approval); resolveErr != nil {
+			return nil, resolveErr
+		}
+		return nil, fmt.Errorf("%w: decision changed since approval %d was requested", ErrConflict, approvalID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	approval.Status = models.ApprovalStatusApproved
+	approval.ResolvedBy = approver.UserID
+	if _, err := s.repo.ResolveDecisionApproval(approval); err != nil {
+		return nil, err
+	}
+	return decision, nil
+}
+
+// RejectDecision отклоняет запрос на подтверждение
+// Отклонить может сотрудник с ролью approver или supervisor либо сам инициатор (отзыв запроса)
+func (s *DecisionServiceImpl) RejectDecision(approvalID int64, actor models.Identity, comment string) (*models.DecisionApproval, error) {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return nil, fmt.Errorf("%w: comment is required", ErrInvalidInput)
+	}
+
+	approval, err := s.getPendingApproval(approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.UserID != approval.RequestedBy && !canApprove(actor) {
+		return nil, fmt.Errorf("%w: only the initiator, an %s or a %s can reject the request", ErrForbidden, models.RoleApprover, models.RoleSupervisor)
+	}
+
+	approval.Status = models.ApprovalStatusRejected
+	approval.ResolvedBy = actor.UserID
+	approval.Comment = comment
+	resolved, err := s.repo.ResolveDecisionApproval(approval)
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		return nil, fmt.Errorf("%w: approval %d is already resolved", ErrConflict, approvalID)
+	}
+	return approval, nil
+}
+
+// requestApproval создает запрос на подтверждение решения вторым сотрудником
+func (s *DecisionServiceImpl) requestApproval(current *models.TransactionDecision, to, actor, reason string) (*models.DecisionApproval, error) {
+	pending, err := s.pendingApproval(current.ProcessingID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return nil, fmt.Errorf("%w: transaction already has pending approval %d (%s)", ErrConflict, pending.ID, pending.ToDecision)
+	}
+
+	now := time.Now()
+	approval := &models.DecisionApproval{
+		ProcessingID: current.ProcessingID,
+		FromDecision: current.Decision,
+		ToDecision:   to,
+		RequestedBy:  actor,
+		Reason:       reason,
+		Status:       models.ApprovalStatusPending,
+		ExpiresAt:    now.Add(s.approvalTTL),
+		CreatedAt:    now,
+	}
+	if err := s.repo.CreateDecisionApproval(approval); err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// pendingApproval возвращает действующий запрос на подтверждение по транзакции, предварительно закрыв просроченные
+func (s *DecisionServiceImpl) pendingApproval(processingID string) (*models.DecisionApproval, error) {
+	if _, err := s.repo.ExpireDecisionApprovals(time.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPendingDecisionApproval(processingID)
+}
+
+// getPendingApproval возвращает запрос, который еще можно подтвердить или отклонить
+func (s *DecisionServiceImpl) getPendingApproval(approvalID int64) (*models.DecisionApproval, error) {
+	approval, err := s.repo.GetDecisionApproval(approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil {
+		return nil, fmt.Errorf("%w: approval %d", ErrNotFound, approvalID)
+	}
+	if approval.Status == models.ApprovalStatusPending && !time.Now().Before(approval.ExpiresAt) {
+		approval.Status = models.ApprovalStatusExpired
+		if _, err := s.repo.ResolveDecisionApproval(approval); err != nil {
+			return nil, err
+		}
+	}
+	if approval.Status != models.ApprovalStatusPending {
+		return nil, fmt.Errorf("%w: approval %d is %s", ErrConflict, approvalID, approval.Status)
+	}
+	return approval, nil
+}
+
+// ApplyRecommendation принимает автоматическое решение по рекомендации анализатора
+// Если решение уже принималось (повторный анализ или ручное решение), оно не меняется
+func (s *DecisionServiceImpl) ApplyRecommendation(processingID string, analysis *models.RiskAnalysis) (*models.TransactionDecision, error) {
+	if analysis == nil {
+		return nil, nil
+	}
+	decision, ok := recommendationDecisions[analysis.Recommendation]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown recommendation %q", ErrInvalidInput, analysis.Recommendation)
+	}
+
+	current, err := s.currentDecision(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Decision != models.DecisionPending {
+		return current, nil
+	}
+
+	tx, err := s.getTransaction(processingID)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.apply(tx, &models.DecisionChange{
+		FromDecision: models.DecisionPending,
+		ToDecision:   decision,
+		Actor:        models.DecisionActorSystem,
+		Reason:       fmt.Sprintf("recommendation: %s (risk score %d)", analysis.Recommendation, analysis.RiskScore),
+	})
+	if err == errDecisionRaced {
+		// Решение успели принять параллельно — оставляем его
+		return s.currentDecision(processingID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ApplySystemDecision меняет решение от имени системы по внутренней политике (например, по истечении срока проверки)
+// Подтверждение вторым сотрудником не требуется: политика утверждена заранее
+func (s *DecisionServiceImpl) ApplySystemDecision(processingID, decision, reason string) (*models.TransactionDecision, error) {
+	tx, err := s.getTransaction(processingID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.currentDecision(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if !decisionTransitionAllowed(current.Decision, decision) {
+		return nil, fmt.Errorf("%w: decision cannot move from %s to %s", ErrConflict, current.Decision, decision)
+	}
+
+	return s.apply(tx, &models.DecisionChange{
+		FromDecision: current.Decision,
+		ToDecision:   decision,
+		Actor:        models.DecisionActorSystem,
+		Reason:       reason,
+	})
+}
+
+// errDecisionRaced возвращается apply, если решение изменили между чтением и записью
+var errDecisionRaced = fmt.Errorf("%w: decision was changed concurrently, retry", ErrConflict)
+
+// apply сохраняет переход и публикует событие для core banking
+func (s *DecisionServiceImpl) apply(tx *models.TransactionStatus, change *models.DecisionChange) (*models.TransactionDecision, error) {
+	change.ProcessingID = tx.ProcessingID
+	change.CreatedAt = time.Now()
+
+	applied, err := s.repo.ApplyDecision(change)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, errDecisionRaced
+	}
+
+	s.publish(tx, change)
+
+	return &models.TransactionDecision{
+		ProcessingID: change.ProcessingID,
+		Decision:     change.ToDecision,
+		Actor:        change.Actor,
+		Reason:       change.Reason,
+		UpdatedAt:    change.CreatedAt,
+	}, nil
+}
+
+// publish отправляет событие об изменении решения; ошибка отправки не отменяет сохраненное решение
+func (s *DecisionServiceImpl) publish(tx *models.TransactionStatus, change *models.DecisionChange) {
+	if s.producer == nil {
+		return
+	}
+
+	event := &models.KafkaDecisionEvent{
+		EventID:   "evt_" + uuid.New().String(),
+		EventType: models.DecisionEventType,
+		Timestamp: change.CreatedAt,
+		Data: models.KafkaDecisionData{
+			ProcessingID:  change.ProcessingID,
+			TransactionID: tx.TransactionID,
+			FromDecision:  change.FromDecision,
+			Decision:      change.ToDecision,
+			Actor:         change.Actor,
+			ApprovedBy:    change.ApprovedBy,
+			Reason:        change.Reason,
+		},
+	}
+	if err := s.producer.SendDecisionEvent(event); err != nil {
+		log.Printf("Error publishing decision event for %s: %v", change.ProcessingID, err)
+		return
+	}
+
+	logger.LogEvent(logger.EventKafkaSent, "decision-service", "kafka", map[string]interface{}{
+		"processing_id": change.ProcessingID,
+		"event_id":      event.EventID,
+		"decision":      change.ToDecision,
+	})
+}
+
+// getTransaction возвращает транзакцию или ErrNotFound
+func (s *DecisionServiceImpl) getTransaction(processingID string) (*models.TransactionStatus, error) {
+	tx, err := s.transactions.GetTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+	return tx, nil
+}
+
+// currentDecision возвращает текущее решение; отсутствие записи означает pending
+func (s *DecisionServiceImpl) currentDecision(processingID string) (*models.TransactionDecision, error) {
+	current, err := s.repo.GetDecision(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return &models.TransactionDecision{ProcessingID: processingID, Decision: models.DecisionPending}, nil
+	}
+	return current, nil
+}
+
+// decisionTransitionAllowed проверяет допустимость перехода между решениями
+func decisionTransitionAllowed(from, to string) bool {
+	for _, allowed := range decisionTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
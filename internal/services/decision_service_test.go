@@ -0,0 +1,310 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	kafkamocks "bank-aml-system/internal/kafka/mocks"
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDecisionService() (DecisionService, *storagemocks.MockDecisionRepository, *storagemocks.MockTransactionRepository, *kafkamocks.MockProducer) {
+	repo := new(storagemocks.MockDecisionRepository)
+	txRepo := new(storagemocks.MockTransactionRepository)
+	producer := new(kafkamocks.MockProducer)
+	return NewDecisionService(repo, txRepo, producer, time.Hour), repo, txRepo, producer
+}
+
+var decisionTestTx = &models.TransactionStatus{ProcessingID: "proc-1", TransactionID: "TXN-1"}
+
+func TestDecisionService_ApplyRecommendation_HoldsForVerification(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	repo.On("GetDecision", "proc-1").Return(nil, nil)
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("ApplyDecision", mock.MatchedBy(func(c *models.DecisionChange) bool {
+		return c.FromDecision == models.DecisionPending && c.ToDecision == models.DecisionHeld && c.Actor == models.DecisionActorSystem
+	})).Return(true, nil)
+	producer.On("SendDecisionEvent", mock.MatchedBy(func(e *models.KafkaDecisionEvent) bool {
+		return e.Data.TransactionID == "TXN-1" && e.Data.Decision == models.DecisionHeld
+	})).Return(nil)
+
+	decision, err := service.ApplyRecommendation("proc-1", &models.RiskAnalysis{RiskScore: 85, Recommendation: "require_verification"})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionHeld, decision.Decision)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestDecisionService_ApplyRecommendation_KeepsExistingDecision(t *testing.T) {
+	service, repo, _, producer := newTestDecisionService()
+
+	existing := &models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionBlocked, Actor: "analyst-1"}
+	repo.On("GetDecision", "proc-1").Return(existing, nil)
+
+	decision, err := service.ApplyRecommendation("proc-1", &models.RiskAnalysis{Recommendation: "auto_approve"})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionBlocked, decision.Decision)
+	repo.AssertNotCalled(t, "ApplyDecision", mock.Anything)
+	producer.AssertNotCalled(t, "SendDecisionEvent", mock.Anything)
+}
+
+func TestDecisionService_ChangeDecision_ReportsImmediately(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld}, nil)
+	repo.On("ApplyDecision", mock.MatchedBy(func(c *models.DecisionChange) bool {
+		return c.FromDecision == models.DecisionHeld && c.ToDecision == models.DecisionReported &&
+			c.Actor == "analyst-1" && c.Reason == "направлено сообщение"
+	})).Return(true, nil)
+	producer.On("SendDecisionEvent", mock.Anything).Return(nil)
+
+	decision, err := service.ChangeDecision("proc-1", &models.DecisionRequest{
+		Decision: models.DecisionReported,
+		Actor:    "analyst-1",
+		Reason:   "направлено сообщение",
+	}, models.Identity{})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionReported, decision.Decision)
+	assert.Nil(t, decision.PendingApproval)
+	repo.AssertExpectations(t)
+}
+
+func TestDecisionService_ChangeDecision_ReleaseRequiresApproval(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld}, nil)
+	repo.On("ExpireDecisionApprovals", mock.Anything).Return(int64(0), nil)
+	repo.On("GetPendingDecisionApproval", "proc-1").Return(nil, nil)
+	repo.On("CreateDecisionApproval", mock.MatchedBy(func(a *models.DecisionApproval) bool {
+		return a.FromDecision == models.DecisionHeld && a.ToDecision == models.DecisionReleased &&
+			a.RequestedBy == "analyst-1" && a.Status == models.ApprovalStatusPending &&
+			a.ExpiresAt.Sub(a.CreatedAt) == time.Hour
+	})).Return(nil)
+
+	decision, err := service.ChangeDecision("proc-1", &models.DecisionRequest{
+		Decision: models.DecisionReleased,
+		Reason:   "документы подтверждены",
+	}, models.Identity{UserID: "analyst-1", Roles: []string{models.RoleAnalyst}})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionHeld, decision.Decision)
+	require.NotNil(t, decision.PendingApproval)
+	assert.Equal(t, models.DecisionReleased, decision.PendingApproval.ToDecision)
+	repo.AssertNotCalled(t, "ApplyDecision", mock.Anything)
+	producer.AssertNotCalled(t, "SendDecisionEvent", mock.Anything)
+}
+
+func TestDecisionService_ChangeDecision_PendingApprovalConflict(t *testing.T) {
+	service, repo, txRepo, _ := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(nil, nil)
+	repo.On("ExpireDecisionApprovals", mock.Anything).Return(int64(0), nil)
+	repo.On("GetPendingDecisionApproval", "proc-1").Return(&models.DecisionApproval{ID: 3, ToDecision: models.DecisionBlocked}, nil)
+
+	_, err := service.ChangeDecision("proc-1", &models.DecisionRequest{
+		Decision: models.DecisionBlocked,
+		Actor:    "analyst-2",
+		Reason:   "повторный запрос",
+	}, models.Identity{UserID: "analyst-2"})
+	assert.True(t, errors.Is(err, ErrConflict))
+	repo.AssertNotCalled(t, "CreateDecisionApproval", mock.Anything)
+}
+
+func TestDecisionService_ChangeDecision_InitiatorFromIdentity(t *testing.T) {
+	service, _, txRepo, _ := newTestDecisionService()
+
+	tests := []struct {
+		name      string
+		req       *models.DecisionRequest
+		initiator models.Identity
+	}{
+		// Подтверждающий не может записать запрос на чужое имя и затем подтвердить его сам
+		{"actor differs from request user", &models.DecisionRequest{Decision: models.DecisionBlocked, Actor: "analyst-1", Reason: "r"}, models.Identity{UserID: "supervisor-1"}},
+		{"four-eyes decision without request user", &models.DecisionRequest{Decision: models.DecisionBlocked, Actor: "analyst-1", Reason: "r"}, models.Identity{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ChangeDecision("proc-1", tt.req, tt.initiator)
+			assert.True(t, errors.Is(err, ErrForbidden))
+		})
+	}
+	txRepo.AssertNotCalled(t, "GetTransactionByProcessingID", mock.Anything)
+}
+
+func pendingBlockApproval() *models.DecisionApproval {
+	return &models.DecisionApproval{
+		ID:           3,
+		ProcessingID: "proc-1",
+		FromDecision: models.DecisionHeld,
+		ToDecision:   models.DecisionBlocked,
+		RequestedBy:  "analyst-1",
+		Reason:       "совпадение с санкционным списком",
+		Status:       models.ApprovalStatusPending,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+}
+
+func TestDecisionService_ApproveDecision_AppliesWithBothIdentities(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	repo.On("GetDecisionApproval", int64(3)).Return(pendingBlockApproval(), nil)
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("ApplyDecision", mock.MatchedBy(func(c *models.DecisionChange) bool {
+		return c.ToDecision == models.DecisionBlocked && c.Actor == "analyst-1" && c.ApprovedBy == "supervisor-1"
+	})).Return(true, nil)
+	producer.On("SendDecisionEvent", mock.MatchedBy(func(e *models.KafkaDecisionEvent) bool {
+		return e.Data.Actor == "analyst-1" && e.Data.ApprovedBy == "supervisor-1"
+	})).Return(nil)
+	repo.On("ResolveDecisionApproval", mock.MatchedBy(func(a *models.DecisionApproval) bool {
+		return a.Status == models.ApprovalStatusApproved && a.ResolvedBy == "supervisor-1"
+	})).Return(true, nil)
+
+	decision, err := service.ApproveDecision(3, models.Identity{UserID: "supervisor-1", Roles: []string{models.RoleApprover}})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionBlocked, decision.Decision)
+	repo.AssertExpectations(t)
+	producer.AssertExpectations(t)
+}
+
+func TestDecisionService_ApproveDecision_SupervisorRole(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	repo.On("GetDecisionApproval", int64(3)).Return(pendingBlockApproval(), nil)
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("ApplyDecision", mock.MatchedBy(func(c *models.DecisionChange) bool {
+		return c.ApprovedBy == "head-1"
+	})).Return(true, nil)
+	producer.On("SendDecisionEvent", mock.Anything).Return(nil)
+	repo.On("ResolveDecisionApproval", mock.Anything).Return(true, nil)
+
+	decision, err := service.ApproveDecision(3, models.Identity{UserID: "head-1", Roles: []string{models.RoleSupervisor}})
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionBlocked, decision.Decision)
+	repo.AssertExpectations(t)
+}
+
+func TestDecisionService_ApproveDecision_Forbidden(t *testing.T) {
+	service, repo, _, _ := newTestDecisionService()
+
+	repo.On("GetDecisionApproval", int64(3)).Return(pendingBlockApproval(), nil)
+
+	tests := []struct {
+		name     string
+		approver models.Identity
+	}{
+		{"without approver role", models.Identity{UserID: "analyst-2", Roles: []string{models.RoleAnalyst}}},
+		{"initiator approves own request", models.Identity{UserID: "analyst-1", Roles: []string{models.RoleApprover}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ApproveDecision(3, tt.approver)
+			assert.True(t, errors.Is(err, ErrForbidden))
+		})
+	}
+	repo.AssertNotCalled(t, "ApplyDecision", mock.Anything)
+}
+
+func TestDecisionService_ApproveDecision_Expired(t *testing.T) {
+	service, repo, _, _ := newTestDecisionService()
+
+	approval := pendingBlockApproval()
+	approval.ExpiresAt = time.Now().Add(-time.Minute)
+	repo.On("GetDecisionApproval", int64(3)).Return(approval, nil)
+	repo.On("ResolveDecisionApproval", mock.MatchedBy(func(a *models.DecisionApproval) bool {
+		return a.Status == models.ApprovalStatusExpired
+	})).Return(true, nil)
+
+	_, err := service.ApproveDecision(3, models.Identity{UserID: "supervisor-1", Roles: []string{models.RoleApprover}})
+	assert.True(t, errors.Is(err, ErrConflict))
+	repo.AssertNotCalled(t, "ApplyDecision", mock.Anything)
+}
+
+func TestDecisionService_RejectDecision_ByInitiator(t *testing.T) {
+	service, repo, _, _ := newTestDecisionService()
+
+	repo.On("GetDecisionApproval", int64(3)).Return(pendingBlockApproval(), nil)
+	repo.On("ResolveDecisionApproval", mock.MatchedBy(func(a *models.DecisionApproval) bool {
+		return a.Status == models.ApprovalStatusRejected && a.ResolvedBy == "analyst-1"
+	})).Return(true, nil)
+
+	approval, err := service.RejectDecision(3, models.Identity{UserID: "analyst-1"}, "ошибочный запрос")
+	require.NoError(t, err)
+	assert.Equal(t, models.ApprovalStatusRejected, approval.Status)
+}
+
+func TestDecisionService_ChangeDecision_InvalidTransition(t *testing.T) {
+	service, repo, txRepo, _ := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(nil, nil)
+
+	_, err := service.ChangeDecision("proc-1", &models.DecisionRequest{
+		Decision: models.DecisionReleased,
+		Reason:   "ok",
+	}, models.Identity{UserID: "analyst-1"})
+	assert.True(t, errors.Is(err, ErrConflict))
+	repo.AssertNotCalled(t, "ApplyDecision", mock.Anything)
+}
+
+func TestDecisionService_ChangeDecision_InvalidInput(t *testing.T) {
+	service, _, txRepo, _ := newTestDecisionService()
+
+	tests := []struct {
+		name string
+		req  *models.DecisionRequest
+	}{
+		{"missing reason", &models.DecisionRequest{Decision: models.DecisionBlocked, Actor: "a"}},
+		{"missing actor", &models.DecisionRequest{Decision: models.DecisionBlocked, Reason: "r"}},
+		{"pending is not settable", &models.DecisionRequest{Decision: models.DecisionPending, Actor: "a", Reason: "r"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ChangeDecision("proc-1", tt.req, models.Identity{})
+			assert.True(t, errors.Is(err, ErrInvalidInput))
+		})
+	}
+	txRepo.AssertNotCalled(t, "GetTransactionByProcessingID", mock.Anything)
+}
+
+func TestDecisionService_GetDecision_DefaultsToPending(t *testing.T) {
+	service, repo, txRepo, _ := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(nil, nil)
+	repo.On("ExpireDecisionApprovals", mock.Anything).Return(int64(0), nil)
+	repo.On("GetPendingDecisionApproval", "proc-1").Return(nil, nil)
+	repo.On("ListDecisionHistory", "proc-1").Return(nil, nil)
+
+	details, err := service.GetDecision("proc-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionPending, details.Decision.Decision)
+	assert.Empty(t, details.History)
+}
+
+func TestDecisionService_ApplySystemDecision_ReleasesWithoutApproval(t *testing.T) {
+	service, repo, txRepo, producer := newTestDecisionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil)
+	repo.On("GetDecision", "proc-1").Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld}, nil)
+	repo.On("ApplyDecision", mock.MatchedBy(func(c *models.DecisionChange) bool {
+		return c.ToDecision == models.DecisionReleased && c.Actor == models.DecisionActorSystem && c.ApprovedBy == ""
+	})).Return(true, nil)
+	producer.On("SendDecisionEvent", mock.Anything).Return(nil)
+
+	decision, err := service.ApplySystemDecision("proc-1", models.DecisionReleased, "hold SLA expired")
+	require.NoError(t, err)
+	assert.Equal(t, models.DecisionReleased, decision.Decision)
+	repo.AssertNotCalled(t, "CreateDecisionApproval", mock.Anything)
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// dispositionValues - допустимые заключения аналитика
+var dispositionValues = map[string]bool{
+	models.DispositionTruePositive:  true,
+	models.DispositionFalsePositive: true,
+	models.DispositionInconclusive:  true,
+}
+
+// DispositionServiceImpl реализует интерфейс DispositionService
+type DispositionServiceImpl struct {
+	repo         storage.DispositionRepository
+	transactions storage.TransactionRepository
+	cases        storage.CaseRepository
+}
+
+// NewDispositionService создает новый сервис заключений аналитиков
+func NewDispositionService(
+	repo storage.DispositionRepository,
+	transactions storage.TransactionRepository,
+	cases storage.CaseRepository,
+) DispositionService {
+	return &DispositionServiceImpl{repo: repo, transactions: transactions, cases: cases}
+}
+
+// RecordFlags сохраняет флаги анализа транзакции
+func (s *DispositionServiceImpl) RecordFlags(processingID string, analysis *models.RiskAnalysis) error {
+	if analysis == nil || len(analysis.Flags) == 0 {
+		return nil
+	}
+	flaggedAt := analysis.AnalyzedAt
+	if flaggedAt.IsZero() {
+		flaggedAt = time.Now()
+	}
+	return s.repo.SaveTransactionFlags(processingID, analysis.Flags, analysis.RulesetVersion, flaggedAt)
+}
+
+// RecordDisposition сохраняет заключение аналитика
+// Заключение по алерту относится к транзакции алерта; заключение по транзакции связывается с ее алертом, если он есть
+func (s *DispositionServiceImpl) RecordDisposition(d *models.Disposition) (*models.Disposition, error) {
+	d.ProcessingID = strings.TrimSpace(d.ProcessingID)
+	d.Analyst = strings.TrimSpace(d.Analyst)
+	if !dispositionValues[d.Disposition] {
+		return nil, fmt.Errorf("%w: unknown disposition %q", ErrInvalidInput, d.Disposition)
+	}
+	if d.Analyst == "" {
+		return nil, fmt.Errorf("%w: analyst is required", ErrInvalidInput)
+	}
+
+	if d.AlertID != 0 {
+		alert, err := s.cases.GetAlert(d.AlertID)
+		if err != nil {
+			return nil, err
+		}
+		if alert == nil {
+			return nil, fmt.Errorf("%w: alert %d", ErrNotFound, d.AlertID)
+		}
+		if d.ProcessingID != "" && d.ProcessingID != alert.ProcessingID {
+			return nil, fmt.Errorf("%w: alert %d belongs to transaction %s", ErrInvalidInput, d.AlertID, alert.ProcessingID)
+		}
+		d.ProcessingID = alert.ProcessingID
+	} else {
+		if d.ProcessingID == "" {
+			return nil, fmt.Errorf("%w: processing_id or alert_id is required", ErrInvalidInput)
+		}
+		tx, err := s.transactions.GetTransactionByProcessingID(d.ProcessingID)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, d.ProcessingID)
+		}
+		alert, err := s.cases.GetAlertByProcessingID(d.ProcessingID)
+		if err != nil {
+			return nil, err
+		}
+		if alert != nil {
+			d.AlertID = alert.ID
+		}
+	}
+
+	if err := s.repo.SaveDisposition(d); err != nil {
+		return nil, err
+	}
+	return s.repo.GetDisposition(d.ProcessingID)
+}
+
+// GetDisposition возвращает заключение аналитика по транзакции
+func (s *DispositionServiceImpl) GetDisposition(processingID string) (*models.Disposition, error) {
+	d, err := s.repo.GetDisposition(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("%w: disposition for transaction %s", ErrNotFound, processingID)
+	}
+	return d, nil
+}
+
+// GetPrecisionReport возвращает точность флагов и конверсию алертов в SAR за период [from, to)
+// Precision считается только по заключениям true/false positive; inconclusive в знаменатель не входит
+func (s *DispositionServiceImpl) GetPrecisionReport(from, to time.Time) (*models.PrecisionReport, error) {
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+
+	flags, err := s.repo.GetFlagPrecision(from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range flags {
+		p.Precision = ratio(p.TruePositives, p.TruePositives+p.FalsePositives)
+		p.AlertToSAR = ratio(p.SARs, p.Alerts)
+	}
+	if flags == nil {
+		flags = []*models.FlagPrecision{}
+	}
+
+	return &models.PrecisionReport{From: from, To: to, Flags: flags}, nil
+}
+
+// ratio возвращает долю part/total или nil, если знаменатель нулевой
+func ratio(part, total int) *float64 {
+	if total == 0 {
+		return nil
+	}
+	value := float64(part) / float64(total)
+	return &value
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDispositionService() (DispositionService, *storagemocks.MockDispositionRepository, *storagemocks.MockTransactionRepository, *storagemocks.MockCaseRepository) {
+	repo := new(storagemocks.MockDispositionRepository)
+	txRepo := new(storagemocks.MockTransactionRepository)
+	caseRepo := new(storagemocks.MockCaseRepository)
+	return NewDispositionService(repo, txRepo, caseRepo), repo, txRepo, caseRepo
+}
+
+func TestDispositionService_RecordFlags(t *testing.T) {
+	service, repo, _, _ := newTestDispositionService()
+
+	analyzedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	analysis := &models.RiskAnalysis{
+		Flags:          []string{"large_amount", "fan_in"},
+		RulesetVersion: "2.0",
+		AnalyzedAt:     analyzedAt,
+	}
+	repo.On("SaveTransactionFlags", "proc-1", analysis.Flags, "2.0", analyzedAt).Return(nil)
+
+	require.NoError(t, service.RecordFlags("proc-1", analysis))
+	require.NoError(t, service.RecordFlags("proc-2", &models.RiskAnalysis{}))
+	repo.AssertExpectations(t)
+}
+
+func TestDispositionService_RecordDisposition_ByAlert(t *testing.T) {
+	service, repo, txRepo, caseRepo := newTestDispositionService()
+
+	caseRepo.On("GetAlert", int64(5)).Return(&models.Alert{ID: 5, ProcessingID: "proc-1"}, nil)
+	repo.On("SaveDisposition", mock.MatchedBy(func(d *models.Disposition) bool {
+		return d.ProcessingID == "proc-1" && d.AlertID == 5 && d.Disposition == models.DispositionTruePositive
+	})).Return(nil)
+	repo.On("GetDisposition", "proc-1").Return(&models.Disposition{ID: 1, ProcessingID: "proc-1", AlertID: 5}, nil)
+
+	saved, err := service.RecordDisposition(&models.Disposition{
+		AlertID:     5,
+		Disposition: models.DispositionTruePositive,
+		Analyst:     "analyst-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), saved.ID)
+	txRepo.AssertNotCalled(t, "GetTransactionByProcessingID", mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestDispositionService_RecordDisposition_ByTransactionLinksAlert(t *testing.T) {
+	service, repo, txRepo, caseRepo := newTestDispositionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-1").Return(&models.TransactionStatus{ProcessingID: "proc-1"}, nil)
+	caseRepo.On("GetAlertByProcessingID", "proc-1").Return(&models.Alert{ID: 9, ProcessingID: "proc-1"}, nil)
+	repo.On("SaveDisposition", mock.MatchedBy(func(d *models.Disposition) bool {
+		return d.ProcessingID == "proc-1" && d.AlertID == 9
+	})).Return(nil)
+	repo.On("GetDisposition", "proc-1").Return(&models.Disposition{ID: 2, ProcessingID: "proc-1", AlertID: 9}, nil)
+
+	saved, err := service.RecordDisposition(&models.Disposition{
+		ProcessingID: "proc-1",
+		Disposition:  models.DispositionFalsePositive,
+		Analyst:      "analyst-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), saved.AlertID)
+	repo.AssertExpectations(t)
+}
+
+func TestDispositionService_RecordDisposition_Errors(t *testing.T) {
+	service, repo, txRepo, caseRepo := newTestDispositionService()
+
+	txRepo.On("GetTransactionByProcessingID", "proc-404").Return(nil, nil)
+	caseRepo.On("GetAlert", int64(5)).Return(&models.Alert{ID: 5, ProcessingID: "proc-1"}, nil)
+
+	_, err := service.RecordDisposition(&models.Disposition{ProcessingID: "proc-1", Disposition: "maybe", Analyst: "a"})
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	_, err = service.RecordDisposition(&models.Disposition{Disposition: models.DispositionInconclusive, Analyst: "a"})
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	_, err = service.RecordDisposition(&models.Disposition{ProcessingID: "proc-404", Disposition: models.DispositionInconclusive, Analyst: "a"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = service.RecordDisposition(&models.Disposition{AlertID: 5, ProcessingID: "proc-2", Disposition: models.DispositionInconclusive, Analyst: "a"})
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+
+	repo.AssertNotCalled(t, "SaveDisposition", mock.Anything)
+}
+
+func TestDispositionService_GetPrecisionReport(t *testing.T) {
+	service, repo, _, _ := newTestDispositionService()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	repo.On("GetFlagPrecision", from, to).Return([]*models.FlagPrecision{
+		{Flag: "fan_in", RulesetVersion: "2.0", Hits: 10, TruePositives: 3, FalsePositives: 1, Inconclusive: 2, Alerts: 4, SARs: 1},
+		{Flag: "round_amount", RulesetVersion: "2.0", Hits: 50},
+	}, nil)
+
+	report, err := service.GetPrecisionReport(from, to)
+	require.NoError(t, err)
+	require.Len(t, report.Flags, 2)
+
+	require.NotNil(t, report.Flags[0].Precision)
+	assert.InDelta(t, 0.75, *report.Flags[0].Precision, 1e-9)
+	require.NotNil(t, report.Flags[0].AlertToSAR)
+	assert.InDelta(t, 0.25, *report.Flags[0].AlertToSAR, 1e-9)
+
+	assert.Nil(t, report.Flags[1].Precision)
+	assert.Nil(t, report.Flags[1].AlertToSAR)
+}
+
+func TestDispositionService_GetPrecisionReport_InvalidRange(t *testing.T) {
+	service, repo, _, _ := newTestDispositionService()
+
+	now := time.Now()
+	_, err := service.GetPrecisionReport(now, now.Add(-time.Hour))
+	assert.True(t, errors.Is(err, ErrInvalidInput))
+	repo.AssertNotCalled(t, "GetFlagPrecision", mock.Anything, mock.Anything)
+}
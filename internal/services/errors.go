@@ -0,0 +1,23 @@
+package services
+
+import "errors"
+
+var (
+	// ErrNotFound возвращается, если запрошенная сущность не найдена
+	ErrNotFound = errors.New("not found")
+
+	// ErrInvalidInput возвращается при некорректных входных данных
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrConflict возвращается, если операция недопустима в текущем состоянии сущности
+	ErrConflict = errors.New("conflict")
+
+	// ErrForbidden возвращается, если у пользователя нет права на операцию
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrUnavailable возвращается, если необходимая для операции зависимость недоступна
+	ErrUnavailable = errors.New("unavailable")
+
+	// ErrExpired возвращается, если запрошенные данные уже удалены по сроку хранения
+	ErrExpired = errors.New("expired")
+)
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"fmt"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// FlowCycleServiceImpl реализует интерфейс FlowCycleService
+type FlowCycleServiceImpl struct {
+	repo storage.FlowCycleRepository
+}
+
+// NewFlowCycleService создает новый сервис круговых потоков
+func NewFlowCycleService(repo storage.FlowCycleRepository) FlowCycleService {
+	return &FlowCycleServiceImpl{repo: repo}
+}
+
+// ListFlowCycles возвращает последние обнаруженные циклы
+func (s *FlowCycleServiceImpl) ListFlowCycles(limit int) ([]*models.FlowCycle, error) {
+	return s.repo.ListFlowCycles(limit)
+}
+
+// ListAccountFlowCycles возвращает циклы, в которых участвовал счет
+func (s *FlowCycleServiceImpl) ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error) {
+	if accountNumber == "" {
+		return nil, fmt.Errorf("%w: account_number is required", ErrInvalidInput)
+	}
+	return s.repo.ListAccountFlowCycles(accountNumber, limit)
+}
@@ -0,0 +1,232 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"bank-aml-system/internal/kafka"
+	"bank-aml-system/internal/logger"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+// defaultHoldSLA - срок проверки приостановленной операции, если он не задан в конфигурации
+const defaultHoldSLA = 4 * time.Hour
+
+// expiredHoldsBatch - число таймеров, обрабатываемых за один проход планировщика
+const expiredHoldsBatch = 100
+
+// HoldPolicy задает срок проверки приостановленных операций и действие по его истечении
+type HoldPolicy struct {
+	SLA          time.Duration
+	ExpiryAction string // models.HoldExpiryRelease или models.HoldExpiryEscalate
+}
+
+// HoldServiceImpl реализует интерфейс HoldService
+type HoldServiceImpl struct {
+	repo         storage.HoldRepository
+	decisions    DecisionService
+	transactions storage.TransactionRepository
+	cases        storage.CaseRepository
+	producer     kafka.Producer // Опционально: без producer события не публикуются
+	policy       HoldPolicy
+}
+
+// NewHoldService создает новый сервис таймеров приостановки
+// Неизвестное действие по истечении срока заменяется эскалацией, чтобы операция не разрешилась без проверки
+func NewHoldService(
+	repo storage.HoldRepository,
+	decisions DecisionService,
+	transactions storage.TransactionRepository,
+	cases storage.CaseRepository,
+	producer kafka.Producer,
+	policy HoldPolicy,
+) HoldService {
+	if policy.SLA <= 0 {
+		policy.SLA = defaultHoldSLA
+	}
+	if policy.ExpiryAction != models.HoldExpiryRelease {
+		policy.ExpiryAction = models.HoldExpiryEscalate
+	}
+	return &HoldServiceImpl{
+		repo:         repo,
+		decisions:    decisions,
+		transactions: transactions,
+		cases:        cases,
+		producer:     producer,
+		policy:       policy,
+	}
+}
+
+// PlaceHold запускает срок проверки, если операция приостановлена автоматически по рекомендации
+// Операции, приостановленные аналитиком вручную, остаются на его контроле без таймера
+func (s *HoldServiceImpl) PlaceHold(processingID string, decision *models.TransactionDecision) (*models.TransactionHold, error) {
+	if decision == nil || decision.Decision != models.DecisionHeld || decision.Actor != models.DecisionActorSystem {
+		return nil, nil
+	}
+
+	now := time.Now()
+	hold := &models.TransactionHold{
+		ProcessingID: processingID,
+		ExpiryAction: s.policy.ExpiryAction,
+		Status:       models.HoldStatusActive,
+		ExpiresAt:    now.Add(s.policy.SLA),
+		CreatedAt:    now,
+	}
+	created, err := s.repo.CreateHold(hold)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, nil
+	}
+
+	s.publish(models.HoldEventPlaced, hold, 0)
+	return hold, nil
+}
+
+// ListHolds возвращает таймеры приостановки по фильтру
+func (s *HoldServiceImpl) ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error) {
+	switch filter.Status {
+	case "", models.HoldStatusActive, models.HoldStatusResolved, models.HoldStatusReleased, models.HoldStatusEscalated:
+	default:
+		return nil, fmt.Errorf("%w: unknown hold status %q", ErrInvalidInput, filter.Status)
+	}
+	return s.repo.ListHolds(filter)
+}
+
+// ProcessExpiredHolds обрабатывает таймеры, срок которых истек к now
+// Если аналитик уже изменил решение, таймер просто закрывается; ошибка по одной операции
+// не останавливает обработку остальных, таймер будет повторно обработан следующим проходом
+func (s *HoldServiceImpl) ProcessExpiredHolds(now time.Time) (int, error) {
+	holds, err := s.repo.ListExpiredHolds(now, expiredHoldsBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, hold := range holds {
+		if err := s.expire(hold); err != nil {
+			log.Printf("Error processing expired hold %d for %s: %v", hold.ID, hold.ProcessingID, err)
+			continue
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// expire применяет к истекшему таймеру действие по политике
+func (s *HoldServiceImpl) expire(hold *models.TransactionHold) error {
+	details, err := s.decisions.GetDecision(hold.ProcessingID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if details == nil || details.Decision.Decision != models.DecisionHeld {
+		hold.Status = models.HoldStatusResolved
+		_, err := s.repo.ResolveHold(hold)
+		return err
+	}
+
+	var caseID int64
+	switch hold.ExpiryAction {
+	case models.HoldExpiryRelease:
+		reason := fmt.Sprintf("hold SLA expired at %s without analyst decision", hold.ExpiresAt.UTC().Format(time.RFC3339))
+		if _, err := s.decisions.ApplySystemDecision(hold.ProcessingID, models.DecisionReleased, reason); err != nil {
+			if errors.Is(err, ErrConflict) {
+				// Решение изменили параллельно — таймер закроется следующим проходом
+				return nil
+			}
+			return err
+		}
+		hold.Status = models.HoldStatusReleased
+	default:
+		if caseID, err = s.escalateCase(hold); err != nil {
+			return err
+		}
+		hold.Status = models.HoldStatusEscalated
+	}
+
+	resolved, err := s.repo.ResolveHold(hold)
+	if err != nil || !resolved {
+		return err
+	}
+
+	eventType := models.HoldEventReleased
+	if hold.Status == models.HoldStatusEscalated {
+		eventType = models.HoldEventEscalated
+	}
+	s.publish(eventType, hold, caseID)
+	return nil
+}
+
+// escalateCase эскалирует кейс алерта по приостановленной операции и оставляет заметку для аналитиков
+// Возвращает 0, если алерт по операции не создавался или кейс уже закрыт
+func (s *HoldServiceImpl) escalateCase(hold *models.TransactionHold) (int64, error) {
+	alert, err := s.cases.GetAlertByProcessingID(hold.ProcessingID)
+	if err != nil || alert == nil {
+		return 0, err
+	}
+	c, err := s.cases.GetCase(alert.CaseID)
+	if err != nil || c == nil || c.Status == models.CaseStatusClosed {
+		return 0, err
+	}
+
+	text := fmt.Sprintf("hold SLA expired for transaction %s; transaction remains held", hold.ProcessingID)
+	if c.Status != models.CaseStatusEscalated {
+		// Эскалация по политике не проходит проверку переходов: кейс мог еще не взяться в работу
+		text = fmt.Sprintf("status: %s -> %s; %s", c.Status, models.CaseStatusEscalated, text)
+		c.Status = models.CaseStatusEscalated
+		if err := s.cases.UpdateCase(c); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.cases.AddCaseNote(&models.CaseNote{
+		CaseID: c.ID,
+		Author: models.DecisionActorSystem,
+		Text:   text,
+	}); err != nil {
+		return 0, err
+	}
+	return c.ID, nil
+}
+
+// publish отправляет событие о приостановке; ошибка отправки не отменяет сохраненный таймер
+func (s *HoldServiceImpl) publish(eventType string, hold *models.TransactionHold, caseID int64) {
+	if s.producer == nil {
+		return
+	}
+
+	transactionID := ""
+	if tx, err := s.transactions.GetTransactionByProcessingID(hold.ProcessingID); err == nil && tx != nil {
+		transactionID = tx.TransactionID
+	}
+
+	event := &models.KafkaHoldEvent{
+		EventID:   "evt_" + uuid.New().String(),
+		EventType: eventType,
+		Timestamp: time.Now(),
+		Data: models.KafkaHoldData{
+			ProcessingID:  hold.ProcessingID,
+			TransactionID: transactionID,
+			HoldID:        hold.ID,
+			Status:        hold.Status,
+			ExpiryAction:  hold.ExpiryAction,
+			ExpiresAt:     hold.ExpiresAt,
+			CaseID:        caseID,
+		},
+	}
+	if err := s.producer.SendHoldEvent(event); err != nil {
+		log.Printf("Error publishing hold event for %s: %v", hold.ProcessingID, err)
+		return
+	}
+
+	logger.LogEvent(logger.EventKafkaSent, "hold-service", "kafka", map[string]interface{}{
+		"processing_id": hold.ProcessingID,
+		"event_id":      event.EventID,
+		"event_type":    eventType,
+	})
+}
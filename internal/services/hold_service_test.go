@@ -0,0 +1,145 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	kafkamocks "bank-aml-system/internal/kafka/mocks"
+	"bank-aml-system/internal/models"
+	servicemocks "bank-aml-system/internal/services/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type holdTestDeps struct {
+	repo      *storagemocks.MockHoldRepository
+	decisions *servicemocks.MockDecisionService
+	txRepo    *storagemocks.MockTransactionRepository
+	cases     *storagemocks.MockCaseRepository
+	producer  *kafkamocks.MockProducer
+}
+
+func newTestHoldService(action string) (HoldService, *holdTestDeps) {
+	d := &holdTestDeps{
+		repo:      new(storagemocks.MockHoldRepository),
+		decisions: new(servicemocks.MockDecisionService),
+		txRepo:    new(storagemocks.MockTransactionRepository),
+		cases:     new(storagemocks.MockCaseRepository),
+		producer:  new(kafkamocks.MockProducer),
+	}
+	d.txRepo.On("GetTransactionByProcessingID", "proc-1").Return(decisionTestTx, nil).Maybe()
+	service := NewHoldService(d.repo, d.decisions, d.txRepo, d.cases, d.producer, HoldPolicy{SLA: time.Hour, ExpiryAction: action})
+	return service, d
+}
+
+func heldDecision(actor string) *models.DecisionDetails {
+	return &models.DecisionDetails{Decision: models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld, Actor: actor}}
+}
+
+func TestHoldService_PlaceHold_StartsTimerForSystemHold(t *testing.T) {
+	service, d := newTestHoldService(models.HoldExpiryRelease)
+
+	d.repo.On("CreateHold", mock.MatchedBy(func(h *models.TransactionHold) bool {
+		return h.ProcessingID == "proc-1" && h.ExpiryAction == models.HoldExpiryRelease &&
+			h.ExpiresAt.Sub(h.CreatedAt) == time.Hour
+	})).Return(true, nil)
+	d.producer.On("SendHoldEvent", mock.MatchedBy(func(e *models.KafkaHoldEvent) bool {
+		return e.EventType == models.HoldEventPlaced && e.Data.TransactionID == "TXN-1"
+	})).Return(nil)
+
+	hold, err := service.PlaceHold("proc-1", &heldDecision(models.DecisionActorSystem).Decision)
+	require.NoError(t, err)
+	require.NotNil(t, hold)
+	assert.Equal(t, models.HoldStatusActive, hold.Status)
+	d.producer.AssertExpectations(t)
+}
+
+func TestHoldService_PlaceHold_IgnoresManualAndRepeatedHolds(t *testing.T) {
+	service, d := newTestHoldService(models.HoldExpiryRelease)
+
+	hold, err := service.PlaceHold("proc-1", &heldDecision("analyst-1").Decision)
+	require.NoError(t, err)
+	assert.Nil(t, hold)
+
+	d.repo.On("CreateHold", mock.Anything).Return(false, nil)
+	hold, err = service.PlaceHold("proc-1", &heldDecision(models.DecisionActorSystem).Decision)
+	require.NoError(t, err)
+	assert.Nil(t, hold)
+	d.producer.AssertNotCalled(t, "SendHoldEvent", mock.Anything)
+}
+
+func TestHoldService_ProcessExpiredHolds_Releases(t *testing.T) {
+	service, d := newTestHoldService(models.HoldExpiryRelease)
+
+	now := time.Now()
+	hold := &models.TransactionHold{ID: 7, ProcessingID: "proc-1", ExpiryAction: models.HoldExpiryRelease, Status: models.HoldStatusActive, ExpiresAt: now.Add(-time.Minute)}
+	d.repo.On("ListExpiredHolds", now, expiredHoldsBatch).Return([]*models.TransactionHold{hold}, nil)
+	d.decisions.On("GetDecision", "proc-1").Return(heldDecision(models.DecisionActorSystem), nil)
+	d.decisions.On("ApplySystemDecision", "proc-1", models.DecisionReleased, mock.Anything).
+		Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionReleased}, nil)
+	d.repo.On("ResolveHold", mock.MatchedBy(func(h *models.TransactionHold) bool {
+		return h.ID == 7 && h.Status == models.HoldStatusReleased
+	})).Return(true, nil)
+	d.producer.On("SendHoldEvent", mock.MatchedBy(func(e *models.KafkaHoldEvent) bool {
+		return e.EventType == models.HoldEventReleased && e.Data.HoldID == 7
+	})).Return(nil)
+
+	processed, err := service.ProcessExpiredHolds(now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	d.decisions.AssertExpectations(t)
+	d.producer.AssertExpectations(t)
+}
+
+func TestHoldService_ProcessExpiredHolds_EscalatesCase(t *testing.T) {
+	service, d := newTestHoldService(models.HoldExpiryEscalate)
+
+	now := time.Now()
+	hold := &models.TransactionHold{ID: 7, ProcessingID: "proc-1", ExpiryAction: models.HoldExpiryEscalate, Status: models.HoldStatusActive}
+	d.repo.On("ListExpiredHolds", now, expiredHoldsBatch).Return([]*models.TransactionHold{hold}, nil)
+	d.decisions.On("GetDecision", "proc-1").Return(heldDecision(models.DecisionActorSystem), nil)
+	d.cases.On("GetAlertByProcessingID", "proc-1").Return(&models.Alert{ID: 3, CaseID: 11}, nil)
+	d.cases.On("GetCase", int64(11)).Return(&models.Case{ID: 11, Status: models.CaseStatusOpen}, nil)
+	d.cases.On("UpdateCase", mock.MatchedBy(func(c *models.Case) bool {
+		return c.Status == models.CaseStatusEscalated
+	})).Return(nil)
+	d.cases.On("AddCaseNote", mock.MatchedBy(func(n *models.CaseNote) bool {
+		return n.CaseID == 11 && n.Author == models.DecisionActorSystem
+	})).Return(nil)
+	d.repo.On("ResolveHold", mock.MatchedBy(func(h *models.TransactionHold) bool {
+		return h.Status == models.HoldStatusEscalated
+	})).Return(true, nil)
+	d.producer.On("SendHoldEvent", mock.MatchedBy(func(e *models.KafkaHoldEvent) bool {
+		return e.EventType == models.HoldEventEscalated && e.Data.CaseID == 11
+	})).Return(nil)
+
+	processed, err := service.ProcessExpiredHolds(now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	d.decisions.AssertNotCalled(t, "ApplySystemDecision", mock.Anything, mock.Anything, mock.Anything)
+	d.cases.AssertExpectations(t)
+	d.producer.AssertExpectations(t)
+}
+
+func TestHoldService_ProcessExpiredHolds_ClosesTimerAfterAnalystDecision(t *testing.T) {
+	service, d := newTestHoldService(models.HoldExpiryRelease)
+
+	now := time.Now()
+	hold := &models.TransactionHold{ID: 7, ProcessingID: "proc-1", ExpiryAction: models.HoldExpiryRelease, Status: models.HoldStatusActive}
+	d.repo.On("ListExpiredHolds", now, expiredHoldsBatch).Return([]*models.TransactionHold{hold}, nil)
+	d.decisions.On("GetDecision", "proc-1").Return(&models.DecisionDetails{
+		Decision: models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionBlocked, Actor: "analyst-1"},
+	}, nil)
+	d.repo.On("ResolveHold", mock.MatchedBy(func(h *models.TransactionHold) bool {
+		return h.Status == models.HoldStatusResolved
+	})).Return(true, nil)
+
+	processed, err := service.ProcessExpiredHolds(now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	d.decisions.AssertNotCalled(t, "ApplySystemDecision", mock.Anything, mock.Anything, mock.Anything)
+	d.producer.AssertNotCalled(t, "SendHoldEvent", mock.Anything)
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"bank-aml-system/internal/models"
 )
 
@@ -8,12 +11,21 @@ import (
 type TransactionService interface {
 	// ProcessTransaction обрабатывает транзакцию
 	ProcessTransaction(req *models.ProcessingRequest) (*models.ProcessingResponse, error)
+
+	// ValidateTransaction проверяет входящую транзакцию; ошибка проверки содержит ошибки по полям (validation.FieldErrors)
+	ValidateTransaction(tx *models.Transaction) error
+
+	// ProcessTransactionBatch сохраняет и отправляет на анализ пакет записей с результатом по каждой записи
+	ProcessTransactionBatch(records []*models.BatchRecord) (*models.BatchIngestionResult, error)
 	
 	// GetTransactionStatus возвращает статус транзакции
 	GetTransactionStatus(processingID string) (*models.TransactionStatusResponse, error)
 	
 	// GetAllTransactions возвращает все транзакции
 	GetAllTransactions(limit int) ([]*models.TransactionStatusResponse, error)
+
+	// SearchTransactions возвращает страницу транзакций по фильтру с курсором следующей страницы
+	SearchTransactions(req *models.TransactionSearchRequest) (*models.TransactionPage, error)
 	
 	// ClearAllTransactions очищает все транзакции
 	ClearAllTransactions() error
@@ -23,5 +35,205 @@ type TransactionService interface {
 type RiskAnalyzer interface {
 	// AnalyzeTransaction выполняет полный анализ транзакции на предмет рисков
 	AnalyzeTransaction(tx *models.Transaction) (*models.RiskAnalysis, error)
+
+	// RescoreTransaction повторно оценивает уже учтенную транзакцию без изменения профилей счета
+	// inputs - состояние профилей, сохраненное при анализе; nil - используется текущее состояние
+	RescoreTransaction(tx *models.Transaction, inputs *models.RuleInputs) (*models.RiskAnalysis, error)
+}
+
+
+// AccountService определяет интерфейс для работы с реестром клиентов и счетов
+type AccountService interface {
+	// SaveCustomer регистрирует или обновляет клиента
+	SaveCustomer(customer *models.Customer) (*models.Customer, error)
+
+	// GetCustomer возвращает клиента по customer_id
+	GetCustomer(customerID string) (*models.Customer, error)
+
+	// ListCustomers возвращает список клиентов
+	ListCustomers(limit int) ([]*models.Customer, error)
+
+	// SaveAccount регистрирует или обновляет счет
+	SaveAccount(account *models.Account) (*models.Account, error)
+
+	// GetAccount возвращает счет вместе с данными владельца
+	GetAccount(accountNumber string) (*models.AccountProfile, error)
+
+	// ListAccounts возвращает счета клиента
+	ListAccounts(customerID string) ([]*models.Account, error)
+}
+
+// FlowCycleService определяет интерфейс для просмотра обнаруженных круговых потоков
+type FlowCycleService interface {
+	// ListFlowCycles возвращает последние обнаруженные циклы
+	ListFlowCycles(limit int) ([]*models.FlowCycle, error)
+
+	// ListAccountFlowCycles возвращает циклы, в которых участвовал счет
+	ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error)
+}
+
+// CaseService определяет интерфейс для работы аналитиков с алертами и кейсами
+type CaseService interface {
+	// RaiseAlert создает алерт по результату анализа и привязывает его к кейсу счета
+	// Возвращает nil, если анализ не требует внимания аналитика
+	RaiseAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis) (*models.Alert, error)
+
+	// RaiseRetroAlert отражает совпадение, найденное ретроспективной проверкой, даже если по операции уже был алерт:
+	// существующий алерт получает новые флаги, а его закрытый кейс открывается повторно; note добавляется к кейсу
+	RaiseRetroAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis, note *models.CaseNote) (*models.Alert, error)
+
+	// ListAlerts возвращает алерты по фильтру
+	ListAlerts(filter models.AlertFilter) ([]*models.Alert, error)
+
+	// ListCases возвращает кейсы по фильтру
+	ListCases(filter models.CaseFilter) ([]*models.Case, error)
+
+	// GetCase возвращает кейс вместе с алертами, заметками и вложениями
+	GetCase(id int64) (*models.CaseDetails, error)
+
+	// UpdateCase меняет статус, исполнителя или решение по кейсу
+	UpdateCase(id int64, update *models.CaseUpdate) (*models.Case, error)
+
+	// AddNote добавляет заметку к кейсу
+	AddNote(caseID int64, note *models.CaseNote) (*models.CaseNote, error)
+
+	// AddAttachment добавляет ссылку на документ к кейсу
+	AddAttachment(caseID int64, attachment *models.CaseAttachment) (*models.CaseAttachment, error)
 }
 
+// DispositionService определяет интерфейс для обратной связи аналитиков по сработавшим правилам
+type DispositionService interface {
+	// RecordFlags сохраняет флаги анализа транзакции для последующей оценки точности правил
+	RecordFlags(processingID string, analysis *models.RiskAnalysis) error
+
+	// RecordDisposition сохраняет заключение аналитика по транзакции или алерту
+	RecordDisposition(d *models.Disposition) (*models.Disposition, error)
+
+	// GetDisposition возвращает заключение аналитика по транзакции
+	GetDisposition(processingID string) (*models.Disposition, error)
+
+	// GetPrecisionReport возвращает точность флагов и конверсию алертов в SAR за период [from, to)
+	GetPrecisionReport(from, to time.Time) (*models.PrecisionReport, error)
+}
+
+// DecisionService определяет интерфейс для управления решениями по транзакциям
+type DecisionService interface {
+	// GetDecision возвращает текущее решение по транзакции вместе с историей
+	GetDecision(processingID string) (*models.DecisionDetails, error)
+
+	// ChangeDecision меняет решение по транзакции с проверкой допустимости перехода
+	// Блокировка и разблокировка ожидают подтверждения вторым сотрудником (PendingApproval в ответе)
+	// Инициатором считается initiator; actor запроса, не совпадающий с ним, отклоняется
+	ChangeDecision(processingID string, req *models.DecisionRequest, initiator models.Identity) (*models.TransactionDecision, error)
+
+	// ListApprovals возвращает запросы на подтверждение решений
+	ListApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error)
+
+	// ApproveDecision подтверждает запрос вторым сотрудником и применяет решение
+	ApproveDecision(approvalID int64, approver models.Identity) (*models.TransactionDecision, error)
+
+	// RejectDecision отклоняет запрос на подтверждение
+	RejectDecision(approvalID int64, actor models.Identity, comment string) (*models.DecisionApproval, error)
+
+	// ApplyRecommendation принимает автоматическое решение по рекомендации анализатора
+	// Действует только для транзакций, по которым решение еще не принималось
+	ApplyRecommendation(processingID string, analysis *models.RiskAnalysis) (*models.TransactionDecision, error)
+
+	// ApplySystemDecision меняет решение от имени системы без подтверждения вторым сотрудником
+	// Используется для действий по утвержденной политике, например автоматической разблокировки по таймеру
+	ApplySystemDecision(processingID, decision, reason string) (*models.TransactionDecision, error)
+}
+
+// HoldService определяет интерфейс таймеров проверки приостановленных операций
+type HoldService interface {
+	// PlaceHold запускает срок проверки для операции, приостановленной по рекомендации анализатора
+	// Возвращает nil, если операция не приостановлена или таймер уже был запущен
+	PlaceHold(processingID string, decision *models.TransactionDecision) (*models.TransactionHold, error)
+
+	// ListHolds возвращает таймеры приостановки по фильтру
+	ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error)
+
+	// ProcessExpiredHolds разрешает или эскалирует операции, по которым аналитик не принял решение в срок
+	// Возвращает число обработанных таймеров
+	ProcessExpiredHolds(now time.Time) (int, error)
+}
+
+// SARReportService определяет интерфейс для формирования и учета сообщений goAML
+type SARReportService interface {
+	// GenerateReport формирует сообщение по операциям кейса и проверяет его (предварительно и по схеме регулятора, если она задана)
+	GenerateReport(caseID int64, req *models.SARReportRequest) (*models.SARReport, error)
+
+	// GetReport возвращает сообщение вместе с XML
+	GetReport(id int64) (*models.SARReport, error)
+
+	// ListReports возвращает сообщения по фильтру
+	ListReports(filter models.SARReportFilter) ([]*models.SARReport, error)
+
+	// UpdateReport меняет статус сообщения после подачи регулятору
+	UpdateReport(id int64, update *models.SARReportUpdate) (*models.SARReport, error)
+}
+
+// MandatoryReportService определяет интерфейс обязательного контроля операций с наличными
+type MandatoryReportService interface {
+	// ScreenTransaction проверяет операцию правилами обязательного контроля и ставит ее в очередь
+	// Проверка не зависит от оценки риска
+	ScreenTransaction(processingID string, tx *models.Transaction) ([]*models.MandatoryReport, error)
+
+	// ListReports возвращает записи очереди по фильтру
+	ListReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error)
+
+	// ExportDaily формирует выгрузку очереди за день в формате csv или xml
+	ExportDaily(businessDate, format string) (*models.MandatoryReportExport, error)
+}
+
+// BlacklistService определяет интерфейс черного и санкционного списков с ретроспективной проверкой операций
+type BlacklistService interface {
+	// AddEntry добавляет запись в список и проверяет прошлые операции по счету и имени
+	// В ответе возвращается запись с найденными совпадениями
+	AddEntry(req *models.BlacklistEntryRequest) (*models.BlacklistEntry, error)
+
+	// GetEntry возвращает запись вместе с результатами ретроспективной проверки
+	GetEntry(id int64) (*models.BlacklistEntry, error)
+
+	// ListEntries возвращает записи списков по фильтру
+	ListEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error)
+}
+
+// AnalysisService определяет интерфейс для истории версий анализа и повторной оценки операций
+type AnalysisService interface {
+	// RecordAnalysis сохраняет результат анализа при поступлении операции очередной версией
+	RecordAnalysis(processingID string, analysis *models.RiskAnalysis) (*models.AnalysisVersion, error)
+
+	// Rescore повторно оценивает операцию текущим набором правил и сохраняет новую версию анализа
+	Rescore(processingID string, req *models.RescoreRequest) (*models.RescoreResult, error)
+
+	// RescoreBatch повторно оценивает проанализированные операции, отобранные фильтром
+	RescoreBatch(req *models.BatchRescoreRequest) (*models.BatchRescoreResult, error)
+
+	// ListVersions возвращает все версии анализа операции
+	ListVersions(processingID string) ([]*models.AnalysisVersion, error)
+}
+
+// TransactionDetailService определяет интерфейс для полных данных об операции для расследования
+type TransactionDetailService interface {
+	// GetTransactionDetail возвращает сохраненную операцию, ее анализы, решения и хронологию событий
+	GetTransactionDetail(processingID string) (*models.TransactionDetail, error)
+}
+
+// AccountActivityService определяет интерфейс для просмотра всей активности счета
+type AccountActivityService interface {
+	// GetAccountActivity возвращает операции счета как отправителя и как контрагента, итоги, счетчики и записи списков
+	GetAccountActivity(req *models.AccountActivityRequest) (*models.AccountActivity, error)
+}
+
+// TransactionWatchService определяет интерфейс подписки на изменения транзакций
+type TransactionWatchService interface {
+	// OpenWatch проверяет условия и resume token и возвращает позицию ленты для Watch
+	OpenWatch(req *models.TransactionWatchRequest) (*models.TransactionUpdateFilter, error)
+
+	// Watch передает в send события ленты, пока не отменен ctx или send не вернет ошибку
+	Watch(ctx context.Context, filter models.TransactionUpdateFilter, send func(*models.TransactionUpdate) error) error
+
+	// PruneUpdates удаляет события ленты старше срока хранения и возвращает их число
+	PruneUpdates(now time.Time) (int64, error)
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/reporting"
+	"bank-aml-system/internal/storage"
+)
+
+// exportAllRows снимает ограничение LIMIT при выборке очереди для выгрузки
+const exportAllRows = -1
+
+// MandatoryReportServiceImpl реализует интерфейс MandatoryReportService
+type MandatoryReportServiceImpl struct {
+	repo          storage.MandatoryReportRepository
+	accounts      storage.AccountRepository
+	rules         *reporting.Rules
+	currencyLocal string
+}
+
+// NewMandatoryReportService создает новый сервис обязательного контроля операций
+func NewMandatoryReportService(
+	repo storage.MandatoryReportRepository,
+	accounts storage.AccountRepository,
+	rules *reporting.Rules,
+	currencyLocal string,
+) MandatoryReportService {
+	return &MandatoryReportServiceImpl{repo: repo, accounts: accounts, rules: rules, currencyLocal: currencyLocal}
+}
+
+// ScreenTransaction проверяет операцию правилами обязательного контроля
+// Операции с наличными клиента за день суммируются по всем его счетам из реестра;
+// если счет не зарегистрирован, суммируются операции только этого счета
+func (s *MandatoryReportServiceImpl) ScreenTransaction(processingID string, tx *models.Transaction) ([]*models.MandatoryReport, error) {
+	if !s.rules.Enabled() || !s.rules.IsCash(tx) {
+		return nil, nil
+	}
+
+	current := &models.TransactionRecord{ProcessingID: processingID, Transaction: *tx}
+	if current.Transaction.Timestamp.IsZero() {
+		current.Transaction.Timestamp = time.Now()
+	}
+
+	customerID := ""
+	accountNumbers := []string{tx.AccountNumber}
+	account, err := s.accounts.GetAccount(tx.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	if account != nil {
+		customerID = account.CustomerID
+	}
+
+	var sameDay []*models.TransactionRecord
+	if s.rules.AggregateDaily() {
+		if customerID != "" {
+			accounts, err := s.accounts.ListAccounts(customerID)
+			if err != nil {
+				return nil, err
+			}
+			accountNumbers = accountNumbers[:0]
+			for _, a := range accounts {
+				accountNumbers = append(accountNumbers, a.AccountNumber)
+			}
+			if len(accountNumbers) == 0 {
+				accountNumbers = []string{tx.AccountNumber}
+			}
+		}
+
+		from, to := reporting.DayBounds(current.Transaction.Timestamp)
+		records, err := s.repo.ListTransactionsByAccounts(accountNumbers, s.rules.CashTypes(), from, to)
+		if err != nil {
+			return nil, err
+		}
+		sameDay = withRecord(records, current)
+	}
+
+	reports := s.rules.Evaluate(current, customerID, sameDay)
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	for _, r := range reports {
+		if r.Rule == reporting.RuleCashUnconverted {
+			log.Printf("WARNING: no exchange rate for %s: cash transaction %s queued as %s without amount_local; set GOAML_EXCHANGE_RATES",
+				r.Currency, r.ProcessingID, r.Rule)
+		}
+	}
+	if _, err := s.repo.EnqueueMandatoryReports(reports); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// ListReports возвращает записи очереди обязательного контроля
+func (s *MandatoryReportServiceImpl) ListReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error) {
+	if filter.BusinessDate != "" {
+		if _, err := time.Parse(reporting.BusinessDateFormat, filter.BusinessDate); err != nil {
+			return nil, fmt.Errorf("%w: invalid date %q: expected YYYY-MM-DD", ErrInvalidInput, filter.BusinessDate)
+		}
+	}
+	if filter.Status != "" && filter.Status != models.MandatoryReportQueued && filter.Status != models.MandatoryReportExported {
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidInput, filter.Status)
+	}
+	return s.repo.ListMandatoryReports(filter)
+}
+
+// ExportDaily формирует выгрузку всех операций за день и отмечает ожидавшие записи как выгруженные
+// Повторная выгрузка за тот же день включает и ранее выгруженные записи
+func (s *MandatoryReportServiceImpl) ExportDaily(businessDate, format string) (*models.MandatoryReportExport, error) {
+	if _, err := time.Parse(reporting.BusinessDateFormat, businessDate); err != nil {
+		return nil, fmt.Errorf("%w: invalid date %q: expected YYYY-MM-DD", ErrInvalidInput, businessDate)
+	}
+	if format != reporting.FormatCSV && format != reporting.FormatXML {
+		return nil, fmt.Errorf("%w: unsupported format %q: expected csv or xml", ErrInvalidInput, format)
+	}
+
+	reports, err := s.repo.ListMandatoryReports(models.MandatoryReportFilter{BusinessDate: businessDate, Limit: exportAllRows})
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := reporting.Export(reports, businessDate, s.currencyLocal, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var queued []int64
+	for _, r := range reports {
+		if r.Status == models.MandatoryReportQueued {
+			queued = append(queued, r.ID)
+		}
+	}
+	if err := s.repo.MarkMandatoryReportsExported(queued, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// withRecord добавляет текущую операцию в выборку, если она еще не сохранена в хранилище
+func withRecord(records []*models.TransactionRecord, current *models.TransactionRecord) []*models.TransactionRecord {
+	for _, r := range records {
+		if r.ProcessingID == current.ProcessingID {
+			return records
+		}
+	}
+	return append(records, current)
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/reporting"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMandatoryReportService() (MandatoryReportService, *storagemocks.MockMandatoryReportRepository, *storagemocks.MockAccountRepository) {
+	repo := new(storagemocks.MockMandatoryReportRepository)
+	accountRepo := new(storagemocks.MockAccountRepository)
+	rules := reporting.NewRules(config.ReportingConfig{
+		Enabled:        true,
+		CashThreshold:  1000000,
+		CashTypes:      []string{"deposit", "withdrawal"},
+		AggregateDaily: true,
+	}, "RUB", nil)
+	return NewMandatoryReportService(repo, accountRepo, rules, "RUB"), repo, accountRepo
+}
+
+func cashTransaction(id string, amount float64) *models.Transaction {
+	return &models.Transaction{
+		TransactionID:   id,
+		AccountNumber:   "A1",
+		Amount:          amount,
+		Currency:        "RUB",
+		TransactionType: "deposit",
+		Timestamp:       time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestMandatoryReportService_ScreenTransaction_AggregatesCustomerAccounts(t *testing.T) {
+	service, repo, accountRepo := newTestMandatoryReportService()
+
+	accountRepo.On("GetAccount", "A1").Return(&models.Account{AccountNumber: "A1", CustomerID: "C-1"}, nil)
+	accountRepo.On("ListAccounts", "C-1").Return([]*models.Account{{AccountNumber: "A1"}, {AccountNumber: "A2"}}, nil)
+
+	earlier := cashTransaction("T1", 700000)
+	earlier.AccountNumber = "A2"
+	from := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	repo.On("ListTransactionsByAccounts", []string{"A1", "A2"}, []string{"deposit", "withdrawal"}, from, from.Add(24*time.Hour)).
+		Return([]*models.TransactionRecord{{ProcessingID: "proc-T1", Transaction: *earlier}}, nil)
+	repo.On("EnqueueMandatoryReports", mock.MatchedBy(func(reports []*models.MandatoryReport) bool {
+		return len(reports) == 2 && reports[0].Rule == reporting.RuleCashDailyAggregate &&
+			reports[0].ProcessingID == "proc-T1" && reports[1].ProcessingID == "proc-T2" &&
+			reports[1].CustomerID == "C-1" && reports[1].DailyTotalLocal == 1000000
+	})).Return(2, nil)
+
+	reports, err := service.ScreenTransaction("proc-T2", cashTransaction("T2", 300000))
+	require.NoError(t, err)
+	assert.Len(t, reports, 2)
+	repo.AssertExpectations(t)
+}
+
+func TestMandatoryReportService_ScreenTransaction_NonCashSkipped(t *testing.T) {
+	service, repo, accountRepo := newTestMandatoryReportService()
+
+	tx := cashTransaction("T1", 5000000)
+	tx.TransactionType = "transfer"
+	reports, err := service.ScreenTransaction("proc-T1", tx)
+
+	require.NoError(t, err)
+	assert.Nil(t, reports)
+	accountRepo.AssertNotCalled(t, "GetAccount", mock.Anything)
+	repo.AssertNotCalled(t, "EnqueueMandatoryReports", mock.Anything)
+}
+
+func TestMandatoryReportService_ExportDaily(t *testing.T) {
+	service, repo, _ := newTestMandatoryReportService()
+
+	exportedAt := time.Date(2024, 1, 16, 1, 0, 0, 0, time.UTC)
+	repo.On("ListMandatoryReports", models.MandatoryReportFilter{BusinessDate: "2024-01-15", Limit: exportAllRows}).Return([]*models.MandatoryReport{
+		{ID: 1, Rule: reporting.RuleCashThreshold, ProcessingID: "proc-1", BusinessDate: "2024-01-15", Status: models.MandatoryReportQueued},
+		{ID: 2, Rule: reporting.RuleCashThreshold, ProcessingID: "proc-2", BusinessDate: "2024-01-15", Status: models.MandatoryReportExported, ExportedAt: &exportedAt},
+	}, nil)
+	repo.On("MarkMandatoryReportsExported", []int64{1}, mock.Anything).Return(nil)
+
+	export, err := service.ExportDaily("2024-01-15", reporting.FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, 2, export.Count)
+	assert.Contains(t, string(export.Content), "proc-2")
+	repo.AssertExpectations(t)
+}
+
+func TestMandatoryReportService_ExportDaily_InvalidInput(t *testing.T) {
+	service, repo, _ := newTestMandatoryReportService()
+
+	_, err := service.ExportDaily("15.01.2024", reporting.FormatCSV)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, err = service.ExportDaily("2024-01-15", "pdf")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	repo.AssertNotCalled(t, "ListMandatoryReports", mock.Anything)
+}
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountActivityService является моком для services.AccountActivityService интерфейса
+type MockAccountActivityService struct {
+	mock.Mock
+}
+
+// GetAccountActivity мок для GetAccountActivity
+func (m *MockAccountActivityService) GetAccountActivity(req *models.AccountActivityRequest) (*models.AccountActivity, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountActivity), args.Error(1)
+}
@@ -0,0 +1,66 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountService является моком для services.AccountService интерфейса
+type MockAccountService struct {
+	mock.Mock
+}
+
+// SaveCustomer мок для SaveCustomer
+func (m *MockAccountService) SaveCustomer(customer *models.Customer) (*models.Customer, error) {
+	args := m.Called(customer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+// GetCustomer мок для GetCustomer
+func (m *MockAccountService) GetCustomer(customerID string) (*models.Customer, error) {
+	args := m.Called(customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+// ListCustomers мок для ListCustomers
+func (m *MockAccountService) ListCustomers(limit int) ([]*models.Customer, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Customer), args.Error(1)
+}
+
+// SaveAccount мок для SaveAccount
+func (m *MockAccountService) SaveAccount(account *models.Account) (*models.Account, error) {
+	args := m.Called(account)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+// GetAccount мок для GetAccount
+func (m *MockAccountService) GetAccount(accountNumber string) (*models.AccountProfile, error) {
+	args := m.Called(accountNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountProfile), args.Error(1)
+}
+
+// ListAccounts мок для ListAccounts
+func (m *MockAccountService) ListAccounts(customerID string) ([]*models.Account, error) {
+	args := m.Called(customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Account), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAnalysisService является моком для services.AnalysisService интерфейса
+type MockAnalysisService struct {
+	mock.Mock
+}
+
+// RecordAnalysis мок для RecordAnalysis
+func (m *MockAnalysisService) RecordAnalysis(processingID string, analysis *models.RiskAnalysis) (*models.AnalysisVersion, error) {
+	args := m.Called(processingID, analysis)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AnalysisVersion), args.Error(1)
+}
+
+// Rescore мок для Rescore
+func (m *MockAnalysisService) Rescore(processingID string, req *models.RescoreRequest) (*models.RescoreResult, error) {
+	args := m.Called(processingID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RescoreResult), args.Error(1)
+}
+
+// RescoreBatch мок для RescoreBatch
+func (m *MockAnalysisService) RescoreBatch(req *models.BatchRescoreRequest) (*models.BatchRescoreResult, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BatchRescoreResult), args.Error(1)
+}
+
+// ListVersions мок для ListVersions
+func (m *MockAnalysisService) ListVersions(processingID string) ([]*models.AnalysisVersion, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.AnalysisVersion), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBlacklistService является моком для services.BlacklistService интерфейса
+type MockBlacklistService struct {
+	mock.Mock
+}
+
+// AddEntry мок для AddEntry
+func (m *MockBlacklistService) AddEntry(req *models.BlacklistEntryRequest) (*models.BlacklistEntry, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BlacklistEntry), args.Error(1)
+}
+
+// GetEntry мок для GetEntry
+func (m *MockBlacklistService) GetEntry(id int64) (*models.BlacklistEntry, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BlacklistEntry), args.Error(1)
+}
+
+// ListEntries мок для ListEntries
+func (m *MockBlacklistService) ListEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.BlacklistEntry), args.Error(1)
+}
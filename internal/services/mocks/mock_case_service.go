@@ -0,0 +1,84 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCaseService является моком для services.CaseService интерфейса
+type MockCaseService struct {
+	mock.Mock
+}
+
+// RaiseAlert мок для RaiseAlert
+func (m *MockCaseService) RaiseAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis) (*models.Alert, error) {
+	args := m.Called(processingID, tx, analysis)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Alert), args.Error(1)
+}
+
+// RaiseRetroAlert мок для RaiseRetroAlert
+func (m *MockCaseService) RaiseRetroAlert(processingID string, tx *models.Transaction, analysis *models.RiskAnalysis, note *models.CaseNote) (*models.Alert, error) {
+	args := m.Called(processingID, tx, analysis, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Alert), args.Error(1)
+}
+
+// ListAlerts мок для ListAlerts
+func (m *MockCaseService) ListAlerts(filter models.AlertFilter) ([]*models.Alert, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Alert), args.Error(1)
+}
+
+// ListCases мок для ListCases
+func (m *MockCaseService) ListCases(filter models.CaseFilter) ([]*models.Case, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Case), args.Error(1)
+}
+
+// GetCase мок для GetCase
+func (m *MockCaseService) GetCase(id int64) (*models.CaseDetails, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CaseDetails), args.Error(1)
+}
+
+// UpdateCase мок для UpdateCase
+func (m *MockCaseService) UpdateCase(id int64, update *models.CaseUpdate) (*models.Case, error) {
+	args := m.Called(id, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Case), args.Error(1)
+}
+
+// AddNote мок для AddNote
+func (m *MockCaseService) AddNote(caseID int64, note *models.CaseNote) (*models.CaseNote, error) {
+	args := m.Called(caseID, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CaseNote), args.Error(1)
+}
+
+// AddAttachment мок для AddAttachment
+func (m *MockCaseService) AddAttachment(caseID int64, attachment *models.CaseAttachment) (*models.CaseAttachment, error) {
+	args := m.Called(caseID, attachment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CaseAttachment), args.Error(1)
+}
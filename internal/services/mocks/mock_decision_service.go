@@ -0,0 +1,75 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDecisionService является моком для services.DecisionService интерфейса
+type MockDecisionService struct {
+	mock.Mock
+}
+
+// GetDecision мок для GetDecision
+func (m *MockDecisionService) GetDecision(processingID string) (*models.DecisionDetails, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DecisionDetails), args.Error(1)
+}
+
+// ChangeDecision мок для ChangeDecision
+func (m *MockDecisionService) ChangeDecision(processingID string, req *models.DecisionRequest, initiator models.Identity) (*models.TransactionDecision, error) {
+	args := m.Called(processingID, req, initiator)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDecision), args.Error(1)
+}
+
+// ApplyRecommendation мок для ApplyRecommendation
+func (m *MockDecisionService) ApplyRecommendation(processingID string, analysis *models.RiskAnalysis) (*models.TransactionDecision, error) {
+	args := m.Called(processingID, analysis)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDecision), args.Error(1)
+}
+
+// ApplySystemDecision мок для ApplySystemDecision
+func (m *MockDecisionService) ApplySystemDecision(processingID, decision, reason string) (*models.TransactionDecision, error) {
+	args := m.Called(processingID, decision, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDecision), args.Error(1)
+}
+
+// ListApprovals мок для ListApprovals
+func (m *MockDecisionService) ListApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.DecisionApproval), args.Error(1)
+}
+
+// ApproveDecision мок для ApproveDecision
+func (m *MockDecisionService) ApproveDecision(approvalID int64, approver models.Identity) (*models.TransactionDecision, error) {
+	args := m.Called(approvalID, approver)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDecision), args.Error(1)
+}
+
+// RejectDecision мок для RejectDecision
+func (m *MockDecisionService) RejectDecision(approvalID int64, actor models.Identity, comment string) (*models.DecisionApproval, error) {
+	args := m.Called(approvalID, actor, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DecisionApproval), args.Error(1)
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDispositionService является моком для services.DispositionService интерфейса
+type MockDispositionService struct {
+	mock.Mock
+}
+
+// RecordFlags мок для RecordFlags
+func (m *MockDispositionService) RecordFlags(processingID string, analysis *models.RiskAnalysis) error {
+	args := m.Called(processingID, analysis)
+	return args.Error(0)
+}
+
+// RecordDisposition мок для RecordDisposition
+func (m *MockDispositionService) RecordDisposition(d *models.Disposition) (*models.Disposition, error) {
+	args := m.Called(d)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Disposition), args.Error(1)
+}
+
+// GetDisposition мок для GetDisposition
+func (m *MockDispositionService) GetDisposition(processingID string) (*models.Disposition, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Disposition), args.Error(1)
+}
+
+// GetPrecisionReport мок для GetPrecisionReport
+func (m *MockDispositionService) GetPrecisionReport(from, to time.Time) (*models.PrecisionReport, error) {
+	args := m.Called(from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PrecisionReport), args.Error(1)
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFlowCycleService является моком для services.FlowCycleService интерфейса
+type MockFlowCycleService struct {
+	mock.Mock
+}
+
+// ListFlowCycles мок для ListFlowCycles
+func (m *MockFlowCycleService) ListFlowCycles(limit int) ([]*models.FlowCycle, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FlowCycle), args.Error(1)
+}
+
+// ListAccountFlowCycles мок для ListAccountFlowCycles
+func (m *MockFlowCycleService) ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error) {
+	args := m.Called(accountNumber, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FlowCycle), args.Error(1)
+}
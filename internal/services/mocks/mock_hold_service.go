@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockHoldService является моком для services.HoldService интерфейса
+type MockHoldService struct {
+	mock.Mock
+}
+
+// PlaceHold мок для PlaceHold
+func (m *MockHoldService) PlaceHold(processingID string, decision *models.TransactionDecision) (*models.TransactionHold, error) {
+	args := m.Called(processingID, decision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionHold), args.Error(1)
+}
+
+// ListHolds мок для ListHolds
+func (m *MockHoldService) ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionHold), args.Error(1)
+}
+
+// ProcessExpiredHolds мок для ProcessExpiredHolds
+func (m *MockHoldService) ProcessExpiredHolds(now time.Time) (int, error) {
+	args := m.Called(now)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMandatoryReportService является моком для services.MandatoryReportService интерфейса
+type MockMandatoryReportService struct {
+	mock.Mock
+}
+
+// ScreenTransaction мок для ScreenTransaction
+func (m *MockMandatoryReportService) ScreenTransaction(processingID string, tx *models.Transaction) ([]*models.MandatoryReport, error) {
+	args := m.Called(processingID, tx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.MandatoryReport), args.Error(1)
+}
+
+// ListReports мок для ListReports
+func (m *MockMandatoryReportService) ListReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.MandatoryReport), args.Error(1)
+}
+
+// ExportDaily мок для ExportDaily
+func (m *MockMandatoryReportService) ExportDaily(businessDate, format string) (*models.MandatoryReportExport, error) {
+	args := m.Called(businessDate, format)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MandatoryReportExport), args.Error(1)
+}
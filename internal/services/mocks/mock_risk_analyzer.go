@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRiskAnalyzer является моком для services.RiskAnalyzer интерфейса
+type MockRiskAnalyzer struct {
+	mock.Mock
+}
+
+// AnalyzeTransaction мок для AnalyzeTransaction
+func (m *MockRiskAnalyzer) AnalyzeTransaction(tx *models.Transaction) (*models.RiskAnalysis, error) {
+	args := m.Called(tx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RiskAnalysis), args.Error(1)
+}
+
+// RescoreTransaction мок для RescoreTransaction
+func (m *MockRiskAnalyzer) RescoreTransaction(tx *models.Transaction, inputs *models.RuleInputs) (*models.RiskAnalysis, error) {
+	args := m.Called(tx, inputs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RiskAnalysis), args.Error(1)
+}
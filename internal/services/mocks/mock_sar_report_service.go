@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSARReportService является моком для services.SARReportService интерфейса
+type MockSARReportService struct {
+	mock.Mock
+}
+
+// GenerateReport мок для GenerateReport
+func (m *MockSARReportService) GenerateReport(caseID int64, req *models.SARReportRequest) (*models.SARReport, error) {
+	args := m.Called(caseID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SARReport), args.Error(1)
+}
+
+// GetReport мок для GetReport
+func (m *MockSARReportService) GetReport(id int64) (*models.SARReport, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SARReport), args.Error(1)
+}
+
+// ListReports мок для ListReports
+func (m *MockSARReportService) ListReports(filter models.SARReportFilter) ([]*models.SARReport, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.SARReport), args.Error(1)
+}
+
+// UpdateReport мок для UpdateReport
+func (m *MockSARReportService) UpdateReport(id int64, update *models.SARReportUpdate) (*models.SARReport, error) {
+	args := m.Called(id, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SARReport), args.Error(1)
+}
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransactionDetailService является моком для services.TransactionDetailService интерфейса
+type MockTransactionDetailService struct {
+	mock.Mock
+}
+
+// GetTransactionDetail мок для GetTransactionDetail
+func (m *MockTransactionDetailService) GetTransactionDetail(processingID string) (*models.TransactionDetail, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDetail), args.Error(1)
+}
@@ -19,6 +19,21 @@ func (m *MockTransactionService) ProcessTransaction(req *models.ProcessingReques
 	return args.Get(0).(*models.ProcessingResponse), args.Error(1)
 }
 
+// ValidateTransaction мок для ValidateTransaction
+func (m *MockTransactionService) ValidateTransaction(tx *models.Transaction) error {
+	args := m.Called(tx)
+	return args.Error(0)
+}
+
+// ProcessTransactionBatch мок для ProcessTransactionBatch
+func (m *MockTransactionService) ProcessTransactionBatch(records []*models.BatchRecord) (*models.BatchIngestionResult, error) {
+	args := m.Called(records)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BatchIngestionResult), args.Error(1)
+}
+
 // GetTransactionStatus мок для GetTransactionStatus
 func (m *MockTransactionService) GetTransactionStatus(processingID string) (*models.TransactionStatusResponse, error) {
 	args := m.Called(processingID)
@@ -37,6 +52,15 @@ func (m *MockTransactionService) GetAllTransactions(limit int) ([]*models.Transa
 	return args.Get(0).([]*models.TransactionStatusResponse), args.Error(1)
 }
 
+// SearchTransactions мок для SearchTransactions
+func (m *MockTransactionService) SearchTransactions(req *models.TransactionSearchRequest) (*models.TransactionPage, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionPage), args.Error(1)
+}
+
 // ClearAllTransactions мок для ClearAllTransactions
 func (m *MockTransactionService) ClearAllTransactions() error {
 	args := m.Called()
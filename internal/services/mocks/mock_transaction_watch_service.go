@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransactionWatchService является моком для services.TransactionWatchService интерфейса
+type MockTransactionWatchService struct {
+	mock.Mock
+}
+
+// OpenWatch мок для OpenWatch
+func (m *MockTransactionWatchService) OpenWatch(req *models.TransactionWatchRequest) (*models.TransactionUpdateFilter, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionUpdateFilter), args.Error(1)
+}
+
+// Watch мок для Watch; события передаются в send через Run
+func (m *MockTransactionWatchService) Watch(ctx context.Context, filter models.TransactionUpdateFilter, send func(*models.TransactionUpdate) error) error {
+	args := m.Called(ctx, filter, send)
+	return args.Error(0)
+}
+
+// PruneUpdates мок для PruneUpdates
+func (m *MockTransactionWatchService) PruneUpdates(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -17,8 +17,18 @@ func NewRiskAnalyzer(redisClient redis.ClientInterface) RiskAnalyzer {
 	return &RiskAnalyzerImpl{analyzer: analyzer}
 }
 
+// NewRiskAnalyzerWithOptions создает анализатор рисков с дополнительными правилами
+func NewRiskAnalyzerWithOptions(redisClient redis.ClientInterface, opts fraud.Options) RiskAnalyzer {
+	analyzer := fraud.NewRiskAnalyzerWithOptions(redisClient, opts)
+	return &RiskAnalyzerImpl{analyzer: analyzer}
+}
+
 // AnalyzeTransaction выполняет полный анализ транзакции на предмет рисков
 func (r *RiskAnalyzerImpl) AnalyzeTransaction(tx *models.Transaction) (*models.RiskAnalysis, error) {
 	return r.analyzer.AnalyzeTransaction(tx)
 }
 
+// RescoreTransaction повторно оценивает транзакцию без изменения профилей счета
+func (r *RiskAnalyzerImpl) RescoreTransaction(tx *models.Transaction, inputs *models.RuleInputs) (*models.RiskAnalysis, error) {
+	return r.analyzer.RescoreTransaction(tx, inputs)
+}
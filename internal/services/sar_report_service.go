@@ -0,0 +1,241 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/goaml"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// sarTransitions описывает допустимые переходы статусов сообщения после его формирования
+var sarTransitions = map[string][]string{
+	models.SARStatusGenerated: {models.SARStatusSubmitted},
+	models.SARStatusSubmitted: {models.SARStatusAccepted, models.SARStatusRejected},
+}
+
+// sarStatuses - все статусы сообщения
+var sarStatuses = map[string]bool{
+	models.SARStatusGenerated: true,
+	models.SARStatusSubmitted: true,
+	models.SARStatusAccepted:  true,
+	models.SARStatusRejected:  true,
+}
+
+// SARReportServiceImpl реализует интерфейс SARReportService
+type SARReportServiceImpl struct {
+	repo     storage.SARReportRepository
+	cases    storage.CaseRepository
+	accounts storage.AccountRepository
+	cfg      config.GoAMLConfig
+
+	schema    *goaml.SchemaValidator // Официальная схема регулятора (GOAML_XSD_PATH); nil - только предварительная проверка
+	schemaErr error                  // Схема задана, но недоступна: сообщения не формируются, пока это не исправлено
+}
+
+// NewSARReportService создает новый сервис сообщений goAML
+func NewSARReportService(
+	repo storage.SARReportRepository,
+	cases storage.CaseRepository,
+	accounts storage.AccountRepository,
+	cfg config.GoAMLConfig,
+) SARReportService {
+	s := &SARReportServiceImpl{repo: repo, cases: cases, accounts: accounts, cfg: cfg}
+	if cfg.SchemaPath == "" {
+		log.Println("Warning: GOAML_XSD_PATH is not set: goAML reports are only prechecked against the built-in simplified schema, not validated against the regulator's XSD")
+	} else if s.schema, s.schemaErr = goaml.NewSchemaValidator(cfg.SchemaPath, cfg.XMLLintPath); s.schemaErr != nil {
+		log.Printf("WARNING: GOAML_XSD_PATH: %v; goAML reports cannot be generated until this is fixed", s.schemaErr)
+	}
+	return s
+}
+
+// GenerateReport формирует сообщение goAML по операциям кейса и проверяет его:
+// предварительно по встроенной упрощенной схеме, затем по официальной схеме регулятора, если она задана
+// Сообщение формируется только по эскалированному кейсу или кейсу, закрытому с решением sar_filed
+func (s *SARReportServiceImpl) GenerateReport(caseID int64, req *models.SARReportRequest) (*models.SARReport, error) {
+	req.Actor = strings.TrimSpace(req.Actor)
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Actor == "" || req.Reason == "" {
+		return nil, fmt.Errorf("%w: actor and reason are required", ErrInvalidInput)
+	}
+	if req.ReportCode == "" {
+		req.ReportCode = goaml.ReportCodeSTR
+	}
+
+	c, err := s.cases.GetCase(caseID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: case %d", ErrNotFound, caseID)
+	}
+	if !sarEligible(c) {
+		return nil, fmt.Errorf("%w: case %d is not escalated or closed with resolution %s", ErrConflict, caseID, models.CaseResolutionSARFiled)
+	}
+
+	transactions, err := s.repo.ListCaseTransactions(caseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return nil, fmt.Errorf("%w: case %d has no transactions", ErrConflict, caseID)
+	}
+
+	profiles := make(map[string]*models.AccountProfile)
+	for _, ct := range transactions {
+		number := ct.Transaction.AccountNumber
+		if _, ok := profiles[number]; ok {
+			continue
+		}
+		profile, err := s.accounts.GetAccountProfile(number)
+		if err != nil {
+			return nil, err
+		}
+		profiles[number] = profile
+	}
+
+	now := time.Now()
+	reference := fmt.Sprintf("AML-%06d-%s", caseID, now.UTC().Format("20060102150405"))
+	report, err := goaml.Build(s.cfg, goaml.Input{
+		ReportCode:   req.ReportCode,
+		Reference:    reference,
+		SubmittedAt:  now,
+		Reason:       req.Reason,
+		Action:       strings.TrimSpace(req.Action),
+		Transactions: transactions,
+		Profiles:     profiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	doc, err := goaml.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+	if err := goaml.Precheck(doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if s.schemaErr != nil {
+		return nil, fmt.Errorf("%w: goAML schema validator: %v", ErrUnavailable, s.schemaErr)
+	}
+	if s.schema != nil {
+		if err := s.schema.Validate(doc); err != nil {
+			var validationErr *goaml.ValidationError
+			if errors.As(err, &validationErr) {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+			}
+			return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+		}
+	}
+
+	sar := &models.SARReport{
+		CaseID:           caseID,
+		ReportCode:       req.ReportCode,
+		ReferenceNumber:  reference,
+		Status:           models.SARStatusGenerated,
+		TransactionCount: len(transactions),
+		CreatedBy:        req.Actor,
+		XML:              doc,
+		CreatedAt:        now,
+	}
+	if err := s.repo.CreateSARReport(sar); err != nil {
+		return nil, err
+	}
+	if err := s.cases.AddCaseNote(&models.CaseNote{
+		CaseID: caseID,
+		Author: req.Actor,
+		Text:   fmt.Sprintf("goAML %s report %s generated (%d transactions)", sar.ReportCode, reference, sar.TransactionCount),
+	}); err != nil {
+		return nil, err
+	}
+
+	return sar, nil
+}
+
+// GetReport возвращает сообщение вместе с XML
+func (s *SARReportServiceImpl) GetReport(id int64) (*models.SARReport, error) {
+	r, err := s.repo.GetSARReport(id)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("%w: report %d", ErrNotFound, id)
+	}
+	return r, nil
+}
+
+// ListReports возвращает сообщения по фильтру
+func (s *SARReportServiceImpl) ListReports(filter models.SARReportFilter) ([]*models.SARReport, error) {
+	if filter.Status != "" && !sarStatuses[filter.Status] {
+		return nil, fmt.Errorf("%w: unknown report status %q", ErrInvalidInput, filter.Status)
+	}
+	return s.repo.ListSARReports(filter)
+}
+
+// UpdateReport фиксирует подачу сообщения регулятору и его ответ
+// Для принятого сообщения обязателен номер, присвоенный порталом регулятора
+func (s *SARReportServiceImpl) UpdateReport(id int64, update *models.SARReportUpdate) (*models.SARReport, error) {
+	if strings.TrimSpace(update.Actor) == "" {
+		return nil, fmt.Errorf("%w: actor is required", ErrInvalidInput)
+	}
+
+	r, err := s.repo.GetSARReport(id)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("%w: report %d", ErrNotFound, id)
+	}
+	if !sarTransitionAllowed(r.Status, update.Status) {
+		return nil, fmt.Errorf("%w: report cannot move from %s to %s", ErrConflict, r.Status, update.Status)
+	}
+
+	if reference := strings.TrimSpace(update.RegulatorReference); reference != "" {
+		r.RegulatorReference = reference
+	}
+	if update.Status == models.SARStatusAccepted && r.RegulatorReference == "" {
+		return nil, fmt.Errorf("%w: regulator_reference is required to accept a report", ErrInvalidInput)
+	}
+	if update.Status == models.SARStatusSubmitted {
+		now := time.Now()
+		r.SubmittedAt = &now
+	}
+	if update.Comment != "" {
+		r.Comment = update.Comment
+	}
+	previous := r.Status
+	r.Status = update.Status
+
+	if err := s.repo.UpdateSARReport(r); err != nil {
+		return nil, err
+	}
+	if err := s.cases.AddCaseNote(&models.CaseNote{
+		CaseID: r.CaseID,
+		Author: update.Actor,
+		Text:   fmt.Sprintf("goAML report %s: %s -> %s", r.ReferenceNumber, previous, r.Status),
+	}); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// sarEligible проверяет, подтверждены ли подозрения по кейсу
+func sarEligible(c *models.Case) bool {
+	return c.Status == models.CaseStatusEscalated ||
+		(c.Status == models.CaseStatusClosed && c.Resolution == models.CaseResolutionSARFiled)
+}
+
+func sarTransitionAllowed(from, to string) bool {
+	for _, allowed := range sarTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
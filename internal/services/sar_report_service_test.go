@@ -0,0 +1,162 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/goaml"
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testGoAMLConfig = config.GoAMLConfig{
+	RentityID:                1024,
+	InstitutionName:          "Test Bank",
+	InstitutionSwift:         "TESTRUMM",
+	CountryCodeLocal:         "RU",
+	CurrencyCodeLocal:        "RUB",
+	ReportingPersonFirstName: "Ivan",
+	ReportingPersonLastName:  "Petrov",
+}
+
+func newTestSARReportService(cfg config.GoAMLConfig) (SARReportService, *storagemocks.MockSARReportRepository, *storagemocks.MockCaseRepository, *storagemocks.MockAccountRepository) {
+	repo := new(storagemocks.MockSARReportRepository)
+	caseRepo := new(storagemocks.MockCaseRepository)
+	accountRepo := new(storagemocks.MockAccountRepository)
+	return NewSARReportService(repo, caseRepo, accountRepo, cfg), repo, caseRepo, accountRepo
+}
+
+func sarCaseTransactions() []*models.CaseTransaction {
+	return []*models.CaseTransaction{{
+		ProcessingID: "proc-1",
+		Flags:        []string{"pass_through"},
+		Transaction: models.Transaction{
+			TransactionID:       "T1",
+			AccountNumber:       "40817810000000000001",
+			Amount:              150000,
+			Currency:            "RUB",
+			TransactionType:     "transfer",
+			CounterpartyAccount: "40702810000000000099",
+			CounterpartyBank:    "Other Bank",
+			CounterpartyCountry: "RU",
+			Timestamp:           time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			Channel:             "online",
+		},
+	}}
+}
+
+func sarAccountProfile() *models.AccountProfile {
+	return &models.AccountProfile{
+		Account: models.Account{AccountNumber: "40817810000000000001", CustomerID: "C-1", Currency: "RUB", Status: "active"},
+		Customer: models.Customer{
+			CustomerID: "C-1",
+			FullName:   "Sidorov Petr",
+			Segment:    models.SegmentRetail,
+			Country:    "RU",
+		},
+	}
+}
+
+func TestSARReportService_GenerateReport(t *testing.T) {
+	service, repo, caseRepo, accountRepo := newTestSARReportService(testGoAMLConfig)
+
+	caseRepo.On("GetCase", int64(7)).Return(&models.Case{ID: 7, Status: models.CaseStatusClosed, Resolution: models.CaseResolutionSARFiled}, nil)
+	repo.On("ListCaseTransactions", int64(7)).Return(sarCaseTransactions(), nil)
+	accountRepo.On("GetAccountProfile", "40817810000000000001").Return(sarAccountProfile(), nil)
+	repo.On("CreateSARReport", mock.MatchedBy(func(r *models.SARReport) bool {
+		return r.CaseID == 7 && r.ReportCode == goaml.ReportCodeSTR && r.Status == models.SARStatusGenerated &&
+			r.TransactionCount == 1 && len(r.XML) > 0
+	})).Return(nil)
+	caseRepo.On("AddCaseNote", mock.MatchedBy(func(n *models.CaseNote) bool {
+		return n.CaseID == 7 && n.Author == "mlro"
+	})).Return(nil)
+
+	report, err := service.GenerateReport(7, &models.SARReportRequest{Reason: "Transit of funds", Actor: "mlro"})
+	require.NoError(t, err)
+	assert.Contains(t, report.ReferenceNumber, "AML-000007-")
+	assert.Contains(t, string(report.XML), "<entity_reference>"+report.ReferenceNumber+"</entity_reference>")
+	repo.AssertExpectations(t)
+	caseRepo.AssertExpectations(t)
+}
+
+func TestSARReportService_GenerateReport_CaseNotConfirmed(t *testing.T) {
+	service, repo, caseRepo, _ := newTestSARReportService(testGoAMLConfig)
+
+	caseRepo.On("GetCase", int64(7)).Return(&models.Case{ID: 7, Status: models.CaseStatusInvestigating}, nil)
+
+	_, err := service.GenerateReport(7, &models.SARReportRequest{Reason: "Transit of funds", Actor: "mlro"})
+	assert.ErrorIs(t, err, ErrConflict)
+	repo.AssertNotCalled(t, "ListCaseTransactions", mock.Anything)
+}
+
+func TestSARReportService_GenerateReport_SchemaValidationFailed(t *testing.T) {
+	service, repo, caseRepo, accountRepo := newTestSARReportService(config.GoAMLConfig{CountryCodeLocal: "RU", CurrencyCodeLocal: "RUB"})
+
+	caseRepo.On("GetCase", int64(7)).Return(&models.Case{ID: 7, Status: models.CaseStatusEscalated}, nil)
+	repo.On("ListCaseTransactions", int64(7)).Return(sarCaseTransactions(), nil)
+	accountRepo.On("GetAccountProfile", "40817810000000000001").Return(sarAccountProfile(), nil)
+
+	_, err := service.GenerateReport(7, &models.SARReportRequest{Reason: "Transit of funds", Actor: "mlro"})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	var validationErr *goaml.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.NotEmpty(t, validationErr.Problems)
+	repo.AssertNotCalled(t, "CreateSARReport", mock.Anything)
+}
+
+func TestSARReportService_GenerateReport_SchemaUnavailable(t *testing.T) {
+	cfg := testGoAMLConfig
+	cfg.SchemaPath = "/nonexistent/goaml.xsd"
+	service, repo, caseRepo, accountRepo := newTestSARReportService(cfg)
+
+	caseRepo.On("GetCase", int64(7)).Return(&models.Case{ID: 7, Status: models.CaseStatusEscalated}, nil)
+	repo.On("ListCaseTransactions", int64(7)).Return(sarCaseTransactions(), nil)
+	accountRepo.On("GetAccountProfile", "40817810000000000001").Return(sarAccountProfile(), nil)
+
+	// Схема регулятора задана, но недоступна: сообщение не должно считаться проверенным
+	_, err := service.GenerateReport(7, &models.SARReportRequest{Reason: "Transit of funds", Actor: "mlro"})
+	assert.ErrorIs(t, err, ErrUnavailable)
+	repo.AssertNotCalled(t, "CreateSARReport", mock.Anything)
+}
+
+func TestSARReportService_UpdateReport(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		update  models.SARReportUpdate
+		wantErr error
+	}{
+		{"submit", models.SARStatusGenerated, models.SARReportUpdate{Status: models.SARStatusSubmitted, Actor: "mlro"}, nil},
+		{"accept with reference", models.SARStatusSubmitted, models.SARReportUpdate{Status: models.SARStatusAccepted, RegulatorReference: "FIU-1", Actor: "mlro"}, nil},
+		{"accept without reference", models.SARStatusSubmitted, models.SARReportUpdate{Status: models.SARStatusAccepted, Actor: "mlro"}, ErrInvalidInput},
+		{"accept before submit", models.SARStatusGenerated, models.SARReportUpdate{Status: models.SARStatusAccepted, RegulatorReference: "FIU-1", Actor: "mlro"}, ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, caseRepo, _ := newTestSARReportService(testGoAMLConfig)
+
+			repo.On("GetSARReport", int64(3)).Return(&models.SARReport{ID: 3, CaseID: 7, Status: tt.status, ReferenceNumber: "AML-000007-1"}, nil)
+			repo.On("UpdateSARReport", mock.Anything).Return(nil)
+			caseRepo.On("AddCaseNote", mock.Anything).Return(nil)
+
+			report, err := service.UpdateReport(3, &tt.update)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "UpdateSARReport", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.update.Status, report.Status)
+			if tt.update.Status == models.SARStatusSubmitted {
+				assert.NotNil(t, report.SubmittedAt)
+			}
+		})
+	}
+}
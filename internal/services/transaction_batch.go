@@ -0,0 +1,120 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bank-aml-system/internal/logger"
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/validation"
+)
+
+// transactionBatchChunk - число записей в одной транзакции SQLite и одном запросе к Kafka
+const transactionBatchChunk = 500
+
+// ProcessTransactionBatch сохраняет и отправляет на анализ записи пакета частями
+// Записи проверяются так же, как одиночные транзакции
+// Отклоненные записи и ошибка одной части не мешают обработке остальных: результат возвращается по каждой записи
+func (s *TransactionServiceImpl) ProcessTransactionBatch(records []*models.BatchRecord) (*models.BatchIngestionResult, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: batch is empty", ErrInvalidInput)
+	}
+
+	result := &models.BatchIngestionResult{
+		Total:   len(records),
+		Results: make([]*models.BatchRecordResult, len(records)),
+	}
+
+	var pending []int
+	for i, record := range records {
+		res := &models.BatchRecordResult{Index: record.Index, Line: record.Line}
+		if record.Request != nil {
+			res.TransactionID = record.Request.TransactionID
+		}
+		result.Results[i] = res
+
+		if record.Error != "" || record.Request == nil {
+			res.Status = models.BatchRecordRejected
+			res.Error = record.Error
+			continue
+		}
+		if err := s.ValidateTransaction(&record.Request.Transaction); err != nil {
+			res.Status = models.BatchRecordRejected
+			res.Error = err.Error()
+			res.Fields, _ = validation.FieldErrors(err)
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for start := 0; start < len(pending); start += transactionBatchChunk {
+		end := start + transactionBatchChunk
+		if end > len(pending) {
+			end = len(pending)
+		}
+		s.processBatchChunk(records, result.Results, pending[start:end])
+	}
+
+	for _, res := range result.Results {
+		switch res.Status {
+		case models.BatchRecordAccepted:
+			result.Accepted++
+		case models.BatchRecordRejected:
+			result.Rejected++
+		default:
+			result.Failed++
+		}
+	}
+
+	logger.LogEvent(logger.EventTransactionSaved, "ingestion-service", "sqlite", map[string]interface{}{
+		"batch_total": result.Total,
+		"accepted":    result.Accepted,
+		"rejected":    result.Rejected,
+		"failed":      result.Failed,
+	})
+
+	return result, nil
+}
+
+// processBatchChunk сохраняет часть пакета одной транзакцией БД и отправляет ее события одним запросом
+func (s *TransactionServiceImpl) processBatchChunk(records []*models.BatchRecord, results []*models.BatchRecordResult, indexes []int) {
+	items := make([]*models.TransactionBatchItem, 0, len(indexes))
+	for _, i := range indexes {
+		items = append(items, &models.TransactionBatchItem{
+			ProcessingID: "proc_" + uuid.New().String(),
+			Transaction:  &records[i].Request.Transaction,
+		})
+	}
+
+	if err := s.repo.SaveTransactions(items); err != nil {
+		for _, i := range indexes {
+			results[i].Status = models.BatchRecordFailed
+			results[i].Error = "failed to save transaction: " + err.Error()
+		}
+		return
+	}
+
+	events := make([]*models.KafkaTransactionEvent, 0, len(items))
+	for _, item := range items {
+		events = append(events, newTransactionEvent(item.ProcessingID, item.Transaction))
+	}
+	// Записи уже сохранены: при ошибке Kafka processing_id возвращается, чтобы операцию можно было переоценить
+	publishErr := s.producer.SendTransactionEvents(events)
+
+	for n, i := range indexes {
+		results[i].ProcessingID = items[n].ProcessingID
+		if publishErr != nil {
+			results[i].Status = models.BatchRecordFailed
+			results[i].Error = "saved but not sent for analysis: " + publishErr.Error()
+			continue
+		}
+		results[i].Status = models.BatchRecordAccepted
+	}
+
+	if publishErr == nil {
+		logger.LogEvent(logger.EventKafkaSent, "ingestion-service", "kafka", map[string]interface{}{
+			"events": len(events),
+		})
+	}
+}
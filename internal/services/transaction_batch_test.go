@@ -0,0 +1,106 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	kafkamocks "bank-aml-system/internal/kafka/mocks"
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func batchRecords(n int) []*models.BatchRecord {
+	records := make([]*models.BatchRecord, 0, n)
+	for i := 0; i < n; i++ {
+		records = append(records, &models.BatchRecord{
+			Index: i,
+			Request: &models.ProcessingRequest{Transaction: models.Transaction{
+				TransactionID: fmt.Sprintf("TXN-%d", i), AccountNumber: "ACC-1", Amount: 100, Currency: "RUB", TransactionType: "transfer",
+			}},
+		})
+	}
+	return records
+}
+
+func TestTransactionService_ProcessTransactionBatch_Chunks(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	service := NewTransactionService(mockRepo, mockProducer)
+
+	records := batchRecords(transactionBatchChunk + 3)
+	records[1].Error = "invalid JSON: unexpected end of JSON input"
+	records[3].Request.Currency = "XXY"
+
+	mockRepo.On("SaveTransactions", mock.MatchedBy(func(items []*models.TransactionBatchItem) bool { return len(items) == transactionBatchChunk })).Return(nil).Once()
+	mockRepo.On("SaveTransactions", mock.MatchedBy(func(items []*models.TransactionBatchItem) bool { return len(items) == 1 })).Return(nil).Once()
+	mockProducer.On("SendTransactionEvents", mock.MatchedBy(func(events []*models.KafkaTransactionEvent) bool {
+		return len(events) == transactionBatchChunk && events[0].Data.TransactionID == "TXN-0" && events[1].Data.TransactionID == "TXN-2"
+	})).Return(nil).Once()
+	mockProducer.On("SendTransactionEvents", mock.MatchedBy(func(events []*models.KafkaTransactionEvent) bool { return len(events) == 1 })).Return(nil).Once()
+
+	result, err := service.ProcessTransactionBatch(records)
+
+	require.NoError(t, err)
+	assert.Equal(t, transactionBatchChunk+3, result.Total)
+	assert.Equal(t, transactionBatchChunk+1, result.Accepted)
+	assert.Equal(t, 2, result.Rejected)
+	require.Len(t, result.Results, transactionBatchChunk+3)
+	assert.Equal(t, models.BatchRecordRejected, result.Results[1].Status)
+	assert.Equal(t, "TXN-1", result.Results[1].TransactionID)
+	assert.Empty(t, result.Results[1].ProcessingID)
+	assert.Equal(t, models.BatchRecordAccepted, result.Results[2].Status)
+	assert.Contains(t, result.Results[2].ProcessingID, "proc_")
+	// Запись с неизвестной валютой отклоняется с ошибкой по полю
+	assert.Equal(t, models.BatchRecordRejected, result.Results[3].Status)
+	assert.Equal(t, []models.FieldError{{Field: "currency", Message: "must be an ISO 4217 currency code in uppercase, e.g. RUB"}}, result.Results[3].Fields)
+	mockRepo.AssertExpectations(t)
+	mockProducer.AssertExpectations(t)
+}
+
+func TestTransactionService_ProcessTransactionBatch_SaveError(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	service := NewTransactionService(mockRepo, mockProducer)
+
+	mockRepo.On("SaveTransactions", mock.Anything).Return(errors.New("database is locked"))
+
+	result, err := service.ProcessTransactionBatch(batchRecords(2))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, models.BatchRecordFailed, result.Results[0].Status)
+	assert.Empty(t, result.Results[0].ProcessingID)
+	assert.Contains(t, result.Results[0].Error, "database is locked")
+	mockProducer.AssertNotCalled(t, "SendTransactionEvents", mock.Anything)
+}
+
+func TestTransactionService_ProcessTransactionBatch_KafkaError(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	service := NewTransactionService(mockRepo, mockProducer)
+
+	mockRepo.On("SaveTransactions", mock.Anything).Return(nil)
+	mockProducer.On("SendTransactionEvents", mock.Anything).Return(errors.New("kafka unavailable"))
+
+	result, err := service.ProcessTransactionBatch(batchRecords(1))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, models.BatchRecordFailed, result.Results[0].Status)
+	assert.NotEmpty(t, result.Results[0].ProcessingID)
+	assert.Contains(t, result.Results[0].Error, "kafka unavailable")
+}
+
+func TestTransactionService_ProcessTransactionBatch_Empty(t *testing.T) {
+	service := NewTransactionService(new(storagemocks.MockTransactionRepository), new(kafkamocks.MockProducer))
+
+	result, err := service.ProcessTransactionBatch(nil)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis"
+	"bank-aml-system/internal/storage"
+)
+
+// maxDetailApprovals - наибольшее число запросов на подтверждение решения в хронологии операции
+const maxDetailApprovals = 500
+
+// TransactionDetailServiceImpl реализует интерфейс TransactionDetailService
+type TransactionDetailServiceImpl struct {
+	transactions storage.TransactionRepository
+	analyses     storage.AnalysisRepository
+	cases        storage.CaseRepository
+	decisions    storage.DecisionRepository
+	holds        storage.HoldRepository
+	dispositions storage.DispositionRepository
+	redisClient  redis.ClientInterface // Опционально: флаги анализа операций, проанализированных до ведения истории
+}
+
+// NewTransactionDetailService создает новый сервис полных данных об операции
+func NewTransactionDetailService(
+	transactions storage.TransactionRepository,
+	analyses storage.AnalysisRepository,
+	cases storage.CaseRepository,
+	decisions storage.DecisionRepository,
+	holds storage.HoldRepository,
+	dispositions storage.DispositionRepository,
+	redisClient redis.ClientInterface,
+) TransactionDetailService {
+	return &TransactionDetailServiceImpl{
+		transactions: transactions,
+		analyses:     analyses,
+		cases:        cases,
+		decisions:    decisions,
+		holds:        holds,
+		dispositions: dispositions,
+		redisClient:  redisClient,
+	}
+}
+
+// GetTransactionDetail собирает сохраненную операцию, версии анализа, решения и хронологию событий
+// Только читает данные: просроченные запросы на подтверждение и отсутствующая история анализа не изменяются
+func (s *TransactionDetailServiceImpl) GetTransactionDetail(processingID string) (*models.TransactionDetail, error) {
+	status, err := s.transactions.GetTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+	tx, err := s.transactions.GetFullTransactionByProcessingID(processingID)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%w: transaction %s", ErrNotFound, processingID)
+	}
+
+	detail := &models.TransactionDetail{
+		ProcessingID: processingID,
+		Transaction:  *tx,
+		Status:       status.Status,
+		CreatedAt:    status.CreatedAt,
+		UpdatedAt:    status.UpdatedAt,
+	}
+
+	if detail.Analyses, err = s.analyses.ListAnalysisVersions(processingID); err != nil {
+		return nil, err
+	}
+	if detail.Analyses == nil {
+		detail.Analyses = []*models.AnalysisVersion{}
+	}
+	detail.Analysis = s.currentAnalysis(status, detail.Analyses)
+
+	if detail.Decision, err = s.decisions.GetDecision(processingID); err != nil {
+		return nil, err
+	}
+	if detail.Decision == nil {
+		detail.Decision = &models.TransactionDecision{ProcessingID: processingID, Decision: models.DecisionPending}
+	}
+	history, err := s.decisions.ListDecisionHistory(processingID)
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := s.decisions.ListDecisionApprovals(models.DecisionApprovalFilter{ProcessingID: processingID, Limit: maxDetailApprovals})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, approval := range approvals {
+		if approval.Status == models.ApprovalStatusPending && now.Before(approval.ExpiresAt) {
+			detail.Decision.PendingApproval = approval
+			break
+		}
+	}
+
+	if detail.Alert, err = s.cases.GetAlertByProcessingID(processingID); err != nil {
+		return nil, err
+	}
+	if detail.Hold, err = s.holds.GetHold(processingID); err != nil {
+		return nil, err
+	}
+	if detail.Disposition, err = s.dispositions.GetDisposition(processingID); err != nil {
+		return nil, err
+	}
+
+	detail.Timeline = buildTimeline(detail, history, approvals)
+	return detail, nil
+}
+
+// currentAnalysis возвращает последнюю версию анализа
+// Для операций, проанализированных до ведения истории, результат берется из БД и кэша Redis без сохранения версии
+func (s *TransactionDetailServiceImpl) currentAnalysis(status *models.TransactionStatus, versions []*models.AnalysisVersion) *models.AnalysisVersion {
+	if len(versions) > 0 {
+		return versions[len(versions)-1]
+	}
+	if status.RiskScore == nil || status.RiskLevel == nil || status.AnalysisTimestamp == nil {
+		return nil
+	}
+
+	analysis := &models.RiskAnalysis{
+		RiskScore:  *status.RiskScore,
+		RiskLevel:  *status.RiskLevel,
+		AnalyzedAt: *status.AnalysisTimestamp,
+	}
+	if s.redisClient != nil {
+		if cached, err := s.redisClient.GetAnalysis(status.ProcessingID); err == nil && cached != nil && cached.RiskScore == analysis.RiskScore {
+			analysis = cached
+		}
+	}
+	return newAnalysisVersion(status.ProcessingID, analysis, models.AnalysisSourceIngestion)
+}
+
+// buildTimeline собирает события по операции и упорядочивает их по времени
+func buildTimeline(detail *models.TransactionDetail, history []*models.DecisionChange, approvals []*models.DecisionApproval) []*models.TransactionEvent {
+	events := []*models.TransactionEvent{{
+		Type:    models.TransactionEventReceived,
+		At:      detail.CreatedAt,
+		Summary: fmt.Sprintf("%s %.2f %s", detail.Transaction.TransactionType, detail.Transaction.Amount, detail.Transaction.Currency),
+	}}
+
+	analyses := detail.Analyses
+	if len(analyses) == 0 && detail.Analysis != nil {
+		analyses = []*models.AnalysisVersion{detail.Analysis}
+	}
+	for _, v := range analyses {
+		eventType := models.TransactionEventAnalyzed
+		if v.Source == models.AnalysisSourceRescore {
+			eventType = models.TransactionEventRescored
+		}
+		summary := fmt.Sprintf("risk %s (%d)", v.RiskLevel, v.RiskScore)
+		if len(v.Flags) > 0 {
+			summary += ", flags: " + strings.Join(v.Flags, ", ")
+		}
+		events = append(events, &models.TransactionEvent{
+			Type:    eventType,
+			At:      v.AnalyzedAt,
+			Actor:   v.RequestedBy,
+			Summary: summary,
+			Details: v.Reason,
+		})
+	}
+
+	if detail.Alert != nil {
+		events = append(events, &models.TransactionEvent{
+			Type:    models.TransactionEventAlertRaised,
+			At:      detail.Alert.CreatedAt,
+			Summary: fmt.Sprintf("alert %d in case %d", detail.Alert.ID, detail.Alert.CaseID),
+		})
+	}
+
+	for _, change := range history {
+		summary := change.FromDecision + " -> " + change.ToDecision
+		if change.ApprovedBy != "" {
+			summary += ", approved by " + change.ApprovedBy
+		}
+		events = append(events, &models.TransactionEvent{
+			Type:    models.TransactionEventDecisionChanged,
+			At:      change.CreatedAt,
+			Actor:   change.Actor,
+			Summary: summary,
+			Details: change.Reason,
+		})
+	}
+
+	for _, approval := range approvals {
+		events = append(events, &models.TransactionEvent{
+			Type:    models.TransactionEventApprovalRequested,
+			At:      approval.CreatedAt,
+			Actor:   approval.RequestedBy,
+			Summary: approval.FromDecision + " -> " + approval.ToDecision,
+			Details: approval.Reason,
+		})
+		if approval.ResolvedAt != nil {
+			events = append(events, &models.TransactionEvent{
+				Type:    models.TransactionEventApprovalResolved,
+				At:      *approval.ResolvedAt,
+				Actor:   approval.ResolvedBy,
+				Summary: approval.Status,
+				Details: approval.Comment,
+			})
+		}
+	}
+
+	if detail.Hold != nil {
+		events = append(events, &models.TransactionEvent{
+			Type:    models.TransactionEventHoldPlaced,
+			At:      detail.Hold.CreatedAt,
+			Summary: fmt.Sprintf("expires at %s, then %s", detail.Hold.ExpiresAt.Format(time.RFC3339), detail.Hold.ExpiryAction),
+		})
+		if detail.Hold.ResolvedAt != nil {
+			events = append(events, &models.TransactionEvent{
+				Type:    models.TransactionEventHoldResolved,
+				At:      *detail.Hold.ResolvedAt,
+				Summary: detail.Hold.Status,
+			})
+		}
+	}
+
+	if detail.Disposition != nil {
+		events = append(events, &models.TransactionEvent{
+			Type:    models.TransactionEventDispositionRecorded,
+			At:      detail.Disposition.UpdatedAt,
+			Actor:   detail.Disposition.Analyst,
+			Summary: detail.Disposition.Disposition,
+			Details: detail.Disposition.Comment,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].At.Before(events[j].At)
+	})
+	return events
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	redismocks "bank-aml-system/internal/redis/mocks"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionDetailFixture struct {
+	service      TransactionDetailService
+	transactions *storagemocks.MockTransactionRepository
+	analyses     *storagemocks.MockAnalysisRepository
+	cases        *storagemocks.MockCaseRepository
+	decisions    *storagemocks.MockDecisionRepository
+	holds        *storagemocks.MockHoldRepository
+	dispositions *storagemocks.MockDispositionRepository
+	redis        *redismocks.MockClientInterface
+}
+
+func newTransactionDetailFixture() *transactionDetailFixture {
+	f := &transactionDetailFixture{
+		transactions: new(storagemocks.MockTransactionRepository),
+		analyses:     new(storagemocks.MockAnalysisRepository),
+		cases:        new(storagemocks.MockCaseRepository),
+		decisions:    new(storagemocks.MockDecisionRepository),
+		holds:        new(storagemocks.MockHoldRepository),
+		dispositions: new(storagemocks.MockDispositionRepository),
+		redis:        new(redismocks.MockClientInterface),
+	}
+	f.service = NewTransactionDetailService(f.transactions, f.analyses, f.cases, f.decisions, f.holds, f.dispositions, f.redis)
+	return f
+}
+
+func TestTransactionDetailService_GetTransactionDetail_FullTimeline(t *testing.T) {
+	f := newTransactionDetailFixture()
+	base := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	score, level := 80, "high"
+	analyzedAt := at(2)
+
+	f.transactions.On("GetTransactionByProcessingID", "proc-1").Return(&models.TransactionStatus{
+		ProcessingID: "proc-1", Status: "reviewed", RiskScore: &score, RiskLevel: &level,
+		AnalysisTimestamp: &analyzedAt, CreatedAt: at(0), UpdatedAt: at(2),
+	}, nil)
+	tx := &models.Transaction{
+		TransactionID: "TXN-1", AccountNumber: "ACC-1", Amount: 5000, Currency: "USD", TransactionType: "international_transfer",
+		CounterpartyAccount: "ACC-9", CounterpartyBank: "Offshore Bank", CounterpartyCountry: "KY",
+		Timestamp: base.Add(-time.Minute), Channel: "online", UserID: "user-1", BranchID: "branch-1",
+	}
+	f.transactions.On("GetFullTransactionByProcessingID", "proc-1").Return(tx, nil)
+	f.analyses.On("ListAnalysisVersions", "proc-1").Return([]*models.AnalysisVersion{
+		{ProcessingID: "proc-1", Version: 1, RiskScore: 60, RiskLevel: "medium", Flags: []string{"large_amount"}, Source: models.AnalysisSourceIngestion, AnalyzedAt: at(1)},
+		{ProcessingID: "proc-1", Version: 2, RiskScore: 80, RiskLevel: "high", Flags: []string{"large_amount", "offshore_counterparty"}, Source: models.AnalysisSourceRescore, RequestedBy: "analyst-1", Reason: "rules update", AnalyzedAt: at(30)},
+	}, nil)
+	f.decisions.On("GetDecision", "proc-1").Return(&models.TransactionDecision{ProcessingID: "proc-1", Decision: models.DecisionHeld, Actor: "system", UpdatedAt: at(1)}, nil)
+	f.decisions.On("ListDecisionHistory", "proc-1").Return([]*models.DecisionChange{
+		{ProcessingID: "proc-1", FromDecision: models.DecisionPending, ToDecision: models.DecisionHeld, Actor: "system", Reason: "require_verification", CreatedAt: at(1)},
+	}, nil)
+	resolvedAt := at(40)
+	f.decisions.On("ListDecisionApprovals", models.DecisionApprovalFilter{ProcessingID: "proc-1", Limit: maxDetailApprovals}).Return([]*models.DecisionApproval{
+		{ID: 2, ProcessingID: "proc-1", FromDecision: models.DecisionHeld, ToDecision: models.DecisionBlocked, RequestedBy: "analyst-1", Status: models.ApprovalStatusPending, ExpiresAt: time.Now().Add(time.Hour), CreatedAt: at(50)},
+		{ID: 1, ProcessingID: "proc-1", FromDecision: models.DecisionHeld, ToDecision: models.DecisionBlocked, RequestedBy: "analyst-1", Status: models.ApprovalStatusRejected, ResolvedBy: "analyst-2", Comment: "need documents", CreatedAt: at(35), ResolvedAt: &resolvedAt},
+	}, nil)
+	f.cases.On("GetAlertByProcessingID", "proc-1").Return(&models.Alert{ID: 7, CaseID: 3, ProcessingID: "proc-1", CreatedAt: at(1)}, nil)
+	f.holds.On("GetHold", "proc-1").Return(&models.TransactionHold{ProcessingID: "proc-1", Status: models.HoldStatusActive, ExpiryAction: models.HoldExpiryEscalate, ExpiresAt: at(240), CreatedAt: at(1)}, nil)
+	f.dispositions.On("GetDisposition", "proc-1").Return(&models.Disposition{ProcessingID: "proc-1", Disposition: "true_positive", Analyst: "analyst-2", CreatedAt: at(60), UpdatedAt: at(60)}, nil)
+
+	detail, err := f.service.GetTransactionDetail("proc-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, *tx, detail.Transaction)
+	assert.Equal(t, "reviewed", detail.Status)
+	require.Len(t, detail.Analyses, 2)
+	assert.Equal(t, 2, detail.Analysis.Version)
+	assert.Equal(t, models.DecisionHeld, detail.Decision.Decision)
+	require.NotNil(t, detail.Decision.PendingApproval)
+	assert.Equal(t, int64(2), detail.Decision.PendingApproval.ID)
+	assert.Equal(t, int64(7), detail.Alert.ID)
+	assert.NotNil(t, detail.Hold)
+	assert.Equal(t, "true_positive", detail.Disposition.Disposition)
+
+	var types []string
+	for i, event := range detail.Timeline {
+		types = append(types, event.Type)
+		if i > 0 {
+			assert.False(t, event.At.Before(detail.Timeline[i-1].At), "timeline must be ordered by time")
+		}
+	}
+	assert.Equal(t, []string{
+		models.TransactionEventReceived,
+		models.TransactionEventAnalyzed,
+		models.TransactionEventAlertRaised,
+		models.TransactionEventDecisionChanged,
+		models.TransactionEventHoldPlaced,
+		models.TransactionEventRescored,
+		models.TransactionEventApprovalRequested,
+		models.TransactionEventApprovalResolved,
+		models.TransactionEventApprovalRequested,
+		models.TransactionEventDispositionRecorded,
+	}, types)
+	assert.Equal(t, "analyst-1", detail.Timeline[5].Actor)
+	assert.Equal(t, "rules update", detail.Timeline[5].Details)
+	f.redis.AssertNotCalled(t, "GetAnalysis", mock.Anything)
+}
+
+func TestTransactionDetailService_GetTransactionDetail_AnalyzedBeforeHistory(t *testing.T) {
+	f := newTransactionDetailFixture()
+	score, level := 45, "medium"
+	analyzedAt := time.Date(2024, 1, 15, 10, 1, 0, 0, time.UTC)
+
+	f.transactions.On("GetTransactionByProcessingID", "proc-1").Return(&models.TransactionStatus{
+		ProcessingID: "proc-1", Status: "reviewed", RiskScore: &score, RiskLevel: &level,
+		AnalysisTimestamp: &analyzedAt, CreatedAt: analyzedAt.Add(-time.Minute),
+	}, nil)
+	f.transactions.On("GetFullTransactionByProcessingID", "proc-1").Return(&models.Transaction{TransactionID: "TXN-1"}, nil)
+	f.analyses.On("ListAnalysisVersions", "proc-1").Return(nil, nil)
+	f.redis.On("GetAnalysis", "proc-1").Return(&models.RiskAnalysis{
+		RiskScore: 45, RiskLevel: "medium", Flags: []string{"night_time"}, AnalyzedAt: analyzedAt,
+	}, nil)
+	f.decisions.On("GetDecision", "proc-1").Return(nil, nil)
+	f.decisions.On("ListDecisionHistory", "proc-1").Return(nil, nil)
+	f.decisions.On("ListDecisionApprovals", mock.Anything).Return(nil, nil)
+	f.cases.On("GetAlertByProcessingID", "proc-1").Return(nil, nil)
+	f.holds.On("GetHold", "proc-1").Return(nil, nil)
+	f.dispositions.On("GetDisposition", "proc-1").Return(nil, nil)
+
+	detail, err := f.service.GetTransactionDetail("proc-1")
+
+	require.NoError(t, err)
+	assert.Empty(t, detail.Analyses)
+	require.NotNil(t, detail.Analysis)
+	assert.Equal(t, []string{"night_time"}, detail.Analysis.Flags)
+	assert.Equal(t, models.DecisionPending, detail.Decision.Decision)
+	assert.Nil(t, detail.Alert)
+	require.Len(t, detail.Timeline, 2)
+	assert.Equal(t, models.TransactionEventAnalyzed, detail.Timeline[1].Type)
+	f.analyses.AssertNotCalled(t, "SaveAnalysisVersion", mock.Anything)
+}
+
+func TestTransactionDetailService_GetTransactionDetail_NotFound(t *testing.T) {
+	f := newTransactionDetailFixture()
+	f.transactions.On("GetTransactionByProcessingID", "missing").Return(nil, nil)
+
+	detail, err := f.service.GetTransactionDetail("missing")
+
+	assert.Nil(t, detail)
+	assert.ErrorIs(t, err, ErrNotFound)
+	f.transactions.AssertNotCalled(t, "GetFullTransactionByProcessingID", mock.Anything)
+}
+
+func TestTransactionDetailService_GetTransactionDetail_RepositoryError(t *testing.T) {
+	f := newTransactionDetailFixture()
+	score, level := 10, "low"
+	analyzedAt := time.Now()
+	f.transactions.On("GetTransactionByProcessingID", "proc-1").Return(&models.TransactionStatus{
+		ProcessingID: "proc-1", RiskScore: &score, RiskLevel: &level, AnalysisTimestamp: &analyzedAt,
+	}, nil)
+	f.transactions.On("GetFullTransactionByProcessingID", "proc-1").Return(&models.Transaction{TransactionID: "TXN-1"}, nil)
+	f.analyses.On("ListAnalysisVersions", "proc-1").Return(nil, errors.New("database is locked"))
+
+	detail, err := f.service.GetTransactionDetail("proc-1")
+
+	assert.Nil(t, detail)
+	assert.EqualError(t, err, "database is locked")
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/redis"
+)
+
+// defaultTransactionPageSize - размер страницы поиска, если лимит не указан
+const defaultTransactionPageSize = 100
+
+// maxTransactionPageSize - наибольший размер страницы поиска
+const maxTransactionPageSize = 500
+
+// SearchTransactions возвращает страницу транзакций по фильтру
+// Курсор привязан к сортировке: следующая страница запрашивается с теми же sort и order
+func (s *TransactionServiceImpl) SearchTransactions(req *models.TransactionSearchRequest) (*models.TransactionPage, error) {
+	filter, err := searchFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на одну запись больше, чтобы узнать, есть ли следующая страница
+	limit := filter.Limit
+	filter.Limit = limit + 1
+	items, err := s.repo.SearchTransactions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.TransactionPage{}
+	if len(items) > limit {
+		items = items[:limit]
+		last := items[len(items)-1]
+		page.NextCursor = encodeTransactionCursor(&models.TransactionCursor{
+			SortBy:      filter.SortBy,
+			Order:       filter.Order,
+			SortKey:     last.SortKey,
+			SortKeyNull: last.SortKeyNull,
+			ID:          last.ID,
+		})
+	}
+
+	page.Transactions = attachCachedFlags(s.redisClient, items)
+	return page, nil
+}
+
+// attachCachedFlags дополняет операции флагами и фактами анализа из кэша Redis
+// Без Redis или после истечения кэша флаги пустые
+func attachCachedFlags(redisClient redis.ClientInterface, items []*models.TransactionSummary) []*models.TransactionSummary {
+	for _, item := range items {
+		item.Flags = []string{}
+		if redisClient != nil {
+			analysis, err := redisClient.GetAnalysis(item.ProcessingID)
+			if err == nil && analysis != nil && analysis.Flags != nil {
+				item.Flags = analysis.Flags
+				item.Evidence = analysis.Evidence
+			}
+		}
+	}
+	if items == nil {
+		items = []*models.TransactionSummary{}
+	}
+	return items
+}
+
+// searchFilter проверяет условия поиска и переводит их в фильтр хранилища
+func searchFilter(req *models.TransactionSearchRequest) (models.TransactionSearchFilter, error) {
+	filter := models.TransactionSearchFilter{
+		AccountNumber: req.AccountNumber,
+		Counterparty:  req.Counterparty,
+		RiskLevel:     req.RiskLevel,
+		MinScore:      req.MinScore,
+		MaxScore:      req.MaxScore,
+		Status:        req.Status,
+		Currency:      req.Currency,
+		Country:       req.Country,
+		Channel:       req.Channel,
+		From:          req.From,
+		To:            req.To,
+		SortBy:        req.SortBy,
+		Order:         req.Order,
+		Limit:         req.Limit,
+	}
+
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = models.TransactionSortCreatedAt
+	case models.TransactionSortCreatedAt, models.TransactionSortTimestamp, models.TransactionSortAmount, models.TransactionSortRiskScore:
+	default:
+		return filter, fmt.Errorf("%w: unknown sort field %q", ErrInvalidInput, filter.SortBy)
+	}
+	switch filter.Order {
+	case "":
+		filter.Order = models.SortOrderDesc
+	case models.SortOrderAsc, models.SortOrderDesc:
+	default:
+		return filter, fmt.Errorf("%w: unknown sort order %q", ErrInvalidInput, filter.Order)
+	}
+	switch filter.RiskLevel {
+	case "", "low", "medium", "high":
+	default:
+		return filter, fmt.Errorf("%w: unknown risk level %q", ErrInvalidInput, filter.RiskLevel)
+	}
+	if filter.MinScore != nil && filter.MaxScore != nil && *filter.MinScore > *filter.MaxScore {
+		return filter, fmt.Errorf("%w: min_score must not exceed max_score", ErrInvalidInput)
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if filter.Limit < 0 || filter.Limit > maxTransactionPageSize {
+		return filter, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, maxTransactionPageSize)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultTransactionPageSize
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeTransactionCursor(req.Cursor)
+		if err != nil {
+			return filter, err
+		}
+		if cursor.SortBy != filter.SortBy || cursor.Order != filter.Order {
+			return filter, fmt.Errorf("%w: cursor was issued for sort %s %s", ErrInvalidInput, cursor.SortBy, cursor.Order)
+		}
+		filter.After = cursor
+	}
+	return filter, nil
+}
+
+// encodeTransactionCursor кодирует позицию в списке в непрозрачную строку
+func encodeTransactionCursor(cursor *models.TransactionCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeTransactionCursor разбирает курсор, выданный encodeTransactionCursor
+func decodeTransactionCursor(value string) (*models.TransactionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	var cursor models.TransactionCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID <= 0 {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	return &cursor, nil
+}
@@ -1,15 +1,18 @@
 package services
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 
+	"bank-aml-system/config"
 	"bank-aml-system/internal/kafka"
 	"bank-aml-system/internal/logger"
 	"bank-aml-system/internal/models"
 	"bank-aml-system/internal/redis"
 	"bank-aml-system/internal/storage"
+	"bank-aml-system/internal/validation"
 )
 
 // TransactionServiceImpl реализует интерфейс TransactionService
@@ -17,27 +20,47 @@ type TransactionServiceImpl struct {
 	repo        storage.TransactionRepository
 	producer    kafka.Producer
 	redisClient redis.ClientInterface // Опциональный Redis клиент для получения флагов (используем интерфейс)
+	validator   *validation.Validator
 }
 
 // NewTransactionService создает новый сервис транзакций
+// Время операции при проверке не ограничивается
 func NewTransactionService(repo storage.TransactionRepository, producer kafka.Producer) TransactionService {
 	return &TransactionServiceImpl{
-		repo:     repo,
-		producer: producer,
+		repo:      repo,
+		producer:  producer,
+		validator: validation.NewValidator(config.ValidationConfig{}),
 	}
 }
 
-// NewTransactionServiceWithRedis создает новый сервис транзакций с поддержкой Redis
-func NewTransactionServiceWithRedis(repo storage.TransactionRepository, producer kafka.Producer, redisClient redis.ClientInterface) TransactionService {
+// NewTransactionServiceWithRedis создает новый сервис транзакций с поддержкой Redis и проверкой входящих транзакций
+func NewTransactionServiceWithRedis(repo storage.TransactionRepository, producer kafka.Producer, redisClient redis.ClientInterface, validator *validation.Validator) TransactionService {
 	return &TransactionServiceImpl{
 		repo:        repo,
 		producer:    producer,
 		redisClient: redisClient,
+		validator:   validator,
 	}
 }
 
+// ValidateTransaction проверяет транзакцию по стандартам (ISO 4217, ISO 3166-1, IBAN, BIC) и допустимому времени операции
+// Незаданное время операции заменяется временем приема
+func (s *TransactionServiceImpl) ValidateTransaction(tx *models.Transaction) error {
+	if tx.Timestamp.IsZero() {
+		tx.Timestamp = time.Now()
+	}
+	if err := s.validator.ValidateTransaction(tx); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return nil
+}
+
 // ProcessTransaction обрабатывает транзакцию
 func (s *TransactionServiceImpl) ProcessTransaction(req *models.ProcessingRequest) (*models.ProcessingResponse, error) {
+	if err := s.ValidateTransaction(&req.Transaction); err != nil {
+		return nil, err
+	}
+
 	processingID := "proc_" + uuid.New().String()
 
 	// Сохраняем транзакцию в БД
@@ -46,21 +69,7 @@ func (s *TransactionServiceImpl) ProcessTransaction(req *models.ProcessingReques
 	}
 
 	// Создаем событие для Kafka
-	event := &models.KafkaTransactionEvent{
-		EventID:   "evt_" + uuid.New().String(),
-		EventType: "transaction_received",
-		Timestamp: time.Now(),
-		Data: models.KafkaTransactionData{
-			ProcessingID:        processingID,
-			TransactionID:       req.TransactionID,
-			AccountNumber:       req.AccountNumber,
-			Amount:              req.Amount,
-			Currency:            req.Currency,
-			TransactionType:     req.TransactionType,
-			CounterpartyCountry: req.CounterpartyCountry,
-			Channel:             req.Channel,
-		},
-	}
+	event := newTransactionEvent(processingID, &req.Transaction)
 
 	// Отправляем событие в Kafka
 	if err := s.producer.SendTransactionEvent(event); err != nil {
@@ -109,6 +118,7 @@ func (s *TransactionServiceImpl) GetTransactionStatus(processingID string) (*mod
 		analysis, err := s.redisClient.GetAnalysis(processingID)
 		if err == nil && analysis != nil && analysis.Flags != nil {
 			response.Flags = analysis.Flags
+			response.Evidence = analysis.Evidence
 		}
 	}
 
@@ -141,6 +151,7 @@ func (s *TransactionServiceImpl) GetAllTransactions(limit int) ([]*models.Transa
 			analysis, err := s.redisClient.GetAnalysis(tx.ProcessingID)
 			if err == nil && analysis != nil && analysis.Flags != nil {
 				response.Flags = analysis.Flags
+				response.Evidence = analysis.Evidence
 			}
 		}
 
@@ -154,3 +165,22 @@ func (s *TransactionServiceImpl) GetAllTransactions(limit int) ([]*models.Transa
 func (s *TransactionServiceImpl) ClearAllTransactions() error {
 	return s.repo.ClearAllTransactions()
 }
+
+// newTransactionEvent создает событие о принятой транзакции для fraud-сервиса
+func newTransactionEvent(processingID string, tx *models.Transaction) *models.KafkaTransactionEvent {
+	return &models.KafkaTransactionEvent{
+		EventID:   "evt_" + uuid.New().String(),
+		EventType: "transaction_received",
+		Timestamp: time.Now(),
+		Data: models.KafkaTransactionData{
+			ProcessingID:        processingID,
+			TransactionID:       tx.TransactionID,
+			AccountNumber:       tx.AccountNumber,
+			Amount:              tx.Amount,
+			Currency:            tx.Currency,
+			TransactionType:     tx.TransactionType,
+			CounterpartyCountry: tx.CounterpartyCountry,
+			Channel:             tx.Channel,
+		},
+	}
+}
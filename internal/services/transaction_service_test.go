@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"bank-aml-system/config"
 	kafkamocks "bank-aml-system/internal/kafka/mocks"
 	"bank-aml-system/internal/models"
 	redismocks "bank-aml-system/internal/redis/mocks"
 	storagemocks "bank-aml-system/internal/storage/mocks"
+	"bank-aml-system/internal/validation"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -34,7 +36,7 @@ func TestNewTransactionServiceWithRedis(t *testing.T) {
 	mockProducer := new(kafkamocks.MockProducer)
 	mockRedis := new(redismocks.MockClientInterface)
 
-	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis, validation.NewValidator(config.ValidationConfig{}))
 
 	assert.NotNil(t, service)
 	impl, ok := service.(*TransactionServiceImpl)
@@ -87,10 +89,11 @@ func TestTransactionService_ProcessTransaction_RepositoryError(t *testing.T) {
 
 	req := &models.ProcessingRequest{
 		Transaction: models.Transaction{
-			TransactionID: "TXN-001",
-			AccountNumber: "ACC123456",
-			Amount:        100000.0,
-			Currency:      "RUB",
+			TransactionID:   "TXN-001",
+			AccountNumber:   "ACC123456",
+			Amount:          100000.0,
+			Currency:        "RUB",
+			TransactionType: "transfer",
 		},
 	}
 
@@ -114,10 +117,11 @@ func TestTransactionService_ProcessTransaction_KafkaError(t *testing.T) {
 
 	req := &models.ProcessingRequest{
 		Transaction: models.Transaction{
-			TransactionID: "TXN-001",
-			AccountNumber: "ACC123456",
-			Amount:        100000.0,
-			Currency:      "RUB",
+			TransactionID:   "TXN-001",
+			AccountNumber:   "ACC123456",
+			Amount:          100000.0,
+			Currency:        "RUB",
+			TransactionType: "transfer",
 		},
 	}
 
@@ -135,6 +139,62 @@ func TestTransactionService_ProcessTransaction_KafkaError(t *testing.T) {
 	mockProducer.AssertExpectations(t)
 }
 
+func TestTransactionService_ProcessTransaction_InvalidInput(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	service := NewTransactionService(mockRepo, mockProducer)
+
+	req := &models.ProcessingRequest{
+		Transaction: models.Transaction{
+			TransactionID:       "TXN-001",
+			AccountNumber:       "ACC123456",
+			Amount:              100000.0,
+			Currency:            "rub",
+			TransactionType:     "transfer",
+			CounterpartyCountry: "Cayman",
+		},
+	}
+
+	response, err := service.ProcessTransaction(req)
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	fields, ok := validation.FieldErrors(err)
+	require.True(t, ok)
+	assert.Len(t, fields, 2)
+	mockRepo.AssertNotCalled(t, "SaveTransaction", mock.Anything, mock.Anything)
+	mockProducer.AssertNotCalled(t, "SendTransactionEvent", mock.Anything)
+}
+
+func TestTransactionService_ProcessTransaction_DefaultsTimestamp(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, nil, validation.NewValidator(config.ValidationConfig{MaxAge: time.Hour}))
+
+	req := &models.ProcessingRequest{
+		Transaction: models.Transaction{
+			TransactionID:   "TXN-001",
+			AccountNumber:   "ACC123456",
+			Amount:          100.0,
+			Currency:        "RUB",
+			TransactionType: "transfer",
+		},
+	}
+	mockRepo.On("SaveTransaction", mock.AnythingOfType("string"), &req.Transaction).Return(nil)
+	mockProducer.On("SendTransactionEvent", mock.AnythingOfType("*models.KafkaTransactionEvent")).Return(nil)
+
+	_, err := service.ProcessTransaction(req)
+
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), req.Timestamp, time.Minute)
+
+	// Операция старше допустимого возраста отклоняется
+	req.Timestamp = time.Now().Add(-2 * time.Hour)
+	_, err = service.ProcessTransaction(req)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockRepo.AssertNumberOfCalls(t, "SaveTransaction", 1)
+}
+
 func TestTransactionService_GetTransactionStatus_Success(t *testing.T) {
 	mockRepo := new(storagemocks.MockTransactionRepository)
 	mockProducer := new(kafkamocks.MockProducer)
@@ -176,7 +236,7 @@ func TestTransactionService_GetTransactionStatus_WithRedis_WithFlags(t *testing.
 	mockRepo := new(storagemocks.MockTransactionRepository)
 	mockProducer := new(kafkamocks.MockProducer)
 	mockRedis := new(redismocks.MockClientInterface)
-	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis, validation.NewValidator(config.ValidationConfig{}))
 
 	processingID := "proc_test_123"
 	riskScore := 50
@@ -218,7 +278,7 @@ func TestTransactionService_GetTransactionStatus_WithRedis_NoAnalysis(t *testing
 	mockRepo := new(storagemocks.MockTransactionRepository)
 	mockProducer := new(kafkamocks.MockProducer)
 	mockRedis := new(redismocks.MockClientInterface)
-	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis, validation.NewValidator(config.ValidationConfig{}))
 
 	processingID := "proc_test_123"
 	status := &models.TransactionStatus{
@@ -319,7 +379,7 @@ func TestTransactionService_GetAllTransactions_WithRedis_WithFlags(t *testing.T)
 	mockRepo := new(storagemocks.MockTransactionRepository)
 	mockProducer := new(kafkamocks.MockProducer)
 	mockRedis := new(redismocks.MockClientInterface)
-	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis, validation.NewValidator(config.ValidationConfig{}))
 
 	riskScore := 50
 	riskLevel := "medium"
@@ -395,3 +455,95 @@ func TestTransactionService_ClearAllTransactions_Error(t *testing.T) {
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestTransactionService_SearchTransactions_NextCursor(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	mockProducer := new(kafkamocks.MockProducer)
+	mockRedis := new(redismocks.MockClientInterface)
+	service := NewTransactionServiceWithRedis(mockRepo, mockProducer, mockRedis, validation.NewValidator(config.ValidationConfig{}))
+
+	items := []*models.TransactionSummary{
+		{ID: 30, SortKey: 500, ProcessingID: "proc-3"},
+		{ID: 20, SortKey: 400, ProcessingID: "proc-2"},
+		{ID: 10, SortKey: 300, ProcessingID: "proc-1"},
+	}
+	mockRepo.On("SearchTransactions", mock.MatchedBy(func(f models.TransactionSearchFilter) bool {
+		return f.Limit == 3 && f.SortBy == models.TransactionSortAmount && f.Order == models.SortOrderDesc &&
+			f.RiskLevel == "high" && f.After == nil
+	})).Return(items, nil)
+	mockRedis.On("GetAnalysis", "proc-3").Return(&models.RiskAnalysis{Flags: []string{"large_amount"}}, nil)
+	mockRedis.On("GetAnalysis", "proc-2").Return(nil, errors.New("not found"))
+
+	page, err := service.SearchTransactions(&models.TransactionSearchRequest{
+		RiskLevel: "high",
+		SortBy:    models.TransactionSortAmount,
+		Limit:     2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 2)
+	assert.Equal(t, []string{"large_amount"}, page.Transactions[0].Flags)
+	assert.Equal(t, []string{}, page.Transactions[1].Flags)
+	require.NotEmpty(t, page.NextCursor)
+
+	cursor, err := decodeTransactionCursor(page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, &models.TransactionCursor{
+		SortBy:  models.TransactionSortAmount,
+		Order:   models.SortOrderDesc,
+		SortKey: 400,
+		ID:      20,
+	}, cursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionService_SearchTransactions_LastPage(t *testing.T) {
+	mockRepo := new(storagemocks.MockTransactionRepository)
+	service := NewTransactionService(mockRepo, new(kafkamocks.MockProducer))
+
+	cursor := encodeTransactionCursor(&models.TransactionCursor{
+		SortBy: models.TransactionSortCreatedAt, Order: models.SortOrderDesc, SortKey: 20, ID: 20,
+	})
+	mockRepo.On("SearchTransactions", mock.MatchedBy(func(f models.TransactionSearchFilter) bool {
+		return f.Limit == defaultTransactionPageSize+1 && f.After != nil && f.After.ID == 20
+	})).Return([]*models.TransactionSummary{{ID: 10, SortKey: 10, ProcessingID: "proc-1"}}, nil)
+
+	page, err := service.SearchTransactions(&models.TransactionSearchRequest{Cursor: cursor})
+
+	require.NoError(t, err)
+	assert.Len(t, page.Transactions, 1)
+	assert.Empty(t, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransactionService_SearchTransactions_InvalidInput(t *testing.T) {
+	minScore, maxScore := 80, 20
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	otherSort := encodeTransactionCursor(&models.TransactionCursor{
+		SortBy: models.TransactionSortAmount, Order: models.SortOrderDesc, SortKey: 1, ID: 1,
+	})
+
+	cases := map[string]*models.TransactionSearchRequest{
+		"unknown sort":    {SortBy: "account_number"},
+		"unknown order":   {Order: "up"},
+		"unknown risk":    {RiskLevel: "critical"},
+		"score range":     {MinScore: &minScore, MaxScore: &maxScore},
+		"period":          {From: &from, To: &to},
+		"limit":           {Limit: maxTransactionPageSize + 1},
+		"malformed":       {Cursor: "not-a-cursor"},
+		"cursor mismatch": {Cursor: otherSort},
+	}
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := new(storagemocks.MockTransactionRepository)
+			service := NewTransactionService(mockRepo, new(kafkamocks.MockProducer))
+
+			page, err := service.SearchTransactions(req)
+
+			assert.Nil(t, page)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			mockRepo.AssertNotCalled(t, "SearchTransactions", mock.Anything)
+		})
+	}
+}
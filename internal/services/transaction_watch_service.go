@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+	"bank-aml-system/internal/storage"
+)
+
+// defaultWatchPollInterval - период чтения ленты, если он не задан в конфигурации
+const defaultWatchPollInterval = time.Second
+
+// watchBatchSize - наибольшее число событий ленты за одно чтение
+const watchBatchSize = 500
+
+// watchEventTypes - типы событий ленты изменений транзакций
+var watchEventTypes = map[string]bool{
+	models.TransactionEventReceived:        true,
+	models.TransactionEventAnalyzed:        true,
+	models.TransactionEventAlertRaised:     true,
+	models.TransactionEventDecisionChanged: true,
+}
+
+// TransactionWatchServiceImpl реализует интерфейс TransactionWatchService
+type TransactionWatchServiceImpl struct {
+	updates      storage.TransactionUpdateRepository
+	pollInterval time.Duration
+	retention    time.Duration // Срок хранения событий; 0 - события не удаляются
+}
+
+// NewTransactionWatchService создает новый сервис подписки на изменения транзакций
+func NewTransactionWatchService(updates storage.TransactionUpdateRepository, pollInterval, retention time.Duration) TransactionWatchService {
+	if pollInterval <= 0 {
+		pollInterval = defaultWatchPollInterval
+	}
+	return &TransactionWatchServiceImpl{
+		updates:      updates,
+		pollInterval: pollInterval,
+		retention:    retention,
+	}
+}
+
+// OpenWatch проверяет условия подписки и определяет позицию ленты, с которой она начнется
+// Без resume token подписка получает только события, записанные после подключения
+func (s *TransactionWatchServiceImpl) OpenWatch(req *models.TransactionWatchRequest) (*models.TransactionUpdateFilter, error) {
+	switch req.RiskLevel {
+	case "", "low", "medium", "high":
+	default:
+		return nil, fmt.Errorf("%w: unknown risk level %q", ErrInvalidInput, req.RiskLevel)
+	}
+	for _, t := range req.Types {
+		if !watchEventTypes[t] {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidInput, t)
+		}
+	}
+
+	oldest, latest, err := s.updates.GetTransactionUpdateBounds()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &models.TransactionUpdateFilter{
+		AfterID:       latest,
+		AccountNumber: strings.TrimSpace(req.AccountNumber),
+		RiskLevel:     req.RiskLevel,
+		MinScore:      req.MinScore,
+		Types:         req.Types,
+		Limit:         watchBatchSize,
+	}
+	if req.ResumeToken == "" {
+		return filter, nil
+	}
+
+	after, err := strconv.ParseInt(req.ResumeToken, 10, 64)
+	if err != nil || after < 0 {
+		return nil, fmt.Errorf("%w: invalid resume token %q", ErrInvalidInput, req.ResumeToken)
+	}
+	if after > latest {
+		return nil, fmt.Errorf("%w: resume token %q is ahead of the feed", ErrInvalidInput, req.ResumeToken)
+	}
+	if after < oldest-1 {
+		return nil, fmt.Errorf("%w: events after resume token %q were deleted, subscribe without a token", ErrExpired, req.ResumeToken)
+	}
+	filter.AfterID = after
+	return filter, nil
+}
+
+// Watch передает в send события ленты после filter.AfterID, пока не отменен ctx или send не вернет ошибку
+// Ошибка чтения ленты не прерывает подписку: чтение повторяется в следующем периоде
+func (s *TransactionWatchServiceImpl) Watch(ctx context.Context, filter models.TransactionUpdateFilter, send func(*models.TransactionUpdate) error) error {
+	if filter.Limit <= 0 {
+		filter.Limit = watchBatchSize
+	}
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		page, err := s.updates.ListTransactionUpdates(filter)
+		if err != nil {
+			log.Printf("Error reading transaction updates after %d: %v", filter.AfterID, err)
+		} else {
+			for _, update := range page.Updates {
+				update.ResumeToken = strconv.FormatInt(update.ID, 10)
+				if err := send(update); err != nil {
+					return err
+				}
+			}
+			filter.AfterID = page.NextID
+		}
+
+		// Полная страница: в ленте есть еще события, читаем сразу
+		if err == nil && len(page.Updates) == filter.Limit {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PruneUpdates удаляет события ленты старше срока хранения
+func (s *TransactionWatchServiceImpl) PruneUpdates(now time.Time) (int64, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.updates.DeleteTransactionUpdatesBefore(now.Add(-s.retention))
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+	storagemocks "bank-aml-system/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionWatchService_OpenWatch(t *testing.T) {
+	minScore := 70
+	cases := map[string]struct {
+		req     *models.TransactionWatchRequest
+		afterID int64
+		err     error
+	}{
+		"from now":        {req: &models.TransactionWatchRequest{RiskLevel: "high", MinScore: &minScore}, afterID: 120},
+		"resume":          {req: &models.TransactionWatchRequest{ResumeToken: "99"}, afterID: 99},
+		"resume at start": {req: &models.TransactionWatchRequest{ResumeToken: "49"}, afterID: 49},
+		"expired":         {req: &models.TransactionWatchRequest{ResumeToken: "48"}, err: ErrExpired},
+		"ahead":           {req: &models.TransactionWatchRequest{ResumeToken: "121"}, err: ErrInvalidInput},
+		"malformed":       {req: &models.TransactionWatchRequest{ResumeToken: "abc"}, err: ErrInvalidInput},
+		"unknown type":    {req: &models.TransactionWatchRequest{Types: []string{"deleted"}}, err: ErrInvalidInput},
+		"unknown level":   {req: &models.TransactionWatchRequest{RiskLevel: "severe"}, err: ErrInvalidInput},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := new(storagemocks.MockTransactionUpdateRepository)
+			repo.On("GetTransactionUpdateBounds").Return(int64(50), int64(120), nil)
+			service := NewTransactionWatchService(repo, time.Millisecond, time.Hour)
+
+			filter, err := service.OpenWatch(tc.req)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.afterID, filter.AfterID)
+			assert.Equal(t, watchBatchSize, filter.Limit)
+			assert.Equal(t, tc.req.RiskLevel, filter.RiskLevel)
+		})
+	}
+}
+
+func TestTransactionWatchService_Watch_AdvancesPosition(t *testing.T) {
+	repo := new(storagemocks.MockTransactionUpdateRepository)
+	service := NewTransactionWatchService(repo, time.Millisecond, time.Hour)
+	filter := models.TransactionUpdateFilter{AfterID: 10, RiskLevel: "high", Limit: 2}
+
+	first := filter
+	repo.On("ListTransactionUpdates", first).Return(&models.TransactionUpdatePage{
+		Updates: []*models.TransactionUpdate{{ID: 11, Type: models.TransactionEventAnalyzed}, {ID: 14, Type: models.TransactionEventAnalyzed}},
+		NextID:  14,
+	}, nil).Once()
+	second := filter
+	second.AfterID = 14
+	repo.On("ListTransactionUpdates", second).Return(nil, errors.New("database is locked")).Once()
+	repo.On("ListTransactionUpdates", second).Return(&models.TransactionUpdatePage{
+		Updates: []*models.TransactionUpdate{{ID: 20, Type: models.TransactionEventDecisionChanged}},
+		NextID:  25,
+	}, nil).Once()
+
+	var tokens []string
+	stop := errors.New("stop")
+	err := service.Watch(context.Background(), filter, func(u *models.TransactionUpdate) error {
+		tokens = append(tokens, u.ResumeToken)
+		if len(tokens) == 3 {
+			return stop
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"11", "14", "20"}, tokens)
+	repo.AssertExpectations(t)
+}
+
+func TestTransactionWatchService_Watch_StopsOnCancel(t *testing.T) {
+	repo := new(storagemocks.MockTransactionUpdateRepository)
+	service := NewTransactionWatchService(repo, time.Millisecond, time.Hour)
+	repo.On("ListTransactionUpdates", mock.Anything).Return(&models.TransactionUpdatePage{Updates: []*models.TransactionUpdate{}, NextID: 5}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := service.Watch(ctx, models.TransactionUpdateFilter{Limit: 10}, func(*models.TransactionUpdate) error { return nil })
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTransactionWatchService_PruneUpdates(t *testing.T) {
+	repo := new(storagemocks.MockTransactionUpdateRepository)
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	repo.On("DeleteTransactionUpdatesBefore", now.Add(-72*time.Hour)).Return(int64(3), nil)
+
+	deleted, err := NewTransactionWatchService(repo, 0, 72*time.Hour).PruneUpdates(now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	deleted, err = NewTransactionWatchService(repo, 0, 0).PruneUpdates(now)
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	repo.AssertNumberOfCalls(t, "DeleteTransactionUpdatesBefore", 1)
+}
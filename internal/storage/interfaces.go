@@ -10,6 +10,9 @@ import (
 type TransactionRepository interface {
 	// SaveTransaction сохраняет транзакцию в БД со статусом pending_review
 	SaveTransaction(processingID string, tx *models.Transaction) error
+
+	// SaveTransactions сохраняет пакет транзакций атомарно: все записи или ни одной
+	SaveTransactions(items []*models.TransactionBatchItem) error
 	
 	// UpdateTransactionAnalysis обновляет результаты анализа транзакции
 	UpdateTransactionAnalysis(processingID string, riskScore int, riskLevel string, analysisTime time.Time) error
@@ -22,8 +25,249 @@ type TransactionRepository interface {
 	
 	// GetAllTransactions получает все транзакции из БД
 	GetAllTransactions(limit int) ([]*models.TransactionStatus, error)
+
+	// SearchTransactions получает страницу транзакций по фильтру с сортировкой
+	SearchTransactions(filter models.TransactionSearchFilter) ([]*models.TransactionSummary, error)
 	
-	// ClearAllTransactions удаляет все транзакции из БД
+	// ClearAllTransactions удаляет все транзакции и производные от них данные: кейсы, алерты, решения, анализы, отчеты
 	ClearAllTransactions() error
 }
 
+
+// AccountRepository определяет интерфейс для работы с реестром клиентов и счетов
+type AccountRepository interface {
+	// SaveCustomer создает или обновляет клиента
+	SaveCustomer(customer *models.Customer) error
+
+	// GetCustomer получает клиента по customer_id
+	GetCustomer(customerID string) (*models.Customer, error)
+
+	// ListCustomers получает список клиентов
+	ListCustomers(limit int) ([]*models.Customer, error)
+
+	// SaveAccount создает или обновляет счет
+	SaveAccount(account *models.Account) error
+
+	// GetAccount получает счет по номеру
+	GetAccount(accountNumber string) (*models.Account, error)
+
+	// ListAccounts получает счета клиента
+	ListAccounts(customerID string) ([]*models.Account, error)
+
+	// GetAccountProfile получает счет вместе с данными владельца
+	GetAccountProfile(accountNumber string) (*models.AccountProfile, error)
+
+	// GetAccountTurnover возвращает сумму исходящих операций по счету начиная с since, не считая операцию excludeTransactionID
+	GetAccountTurnover(accountNumber, excludeTransactionID string, since time.Time) (float64, error)
+}
+
+// FlowCycleRepository определяет интерфейс для хранения обнаруженных круговых потоков
+type FlowCycleRepository interface {
+	// SaveFlowCycle сохраняет цикл вместе с участвующими счетами
+	SaveFlowCycle(cycle *models.FlowCycle) error
+
+	// ListFlowCycles получает последние обнаруженные циклы
+	ListFlowCycles(limit int) ([]*models.FlowCycle, error)
+
+	// ListAccountFlowCycles получает циклы, в которых участвовал счет
+	ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error)
+}
+
+// CaseRepository определяет интерфейс для работы с алертами и кейсами
+type CaseRepository interface {
+	// SaveAlert сохраняет алерт, привязанный к кейсу
+	SaveAlert(alert *models.Alert) error
+
+	// GetAlert получает алерт по ID
+	GetAlert(id int64) (*models.Alert, error)
+
+	// GetAlertByProcessingID получает алерт по processing_id транзакции
+	GetAlertByProcessingID(processingID string) (*models.Alert, error)
+
+	// UpdateAlert обновляет оценку, рекомендацию, флаги и статус алерта
+	UpdateAlert(alert *models.Alert) error
+
+	// ListAlerts получает алерты по фильтру
+	ListAlerts(filter models.AlertFilter) ([]*models.Alert, error)
+
+	// ListCaseAlerts получает алерты кейса
+	ListCaseAlerts(caseID int64) ([]*models.Alert, error)
+
+	// CreateCase создает кейс
+	CreateCase(c *models.Case) error
+
+	// GetCase получает кейс по ID
+	GetCase(id int64) (*models.Case, error)
+
+	// GetActiveCaseByAccount получает незакрытый кейс по счету
+	GetActiveCaseByAccount(accountNumber string) (*models.Case, error)
+
+	// ListCases получает кейсы по фильтру
+	ListCases(filter models.CaseFilter) ([]*models.Case, error)
+
+	// UpdateCase обновляет статус, исполнителя и решение по кейсу
+	UpdateCase(c *models.Case) error
+
+	// AddCaseNote добавляет заметку к кейсу
+	AddCaseNote(note *models.CaseNote) error
+
+	// ListCaseNotes получает заметки кейса
+	ListCaseNotes(caseID int64) ([]*models.CaseNote, error)
+
+	// AddCaseAttachment добавляет ссылку на документ к кейсу
+	AddCaseAttachment(attachment *models.CaseAttachment) error
+
+	// ListCaseAttachments получает вложения кейса
+	ListCaseAttachments(caseID int64) ([]*models.CaseAttachment, error)
+}
+
+// DispositionRepository определяет интерфейс для хранения флагов анализа и заключений аналитиков
+type DispositionRepository interface {
+	// SaveTransactionFlags сохраняет сработавшие флаги транзакции вместе с версией правил
+	SaveTransactionFlags(processingID string, flags []string, rulesetVersion string, flaggedAt time.Time) error
+
+	// SaveDisposition создает или заменяет заключение аналитика по транзакции
+	SaveDisposition(d *models.Disposition) error
+
+	// GetDisposition получает заключение аналитика по processing_id транзакции
+	GetDisposition(processingID string) (*models.Disposition, error)
+
+	// GetFlagPrecision считает срабатывания и заключения по флагам за период [from, to)
+	GetFlagPrecision(from, to time.Time) ([]*models.FlagPrecision, error)
+}
+
+// DecisionRepository определяет интерфейс для хранения решений по транзакциям
+type DecisionRepository interface {
+	// GetDecision получает текущее решение по транзакции (nil, если решение не принималось)
+	GetDecision(processingID string) (*models.TransactionDecision, error)
+
+	// ApplyDecision меняет решение, если текущее совпадает с change.FromDecision, и пишет историю
+	ApplyDecision(change *models.DecisionChange) (bool, error)
+
+	// ListDecisionHistory получает историю решений по транзакции
+	ListDecisionHistory(processingID string) ([]*models.DecisionChange, error)
+
+	// CreateDecisionApproval сохраняет запрос на подтверждение решения вторым сотрудником
+	CreateDecisionApproval(a *models.DecisionApproval) error
+
+	// GetDecisionApproval получает запрос на подтверждение по ID
+	GetDecisionApproval(id int64) (*models.DecisionApproval, error)
+
+	// GetPendingDecisionApproval получает ожидающий подтверждения запрос по транзакции
+	GetPendingDecisionApproval(processingID string) (*models.DecisionApproval, error)
+
+	// ListDecisionApprovals получает запросы на подтверждение по фильтру
+	ListDecisionApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error)
+
+	// ResolveDecisionApproval переводит ожидающий запрос в итоговый статус (false, если он уже обработан)
+	ResolveDecisionApproval(a *models.DecisionApproval) (bool, error)
+
+	// ExpireDecisionApprovals помечает просроченные запросы как expired
+	ExpireDecisionApprovals(now time.Time) (int64, error)
+}
+
+// HoldRepository определяет интерфейс для таймеров проверки приостановленных операций
+type HoldRepository interface {
+	// CreateHold сохраняет таймер приостановки (false, если таймер по транзакции уже существует)
+	CreateHold(h *models.TransactionHold) (bool, error)
+
+	// GetHold получает таймер приостановки по транзакции (nil, если операция не приостанавливалась)
+	GetHold(processingID string) (*models.TransactionHold, error)
+
+	// ListHolds получает таймеры по фильтру
+	ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error)
+
+	// ListExpiredHolds получает активные таймеры, срок которых истек к now
+	ListExpiredHolds(now time.Time, limit int) ([]*models.TransactionHold, error)
+
+	// ResolveHold переводит активный таймер в итоговый статус (false, если он уже обработан)
+	ResolveHold(h *models.TransactionHold) (bool, error)
+}
+
+// SARReportRepository определяет интерфейс для хранения сообщений о подозрительной деятельности
+type SARReportRepository interface {
+	// ListCaseTransactions получает операции кейса вместе с флагами анализа
+	ListCaseTransactions(caseID int64) ([]*models.CaseTransaction, error)
+
+	// CreateSARReport сохраняет сформированное сообщение
+	CreateSARReport(r *models.SARReport) error
+
+	// GetSARReport получает сообщение по ID
+	GetSARReport(id int64) (*models.SARReport, error)
+
+	// ListSARReports получает сообщения по фильтру
+	ListSARReports(filter models.SARReportFilter) ([]*models.SARReport, error)
+
+	// UpdateSARReport сохраняет статус, номер регулятора и комментарий сообщения
+	UpdateSARReport(r *models.SARReport) error
+}
+
+// MandatoryReportRepository определяет интерфейс для очереди обязательного контроля операций
+type MandatoryReportRepository interface {
+	// ListTransactionsByAccounts получает операции указанных типов по счетам за период [from, to)
+	ListTransactionsByAccounts(accountNumbers []string, transactionTypes []string, from, to time.Time) ([]*models.TransactionRecord, error)
+
+	// EnqueueMandatoryReports ставит операции в очередь; уже поставленные по тому же правилу пропускаются
+	// Возвращает число добавленных записей
+	EnqueueMandatoryReports(reports []*models.MandatoryReport) (int, error)
+
+	// ListMandatoryReports получает записи очереди по фильтру
+	ListMandatoryReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error)
+
+	// MarkMandatoryReportsExported отмечает записи как выгруженные
+	MarkMandatoryReportsExported(ids []int64, exportedAt time.Time) error
+}
+
+// BlacklistRepository определяет интерфейс для записей черного и санкционного списков и их ретроспективной проверки
+type BlacklistRepository interface {
+	// CreateBlacklistEntry сохраняет запись списка
+	CreateBlacklistEntry(e *models.BlacklistEntry) error
+
+	// GetBlacklistEntry получает запись списка по ID
+	GetBlacklistEntry(id int64) (*models.BlacklistEntry, error)
+
+	// ListBlacklistEntries получает записи списков по фильтру
+	ListBlacklistEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error)
+
+	// ListAccountNumbersByCustomerName получает счета клиентов с указанным полным именем
+	ListAccountNumbersByCustomerName(name string) ([]string, error)
+
+	// ListTransactionsInvolvingAccounts получает операции с since, где счета участвуют как счет клиента или контрагента
+	ListTransactionsInvolvingAccounts(accountNumbers []string, since time.Time) ([]*models.TransactionRecord, error)
+
+	// SaveBlacklistMatches сохраняет совпадения ретроспективной проверки и отмечает запись проверенной
+	SaveBlacklistMatches(entryID int64, matches []*models.BlacklistMatch, screenedAt time.Time) error
+
+	// ListBlacklistMatches получает совпадения ретроспективной проверки записи
+	ListBlacklistMatches(entryID int64) ([]*models.BlacklistMatch, error)
+}
+
+// AnalysisRepository определяет интерфейс для истории версий анализа операций
+type AnalysisRepository interface {
+	// SaveAnalysisVersion сохраняет результат анализа следующей версией для операции
+	SaveAnalysisVersion(v *models.AnalysisVersion) error
+
+	// ListAnalysisVersions получает все версии анализа операции по возрастанию номера
+	ListAnalysisVersions(processingID string) ([]*models.AnalysisVersion, error)
+
+	// ListProcessingIDsForRescore получает проанализированные операции, подходящие под фильтр
+	ListProcessingIDsForRescore(filter models.RescoreFilter) ([]string, error)
+}
+
+// AccountActivityRepository определяет интерфейс для итогов операций счета
+type AccountActivityRepository interface {
+	// GetAccountActivityTotals считает число и сумму операций счета по валютам, странам, каналам и периодам
+	GetAccountActivityTotals(filter models.AccountActivityFilter) (*models.AccountActivityTotals, error)
+}
+
+// TransactionUpdateRepository определяет интерфейс для ленты изменений транзакций
+type TransactionUpdateRepository interface {
+	// ListTransactionUpdates возвращает события после filter.AfterID и позицию, с которой продолжать чтение
+	ListTransactionUpdates(filter models.TransactionUpdateFilter) (*models.TransactionUpdatePage, error)
+
+	// GetTransactionUpdateBounds возвращает первый хранящийся и последний выданный ID ленты
+	GetTransactionUpdateBounds() (oldest, latest int64, err error)
+
+	// DeleteTransactionUpdatesBefore удаляет события, записанные раньше before, и возвращает их число
+	DeleteTransactionUpdatesBefore(before time.Time) (int64, error)
+}
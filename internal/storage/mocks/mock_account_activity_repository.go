@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountActivityRepository является моком для storage.AccountActivityRepository интерфейса
+type MockAccountActivityRepository struct {
+	mock.Mock
+}
+
+// GetAccountActivityTotals мок для GetAccountActivityTotals
+func (m *MockAccountActivityRepository) GetAccountActivityTotals(filter models.AccountActivityFilter) (*models.AccountActivityTotals, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountActivityTotals), args.Error(1)
+}
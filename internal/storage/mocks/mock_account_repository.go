@@ -0,0 +1,76 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountRepository является моком для storage.AccountRepository интерфейса
+type MockAccountRepository struct {
+	mock.Mock
+}
+
+// SaveCustomer мок для SaveCustomer
+func (m *MockAccountRepository) SaveCustomer(customer *models.Customer) error {
+	args := m.Called(customer)
+	return args.Error(0)
+}
+
+// GetCustomer мок для GetCustomer
+func (m *MockAccountRepository) GetCustomer(customerID string) (*models.Customer, error) {
+	args := m.Called(customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Customer), args.Error(1)
+}
+
+// ListCustomers мок для ListCustomers
+func (m *MockAccountRepository) ListCustomers(limit int) ([]*models.Customer, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Customer), args.Error(1)
+}
+
+// SaveAccount мок для SaveAccount
+func (m *MockAccountRepository) SaveAccount(account *models.Account) error {
+	args := m.Called(account)
+	return args.Error(0)
+}
+
+// GetAccount мок для GetAccount
+func (m *MockAccountRepository) GetAccount(accountNumber string) (*models.Account, error) {
+	args := m.Called(accountNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Account), args.Error(1)
+}
+
+// ListAccounts мок для ListAccounts
+func (m *MockAccountRepository) ListAccounts(customerID string) ([]*models.Account, error) {
+	args := m.Called(customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Account), args.Error(1)
+}
+
+// GetAccountProfile мок для GetAccountProfile
+func (m *MockAccountRepository) GetAccountProfile(accountNumber string) (*models.AccountProfile, error) {
+	args := m.Called(accountNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AccountProfile), args.Error(1)
+}
+
+// GetAccountTurnover мок для GetAccountTurnover
+func (m *MockAccountRepository) GetAccountTurnover(accountNumber, excludeTransactionID string, since time.Time) (float64, error) {
+	args := m.Called(accountNumber, excludeTransactionID, since)
+	return args.Get(0).(float64), args.Error(1)
+}
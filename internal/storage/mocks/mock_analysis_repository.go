@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAnalysisRepository является моком для storage.AnalysisRepository интерфейса
+type MockAnalysisRepository struct {
+	mock.Mock
+}
+
+// SaveAnalysisVersion мок для SaveAnalysisVersion
+func (m *MockAnalysisRepository) SaveAnalysisVersion(v *models.AnalysisVersion) error {
+	args := m.Called(v)
+	return args.Error(0)
+}
+
+// ListAnalysisVersions мок для ListAnalysisVersions
+func (m *MockAnalysisRepository) ListAnalysisVersions(processingID string) ([]*models.AnalysisVersion, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.AnalysisVersion), args.Error(1)
+}
+
+// ListProcessingIDsForRescore мок для ListProcessingIDsForRescore
+func (m *MockAnalysisRepository) ListProcessingIDsForRescore(filter models.RescoreFilter) ([]string, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
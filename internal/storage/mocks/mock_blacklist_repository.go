@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBlacklistRepository является моком для storage.BlacklistRepository интерфейса
+type MockBlacklistRepository struct {
+	mock.Mock
+}
+
+// CreateBlacklistEntry мок для CreateBlacklistEntry
+func (m *MockBlacklistRepository) CreateBlacklistEntry(e *models.BlacklistEntry) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+// GetBlacklistEntry мок для GetBlacklistEntry
+func (m *MockBlacklistRepository) GetBlacklistEntry(id int64) (*models.BlacklistEntry, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BlacklistEntry), args.Error(1)
+}
+
+// ListBlacklistEntries мок для ListBlacklistEntries
+func (m *MockBlacklistRepository) ListBlacklistEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.BlacklistEntry), args.Error(1)
+}
+
+// ListAccountNumbersByCustomerName мок для ListAccountNumbersByCustomerName
+func (m *MockBlacklistRepository) ListAccountNumbersByCustomerName(name string) ([]string, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// ListTransactionsInvolvingAccounts мок для ListTransactionsInvolvingAccounts
+func (m *MockBlacklistRepository) ListTransactionsInvolvingAccounts(accountNumbers []string, since time.Time) ([]*models.TransactionRecord, error) {
+	args := m.Called(accountNumbers, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionRecord), args.Error(1)
+}
+
+// SaveBlacklistMatches мок для SaveBlacklistMatches
+func (m *MockBlacklistRepository) SaveBlacklistMatches(entryID int64, matches []*models.BlacklistMatch, screenedAt time.Time) error {
+	args := m.Called(entryID, matches, screenedAt)
+	return args.Error(0)
+}
+
+// ListBlacklistMatches мок для ListBlacklistMatches
+func (m *MockBlacklistRepository) ListBlacklistMatches(entryID int64) ([]*models.BlacklistMatch, error) {
+	args := m.Called(entryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.BlacklistMatch), args.Error(1)
+}
@@ -0,0 +1,129 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCaseRepository является моком для storage.CaseRepository интерфейса
+type MockCaseRepository struct {
+	mock.Mock
+}
+
+// SaveAlert мок для SaveAlert
+func (m *MockCaseRepository) SaveAlert(alert *models.Alert) error {
+	args := m.Called(alert)
+	return args.Error(0)
+}
+
+// GetAlert мок для GetAlert
+func (m *MockCaseRepository) GetAlert(id int64) (*models.Alert, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Alert), args.Error(1)
+}
+
+// UpdateAlert мок для UpdateAlert
+func (m *MockCaseRepository) UpdateAlert(alert *models.Alert) error {
+	args := m.Called(alert)
+	return args.Error(0)
+}
+
+// GetAlertByProcessingID мок для GetAlertByProcessingID
+func (m *MockCaseRepository) GetAlertByProcessingID(processingID string) (*models.Alert, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Alert), args.Error(1)
+}
+
+// ListAlerts мок для ListAlerts
+func (m *MockCaseRepository) ListAlerts(filter models.AlertFilter) ([]*models.Alert, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Alert), args.Error(1)
+}
+
+// ListCaseAlerts мок для ListCaseAlerts
+func (m *MockCaseRepository) ListCaseAlerts(caseID int64) ([]*models.Alert, error) {
+	args := m.Called(caseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Alert), args.Error(1)
+}
+
+// CreateCase мок для CreateCase
+func (m *MockCaseRepository) CreateCase(c *models.Case) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// GetCase мок для GetCase
+func (m *MockCaseRepository) GetCase(id int64) (*models.Case, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Case), args.Error(1)
+}
+
+// GetActiveCaseByAccount мок для GetActiveCaseByAccount
+func (m *MockCaseRepository) GetActiveCaseByAccount(accountNumber string) (*models.Case, error) {
+	args := m.Called(accountNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Case), args.Error(1)
+}
+
+// ListCases мок для ListCases
+func (m *MockCaseRepository) ListCases(filter models.CaseFilter) ([]*models.Case, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Case), args.Error(1)
+}
+
+// UpdateCase мок для UpdateCase
+func (m *MockCaseRepository) UpdateCase(c *models.Case) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// AddCaseNote мок для AddCaseNote
+func (m *MockCaseRepository) AddCaseNote(note *models.CaseNote) error {
+	args := m.Called(note)
+	return args.Error(0)
+}
+
+// ListCaseNotes мок для ListCaseNotes
+func (m *MockCaseRepository) ListCaseNotes(caseID int64) ([]*models.CaseNote, error) {
+	args := m.Called(caseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.CaseNote), args.Error(1)
+}
+
+// AddCaseAttachment мок для AddCaseAttachment
+func (m *MockCaseRepository) AddCaseAttachment(attachment *models.CaseAttachment) error {
+	args := m.Called(attachment)
+	return args.Error(0)
+}
+
+// ListCaseAttachments мок для ListCaseAttachments
+func (m *MockCaseRepository) ListCaseAttachments(caseID int64) ([]*models.CaseAttachment, error) {
+	args := m.Called(caseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.CaseAttachment), args.Error(1)
+}
@@ -0,0 +1,83 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDecisionRepository является моком для storage.DecisionRepository интерфейса
+type MockDecisionRepository struct {
+	mock.Mock
+}
+
+// GetDecision мок для GetDecision
+func (m *MockDecisionRepository) GetDecision(processingID string) (*models.TransactionDecision, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionDecision), args.Error(1)
+}
+
+// ApplyDecision мок для ApplyDecision
+func (m *MockDecisionRepository) ApplyDecision(change *models.DecisionChange) (bool, error) {
+	args := m.Called(change)
+	return args.Bool(0), args.Error(1)
+}
+
+// ListDecisionHistory мок для ListDecisionHistory
+func (m *MockDecisionRepository) ListDecisionHistory(processingID string) ([]*models.DecisionChange, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.DecisionChange), args.Error(1)
+}
+
+// CreateDecisionApproval мок для CreateDecisionApproval
+func (m *MockDecisionRepository) CreateDecisionApproval(a *models.DecisionApproval) error {
+	args := m.Called(a)
+	return args.Error(0)
+}
+
+// GetDecisionApproval мок для GetDecisionApproval
+func (m *MockDecisionRepository) GetDecisionApproval(id int64) (*models.DecisionApproval, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DecisionApproval), args.Error(1)
+}
+
+// GetPendingDecisionApproval мок для GetPendingDecisionApproval
+func (m *MockDecisionRepository) GetPendingDecisionApproval(processingID string) (*models.DecisionApproval, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DecisionApproval), args.Error(1)
+}
+
+// ListDecisionApprovals мок для ListDecisionApprovals
+func (m *MockDecisionRepository) ListDecisionApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.DecisionApproval), args.Error(1)
+}
+
+// ResolveDecisionApproval мок для ResolveDecisionApproval
+func (m *MockDecisionRepository) ResolveDecisionApproval(a *models.DecisionApproval) (bool, error) {
+	args := m.Called(a)
+	return args.Bool(0), args.Error(1)
+}
+
+// ExpireDecisionApprovals мок для ExpireDecisionApprovals
+func (m *MockDecisionRepository) ExpireDecisionApprovals(now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDispositionRepository является моком для storage.DispositionRepository интерфейса
+type MockDispositionRepository struct {
+	mock.Mock
+}
+
+// SaveTransactionFlags мок для SaveTransactionFlags
+func (m *MockDispositionRepository) SaveTransactionFlags(processingID string, flags []string, rulesetVersion string, flaggedAt time.Time) error {
+	args := m.Called(processingID, flags, rulesetVersion, flaggedAt)
+	return args.Error(0)
+}
+
+// SaveDisposition мок для SaveDisposition
+func (m *MockDispositionRepository) SaveDisposition(d *models.Disposition) error {
+	args := m.Called(d)
+	return args.Error(0)
+}
+
+// GetDisposition мок для GetDisposition
+func (m *MockDispositionRepository) GetDisposition(processingID string) (*models.Disposition, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Disposition), args.Error(1)
+}
+
+// GetFlagPrecision мок для GetFlagPrecision
+func (m *MockDispositionRepository) GetFlagPrecision(from, to time.Time) ([]*models.FlagPrecision, error) {
+	args := m.Called(from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FlagPrecision), args.Error(1)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFlowCycleRepository является моком для storage.FlowCycleRepository интерфейса
+type MockFlowCycleRepository struct {
+	mock.Mock
+}
+
+// SaveFlowCycle мок для SaveFlowCycle
+func (m *MockFlowCycleRepository) SaveFlowCycle(cycle *models.FlowCycle) error {
+	args := m.Called(cycle)
+	return args.Error(0)
+}
+
+// ListFlowCycles мок для ListFlowCycles
+func (m *MockFlowCycleRepository) ListFlowCycles(limit int) ([]*models.FlowCycle, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FlowCycle), args.Error(1)
+}
+
+// ListAccountFlowCycles мок для ListAccountFlowCycles
+func (m *MockFlowCycleRepository) ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error) {
+	args := m.Called(accountNumber, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.FlowCycle), args.Error(1)
+}
@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockHoldRepository является моком для storage.HoldRepository интерфейса
+type MockHoldRepository struct {
+	mock.Mock
+}
+
+// CreateHold мок для CreateHold
+func (m *MockHoldRepository) CreateHold(h *models.TransactionHold) (bool, error) {
+	args := m.Called(h)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetHold мок для GetHold
+func (m *MockHoldRepository) GetHold(processingID string) (*models.TransactionHold, error) {
+	args := m.Called(processingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionHold), args.Error(1)
+}
+
+// ListHolds мок для ListHolds
+func (m *MockHoldRepository) ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionHold), args.Error(1)
+}
+
+// ListExpiredHolds мок для ListExpiredHolds
+func (m *MockHoldRepository) ListExpiredHolds(now time.Time, limit int) ([]*models.TransactionHold, error) {
+	args := m.Called(now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionHold), args.Error(1)
+}
+
+// ResolveHold мок для ResolveHold
+func (m *MockHoldRepository) ResolveHold(h *models.TransactionHold) (bool, error) {
+	args := m.Called(h)
+	return args.Bool(0), args.Error(1)
+}
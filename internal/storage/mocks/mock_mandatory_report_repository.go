@@ -0,0 +1,44 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMandatoryReportRepository является моком для storage.MandatoryReportRepository интерфейса
+type MockMandatoryReportRepository struct {
+	mock.Mock
+}
+
+// ListTransactionsByAccounts мок для ListTransactionsByAccounts
+func (m *MockMandatoryReportRepository) ListTransactionsByAccounts(accountNumbers []string, transactionTypes []string, from, to time.Time) ([]*models.TransactionRecord, error) {
+	args := m.Called(accountNumbers, transactionTypes, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionRecord), args.Error(1)
+}
+
+// EnqueueMandatoryReports мок для EnqueueMandatoryReports
+func (m *MockMandatoryReportRepository) EnqueueMandatoryReports(reports []*models.MandatoryReport) (int, error) {
+	args := m.Called(reports)
+	return args.Int(0), args.Error(1)
+}
+
+// ListMandatoryReports мок для ListMandatoryReports
+func (m *MockMandatoryReportRepository) ListMandatoryReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.MandatoryReport), args.Error(1)
+}
+
+// MarkMandatoryReportsExported мок для MarkMandatoryReportsExported
+func (m *MockMandatoryReportRepository) MarkMandatoryReportsExported(ids []int64, exportedAt time.Time) error {
+	args := m.Called(ids, exportedAt)
+	return args.Error(0)
+}
@@ -18,6 +18,12 @@ func (m *MockTransactionRepository) SaveTransaction(processingID string, tx *mod
 	return args.Error(0)
 }
 
+// SaveTransactions мок для SaveTransactions
+func (m *MockTransactionRepository) SaveTransactions(items []*models.TransactionBatchItem) error {
+	args := m.Called(items)
+	return args.Error(0)
+}
+
 // UpdateTransactionAnalysis мок для UpdateTransactionAnalysis
 func (m *MockTransactionRepository) UpdateTransactionAnalysis(processingID string, riskScore int, riskLevel string, analysisTime time.Time) error {
 	args := m.Called(processingID, riskScore, riskLevel, analysisTime)
@@ -56,3 +62,12 @@ func (m *MockTransactionRepository) ClearAllTransactions() error {
 	args := m.Called()
 	return args.Error(0)
 }
+
+// SearchTransactions мок для SearchTransactions
+func (m *MockTransactionRepository) SearchTransactions(filter models.TransactionSearchFilter) ([]*models.TransactionSummary, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.TransactionSummary), args.Error(1)
+}
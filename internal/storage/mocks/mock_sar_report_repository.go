@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSARReportRepository является моком для storage.SARReportRepository интерфейса
+type MockSARReportRepository struct {
+	mock.Mock
+}
+
+// ListCaseTransactions мок для ListCaseTransactions
+func (m *MockSARReportRepository) ListCaseTransactions(caseID int64) ([]*models.CaseTransaction, error) {
+	args := m.Called(caseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.CaseTransaction), args.Error(1)
+}
+
+// CreateSARReport мок для CreateSARReport
+func (m *MockSARReportRepository) CreateSARReport(r *models.SARReport) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+// GetSARReport мок для GetSARReport
+func (m *MockSARReportRepository) GetSARReport(id int64) (*models.SARReport, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SARReport), args.Error(1)
+}
+
+// ListSARReports мок для ListSARReports
+func (m *MockSARReportRepository) ListSARReports(filter models.SARReportFilter) ([]*models.SARReport, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.SARReport), args.Error(1)
+}
+
+// UpdateSARReport мок для UpdateSARReport
+func (m *MockSARReportRepository) UpdateSARReport(r *models.SARReport) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
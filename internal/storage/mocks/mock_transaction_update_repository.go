@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransactionUpdateRepository является моком для storage.TransactionUpdateRepository интерфейса
+type MockTransactionUpdateRepository struct {
+	mock.Mock
+}
+
+// ListTransactionUpdates мок для ListTransactionUpdates
+func (m *MockTransactionUpdateRepository) ListTransactionUpdates(filter models.TransactionUpdateFilter) (*models.TransactionUpdatePage, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TransactionUpdatePage), args.Error(1)
+}
+
+// GetTransactionUpdateBounds мок для GetTransactionUpdateBounds
+func (m *MockTransactionUpdateRepository) GetTransactionUpdateBounds() (int64, int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+// DeleteTransactionUpdatesBefore мок для DeleteTransactionUpdatesBefore
+func (m *MockTransactionUpdateRepository) DeleteTransactionUpdatesBefore(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
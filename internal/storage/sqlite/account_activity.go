@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"bank-aml-system/internal/models"
+)
+
+// activityPeriodExpressions - группировка итогов по периодам
+// Время хранится в формате time.Time.String, поэтому дата - первые 10 символов по местному времени операции
+var activityPeriodExpressions = map[string]string{
+	models.ActivityPeriodDay:   "substr(timestamp, 1, 10)",
+	models.ActivityPeriodWeek:  "strftime('%Y-W%W', substr(timestamp, 1, 10))",
+	models.ActivityPeriodMonth: "substr(timestamp, 1, 7)",
+}
+
+// GetAccountActivityTotals считает число и сумму операций счета по валютам, странам контрагентов, каналам и периодам
+// Операция учитывается как sent, если счет - счет клиента, и как received, если счет - счет контрагента
+func (s *SQLiteStorage) GetAccountActivityTotals(filter models.AccountActivityFilter) (*models.AccountActivityTotals, error) {
+	periodExpr, ok := activityPeriodExpressions[filter.Period]
+	if !ok {
+		periodExpr = activityPeriodExpressions[models.ActivityPeriodDay]
+	}
+
+	totals := &models.AccountActivityTotals{}
+	groups := []struct {
+		keyExpr string
+		target  *[]*models.ActivityTotal
+	}{
+		{"currency", &totals.ByCurrency},
+		{"COALESCE(counterparty_country, '')", &totals.ByCountry},
+		{"COALESCE(channel, '')", &totals.ByChannel},
+		{periodExpr, &totals.ByPeriod},
+	}
+	for _, group := range groups {
+		items, err := s.accountActivityGroup(filter, group.keyExpr)
+		if err != nil {
+			return nil, err
+		}
+		*group.target = items
+	}
+	return totals, nil
+}
+
+// accountActivityGroup считает итоги операций счета, сгруппированные по направлению, keyExpr и валюте
+func (s *SQLiteStorage) accountActivityGroup(filter models.AccountActivityFilter, keyExpr string) ([]*models.ActivityTotal, error) {
+	conditions := []string{"(account_number = ? OR counterparty_account = ?)"}
+	args := []interface{}{filter.AccountNumber, filter.AccountNumber, filter.AccountNumber}
+	conditions, args = appendTimeRange(conditions, args, filter.From, filter.To)
+
+	query := `
+		SELECT CASE WHEN account_number = ? THEN '` + models.ActivityDirectionSent + `' ELSE '` + models.ActivityDirectionReceived + `' END AS direction,
+		       ` + keyExpr + ` AS group_key, currency, COUNT(*), COALESCE(SUM(amount), 0)
+		FROM transactions` + whereClause(conditions) + `
+		GROUP BY direction, group_key, currency
+		ORDER BY direction DESC, group_key, currency
+	`
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []*models.ActivityTotal{}
+	for rows.Next() {
+		var t models.ActivityTotal
+		if err := rows.Scan(&t.Direction, &t.Key, &t.Currency, &t.Count, &t.Amount); err != nil {
+			return nil, err
+		}
+		result = append(result, &t)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,199 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// SaveCustomer создает или обновляет клиента
+func (s *SQLiteStorage) SaveCustomer(customer *models.Customer) error {
+	query := `
+		INSERT INTO customers (
+			customer_id, full_name, segment, residency, country, kyc_risk_rating
+		) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(customer_id) DO UPDATE SET
+			full_name = excluded.full_name,
+			segment = excluded.segment,
+			residency = excluded.residency,
+			country = excluded.country,
+			kyc_risk_rating = excluded.kyc_risk_rating,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(
+			query,
+			customer.CustomerID, customer.FullName, customer.Segment,
+			customer.Residency, customer.Country, customer.KYCRiskRating,
+		)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetCustomer получает клиента по customer_id
+func (s *SQLiteStorage) GetCustomer(customerID string) (*models.Customer, error) {
+	query := `
+		SELECT customer_id, full_name, segment, residency, COALESCE(country, ''),
+		       kyc_risk_rating, created_at, updated_at
+		FROM customers
+		WHERE customer_id = ?
+	`
+
+	var c models.Customer
+	err := s.DB.QueryRow(query, customerID).Scan(
+		&c.CustomerID, &c.FullName, &c.Segment, &c.Residency, &c.Country,
+		&c.KYCRiskRating, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// ListCustomers получает список клиентов
+func (s *SQLiteStorage) ListCustomers(limit int) ([]*models.Customer, error) {
+	query := `
+		SELECT customer_id, full_name, segment, residency, COALESCE(country, ''),
+		       kyc_risk_rating, created_at, updated_at
+		FROM customers
+		ORDER BY created_at DESC
+		LIMIT ?
+	`
+
+	rows, err := s.DB.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var customers []*models.Customer
+	for rows.Next() {
+		var c models.Customer
+		if err := rows.Scan(
+			&c.CustomerID, &c.FullName, &c.Segment, &c.Residency, &c.Country,
+			&c.KYCRiskRating, &c.CreatedAt, &c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		customers = append(customers, &c)
+	}
+
+	return customers, rows.Err()
+}
+
+// SaveAccount создает или обновляет счет
+func (s *SQLiteStorage) SaveAccount(account *models.Account) error {
+	query := `
+		INSERT INTO accounts (
+			account_number, customer_id, currency, opened_at, declared_turnover, status
+		) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(account_number) DO UPDATE SET
+			customer_id = excluded.customer_id,
+			currency = excluded.currency,
+			opened_at = excluded.opened_at,
+			declared_turnover = excluded.declared_turnover,
+			status = excluded.status,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(
+			query,
+			account.AccountNumber, account.CustomerID, account.Currency,
+			account.OpenedAt, account.DeclaredTurnover, account.Status,
+		)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetAccount получает счет по номеру
+func (s *SQLiteStorage) GetAccount(accountNumber string) (*models.Account, error) {
+	query := `
+		SELECT account_number, customer_id, COALESCE(currency, ''), opened_at,
+		       declared_turnover, status, created_at, updated_at
+		FROM accounts
+		WHERE account_number = ?
+	`
+
+	var a models.Account
+	err := s.DB.QueryRow(query, accountNumber).Scan(
+		&a.AccountNumber, &a.CustomerID, &a.Currency, &a.OpenedAt,
+		&a.DeclaredTurnover, &a.Status, &a.CreatedAt, &a.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+// ListAccounts получает счета клиента
+func (s *SQLiteStorage) ListAccounts(customerID string) ([]*models.Account, error) {
+	query := `
+		SELECT account_number, customer_id, COALESCE(currency, ''), opened_at,
+		       declared_turnover, status, created_at, updated_at
+		FROM accounts
+		WHERE customer_id = ?
+		ORDER BY opened_at
+	`
+
+	rows, err := s.DB.Query(query, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*models.Account
+	for rows.Next() {
+		var a models.Account
+		if err := rows.Scan(
+			&a.AccountNumber, &a.CustomerID, &a.Currency, &a.OpenedAt,
+			&a.DeclaredTurnover, &a.Status, &a.CreatedAt, &a.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, &a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// GetAccountProfile получает счет вместе с данными владельца
+func (s *SQLiteStorage) GetAccountProfile(accountNumber string) (*models.AccountProfile, error) {
+	account, err := s.GetAccount(accountNumber)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	customer, err := s.GetCustomer(account.CustomerID)
+	if err != nil || customer == nil {
+		return nil, err
+	}
+
+	return &models.AccountProfile{Account: *account, Customer: *customer}, nil
+}
+
+// GetAccountTurnover возвращает сумму исходящих операций по счету начиная с since
+// Оцениваемая операция к этому моменту уже сохранена, поэтому ее (и ее повторные отправки) исключают по excludeTransactionID:
+// вызывающая сторона прибавляет ее сумму сама. Пополнения (deposit) в исходящий оборот не входят.
+// Суммы складываются без конвертации валют - для оценки порядка оборота этого достаточно
+func (s *SQLiteStorage) GetAccountTurnover(accountNumber, excludeTransactionID string, since time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM transactions
+		WHERE account_number = ? AND timestamp_utc >= ? AND transaction_id != ? AND transaction_type != 'deposit'
+	`
+
+	var turnover float64
+	err := s.DB.QueryRow(query, accountNumber, transactionInstant(since), excludeTransactionID).Scan(&turnover)
+	return turnover, err
+}
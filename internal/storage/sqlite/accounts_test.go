@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountTurnover_ComparesInstantsAcrossOffsets(t *testing.T) {
+	storage := newTestStorage(t)
+	moscow := time.FixedZone("MSK", 3*60*60)
+	since := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	txs := map[string]*models.Transaction{
+		// 14:30 MSK = 11:30 UTC: раньше since, хотя текстом больше
+		"proc_before": {TransactionID: "TXN-1", AccountNumber: "ACC123456", Amount: 1000, Currency: "RUB", TransactionType: "transfer", Timestamp: time.Date(2024, 1, 15, 14, 30, 0, 0, moscow)},
+		"proc_after":  {TransactionID: "TXN-2", AccountNumber: "ACC123456", Amount: 2000, Currency: "RUB", TransactionType: "transfer", Timestamp: since.Add(time.Hour)},
+		// 15:30 MSK = 12:30 UTC
+		"proc_offset": {TransactionID: "TXN-3", AccountNumber: "ACC123456", Amount: 4000, Currency: "RUB", TransactionType: "transfer", Timestamp: time.Date(2024, 1, 15, 15, 30, 0, 0, moscow)},
+		"proc_credit": {TransactionID: "TXN-4", AccountNumber: "ACC123456", Amount: 8000, Currency: "RUB", TransactionType: "deposit", Timestamp: since.Add(time.Hour)},
+		"proc_self":   {TransactionID: "TXN-5", AccountNumber: "ACC123456", Amount: 16000, Currency: "RUB", TransactionType: "transfer", Timestamp: since.Add(2 * time.Hour)},
+	}
+	for processingID, tx := range txs {
+		require.NoError(t, storage.SaveTransaction(processingID, tx))
+	}
+
+	turnover, err := storage.GetAccountTurnover("ACC123456", "TXN-5", since)
+	require.NoError(t, err)
+	assert.Equal(t, 6000.0, turnover)
+}
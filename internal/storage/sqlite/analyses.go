@@ -0,0 +1,178 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const analysisVersionColumns = `
+	id, processing_id, version, risk_score, risk_level, recommendation, flags, COALESCE(evidence, ''),
+	COALESCE(ruleset_version, ''), source, COALESCE(requested_by, ''), COALESCE(reason, ''), analyzed_at, created_at,
+	COALESCE(rule_inputs, '')
+`
+
+// SaveAnalysisVersion сохраняет результат анализа следующей версией для операции
+// Номер версии назначается внутри транзакции БД, поэтому параллельные оценки не получают одинаковый номер
+func (s *SQLiteStorage) SaveAnalysisVersion(v *models.AnalysisVersion) error {
+	flags := v.Flags
+	if flags == nil {
+		flags = []string{}
+	}
+	flagsJSON, err := json.Marshal(flags)
+	if err != nil {
+		return err
+	}
+	var evidence string
+	if len(v.Evidence) > 0 {
+		data, err := json.Marshal(v.Evidence)
+		if err != nil {
+			return err
+		}
+		evidence = string(data)
+	}
+	var inputs string
+	if v.Inputs != nil {
+		data, err := json.Marshal(v.Inputs)
+		if err != nil {
+			return err
+		}
+		inputs = string(data)
+	}
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = time.Now()
+	}
+
+	insert := `
+		INSERT INTO transaction_analyses (
+			processing_id, version, risk_score, risk_level, recommendation, flags, evidence,
+			ruleset_version, source, requested_by, reason, analyzed_at, created_at, rule_inputs
+		) VALUES (?, ?, ?, ?, ?, ?, NULLIF(?, ''), NULLIF(?, ''), ?, NULLIF(?, ''), NULLIF(?, ''), ?, ?, NULLIF(?, ''))
+	`
+
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		var version int
+		if err := dbTx.QueryRow(
+			`SELECT COALESCE(MAX(version), 0) + 1 FROM transaction_analyses WHERE processing_id = ?`, v.ProcessingID,
+		).Scan(&version); err != nil {
+			return err
+		}
+
+		result, err := dbTx.Exec(
+			insert,
+			v.ProcessingID, version, v.RiskScore, v.RiskLevel, v.Recommendation, string(flagsJSON), evidence,
+			v.RulesetVersion, v.Source, v.RequestedBy, v.Reason, v.AnalyzedAt.UTC(), v.CreatedAt.UTC(), inputs,
+		)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if err := dbTx.Commit(); err != nil {
+			return err
+		}
+		v.ID = id
+		v.Version = version
+		return nil
+	}, 3, 50*time.Millisecond)
+}
+
+// ListAnalysisVersions получает все версии анализа операции, начиная с первой
+func (s *SQLiteStorage) ListAnalysisVersions(processingID string) ([]*models.AnalysisVersion, error) {
+	query := `SELECT ` + analysisVersionColumns + ` FROM transaction_analyses WHERE processing_id = ? ORDER BY version`
+
+	rows, err := s.DB.Query(query, processingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAnalysisVersions(rows)
+}
+
+// ListProcessingIDsForRescore получает проанализированные операции, подходящие под фильтр, начиная с последних
+func (s *SQLiteStorage) ListProcessingIDsForRescore(filter models.RescoreFilter) ([]string, error) {
+	conditions := []string{"analysis_timestamp IS NOT NULL"}
+	var args []interface{}
+	// Время операций хранится с исходным часовым поясом: выборка берется с запасом и уточняется после чтения
+	if filter.From != nil {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, filter.From.Add(-24*time.Hour))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "timestamp < ?")
+		args = append(args, filter.To.Add(24*time.Hour))
+	}
+	if filter.RiskLevel != "" {
+		conditions = append(conditions, "risk_level = ?")
+		args = append(args, filter.RiskLevel)
+	}
+	if filter.AccountNumber != "" {
+		conditions = append(conditions, "account_number = ?")
+		args = append(args, filter.AccountNumber)
+	}
+
+	query := `SELECT processing_id, timestamp FROM transactions` + whereClause(conditions) + ` ORDER BY timestamp DESC, id DESC`
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		var ts time.Time
+		if err := rows.Scan(&id, &ts); err != nil {
+			return nil, err
+		}
+		if (filter.From != nil && ts.Before(*filter.From)) || (filter.To != nil && !ts.Before(*filter.To)) {
+			continue
+		}
+		ids = append(ids, id)
+		if filter.Limit > 0 && len(ids) >= filter.Limit {
+			break
+		}
+	}
+	return ids, rows.Err()
+}
+
+func scanAnalysisVersions(rows *sql.Rows) ([]*models.AnalysisVersion, error) {
+	var versions []*models.AnalysisVersion
+	for rows.Next() {
+		var v models.AnalysisVersion
+		var flags, evidence, inputs string
+		if err := rows.Scan(
+			&v.ID, &v.ProcessingID, &v.Version, &v.RiskScore, &v.RiskLevel, &v.Recommendation, &flags, &evidence,
+			&v.RulesetVersion, &v.Source, &v.RequestedBy, &v.Reason, &v.AnalyzedAt, &v.CreatedAt, &inputs,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(flags), &v.Flags); err != nil {
+			return nil, err
+		}
+		if evidence != "" {
+			if err := json.Unmarshal([]byte(evidence), &v.Evidence); err != nil {
+				return nil, err
+			}
+		}
+		if inputs != "" {
+			if err := json.Unmarshal([]byte(inputs), &v.Inputs); err != nil {
+				return nil, err
+			}
+		}
+		versions = append(versions, &v)
+	}
+	return versions, rows.Err()
+}
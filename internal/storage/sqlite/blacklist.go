@@ -0,0 +1,260 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const blacklistEntryColumns = `
+	id, list_type, COALESCE(account_number, ''), COALESCE(name, ''), reason, added_by,
+	lookback_from, screened_at, match_count, created_at
+`
+
+const blacklistMatchColumns = `
+	id, entry_id, processing_id, transaction_id, account_number, COALESCE(counterparty_account, ''),
+	amount, currency, transaction_time, matched_on, COALESCE(alert_id, 0), COALESCE(case_id, 0), created_at
+`
+
+// CreateBlacklistEntry сохраняет запись черного или санкционного списка
+func (s *SQLiteStorage) CreateBlacklistEntry(e *models.BlacklistEntry) error {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	query := `
+		INSERT INTO blacklist_entries (list_type, account_number, name, reason, added_by, lookback_from, created_at)
+		VALUES (?, NULLIF(?, ''), NULLIF(?, ''), ?, ?, ?, ?)
+	`
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			query,
+			e.ListType, e.AccountNumber, e.Name, e.Reason, e.AddedBy, e.LookbackFrom.UTC(), e.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+		e.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetBlacklistEntry получает запись списка по ID
+func (s *SQLiteStorage) GetBlacklistEntry(id int64) (*models.BlacklistEntry, error) {
+	rows, err := s.DB.Query(`SELECT `+blacklistEntryColumns+` FROM blacklist_entries WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries, err := scanBlacklistEntries(rows)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return entries[0], nil
+}
+
+// ListBlacklistEntries получает записи списков по фильтру, начиная с последних
+func (s *SQLiteStorage) ListBlacklistEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.ListType != "" {
+		conditions = append(conditions, "list_type = ?")
+		args = append(args, filter.ListType)
+	}
+	if filter.AccountNumber != "" {
+		conditions = append(conditions, `(account_number = ? OR LOWER(TRIM(name)) IN (
+			SELECT LOWER(TRIM(c.full_name))
+			FROM accounts a
+			JOIN customers c ON c.customer_id = a.customer_id
+			WHERE a.account_number = ?
+		))`)
+		args = append(args, filter.AccountNumber, filter.AccountNumber)
+	}
+
+	query := `SELECT ` + blacklistEntryColumns + ` FROM blacklist_entries` + whereClause(conditions) + ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBlacklistEntries(rows)
+}
+
+// ListAccountNumbersByCustomerName получает счета клиентов, полное имя которых совпадает с name без учета регистра
+func (s *SQLiteStorage) ListAccountNumbersByCustomerName(name string) ([]string, error) {
+	query := `
+		SELECT a.account_number
+		FROM accounts a
+		JOIN customers c ON c.customer_id = a.customer_id
+		WHERE LOWER(TRIM(c.full_name)) = LOWER(TRIM(?))
+		ORDER BY a.account_number
+	`
+
+	rows, err := s.DB.Query(query, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var numbers []string
+	for rows.Next() {
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, rows.Err()
+}
+
+// ListTransactionsInvolvingAccounts получает операции с since, в которых счета участвуют
+// как счет клиента или как счет контрагента
+func (s *SQLiteStorage) ListTransactionsInvolvingAccounts(accountNumbers []string, since time.Time) ([]*models.TransactionRecord, error) {
+	if len(accountNumbers) == 0 {
+		return nil, nil
+	}
+
+	var args []interface{}
+	for _, number := range accountNumbers {
+		args = append(args, number)
+	}
+	for _, number := range accountNumbers {
+		args = append(args, number)
+	}
+	// Время операций хранится с исходным часовым поясом: выборка берется с запасом и уточняется после чтения
+	args = append(args, since.Add(-24*time.Hour))
+
+	query := `
+		SELECT processing_id, transaction_id, account_number, amount, currency, transaction_type,
+		       COALESCE(counterparty_account, ''), COALESCE(counterparty_bank, ''),
+		       COALESCE(counterparty_country, ''), timestamp, COALESCE(channel, ''),
+		       COALESCE(user_id, ''), COALESCE(branch_id, '')
+		FROM transactions
+		WHERE (account_number IN (` + placeholders(len(accountNumbers)) + `)
+		    OR counterparty_account IN (` + placeholders(len(accountNumbers)) + `))
+		  AND timestamp >= ?
+		ORDER BY timestamp, id
+	`
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.TransactionRecord
+	for rows.Next() {
+		var r models.TransactionRecord
+		tx := &r.Transaction
+		if err := rows.Scan(
+			&r.ProcessingID, &tx.TransactionID, &tx.AccountNumber, &tx.Amount, &tx.Currency, &tx.TransactionType,
+			&tx.CounterpartyAccount, &tx.CounterpartyBank,
+			&tx.CounterpartyCountry, &tx.Timestamp, &tx.Channel,
+			&tx.UserID, &tx.BranchID,
+		); err != nil {
+			return nil, err
+		}
+		if tx.Timestamp.Before(since) {
+			continue
+		}
+		result = append(result, &r)
+	}
+
+	return result, rows.Err()
+}
+
+// SaveBlacklistMatches сохраняет результат ретроспективной проверки записи
+// Повторно найденные операции не дублируются; match_count отражает общее число совпадений
+func (s *SQLiteStorage) SaveBlacklistMatches(entryID int64, matches []*models.BlacklistMatch, screenedAt time.Time) error {
+	insert := `
+		INSERT OR IGNORE INTO blacklist_matches (
+			entry_id, processing_id, transaction_id, account_number, counterparty_account,
+			amount, currency, transaction_time, matched_on, alert_id, case_id, created_at
+		) VALUES (?, ?, ?, ?, NULLIF(?, ''), ?, ?, ?, ?, NULLIF(?, 0), NULLIF(?, 0), ?)
+	`
+	update := `
+		UPDATE blacklist_entries
+		SET screened_at = ?, match_count = (SELECT COUNT(*) FROM blacklist_matches WHERE entry_id = ?)
+		WHERE id = ?
+	`
+
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		for _, m := range matches {
+			m.EntryID = entryID
+			if m.CreatedAt.IsZero() {
+				m.CreatedAt = screenedAt
+			}
+			result, err := dbTx.Exec(
+				insert,
+				m.EntryID, m.ProcessingID, m.TransactionID, m.AccountNumber, m.CounterpartyAccount,
+				m.Amount, m.Currency, m.TransactionTime.UTC(), m.MatchedOn, m.AlertID, m.CaseID, m.CreatedAt.UTC(),
+			)
+			if err != nil {
+				return err
+			}
+			if n, err := result.RowsAffected(); err == nil && n > 0 {
+				m.ID, _ = result.LastInsertId()
+			}
+		}
+
+		if _, err := dbTx.Exec(update, screenedAt.UTC(), entryID, entryID); err != nil {
+			return err
+		}
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+}
+
+// ListBlacklistMatches получает совпадения ретроспективной проверки записи в хронологическом порядке операций
+func (s *SQLiteStorage) ListBlacklistMatches(entryID int64) ([]*models.BlacklistMatch, error) {
+	query := `SELECT ` + blacklistMatchColumns + ` FROM blacklist_matches WHERE entry_id = ? ORDER BY transaction_time, id`
+
+	rows, err := s.DB.Query(query, entryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []*models.BlacklistMatch
+	for rows.Next() {
+		var m models.BlacklistMatch
+		if err := rows.Scan(
+			&m.ID, &m.EntryID, &m.ProcessingID, &m.TransactionID, &m.AccountNumber, &m.CounterpartyAccount,
+			&m.Amount, &m.Currency, &m.TransactionTime, &m.MatchedOn, &m.AlertID, &m.CaseID, &m.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		matches = append(matches, &m)
+	}
+	return matches, rows.Err()
+}
+
+func scanBlacklistEntries(rows *sql.Rows) ([]*models.BlacklistEntry, error) {
+	var entries []*models.BlacklistEntry
+	for rows.Next() {
+		var e models.BlacklistEntry
+		var screenedAt sql.NullTime
+		if err := rows.Scan(
+			&e.ID, &e.ListType, &e.AccountNumber, &e.Name, &e.Reason, &e.AddedBy,
+			&e.LookbackFrom, &screenedAt, &e.MatchCount, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if screenedAt.Valid {
+			e.ScreenedAt = &screenedAt.Time
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,383 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const caseColumns = `
+	c.id, c.account_number, c.status, COALESCE(c.assigned_to, ''), COALESCE(c.resolution, ''),
+	c.max_risk_score, (SELECT COUNT(*) FROM alerts a WHERE a.case_id = c.id),
+	c.created_at, c.updated_at, c.closed_at
+`
+
+const alertColumns = `
+	id, case_id, processing_id, transaction_id, account_number, risk_score, risk_level,
+	COALESCE(recommendation, ''), flags, status, created_at
+`
+
+// SaveAlert сохраняет алерт, привязанный к кейсу
+func (s *SQLiteStorage) SaveAlert(alert *models.Alert) error {
+	flags, err := json.Marshal(alert.Flags)
+	if err != nil {
+		return err
+	}
+	if alert.Status == "" {
+		alert.Status = models.AlertStatusOpen
+	}
+	if alert.CreatedAt.IsZero() {
+		alert.CreatedAt = time.Now()
+	}
+
+	query := `
+		INSERT INTO alerts (
+			case_id, processing_id, transaction_id, account_number, risk_score,
+			risk_level, recommendation, flags, status, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			query,
+			alert.CaseID, alert.ProcessingID, alert.TransactionID, alert.AccountNumber, alert.RiskScore,
+			alert.RiskLevel, alert.Recommendation, string(flags), alert.Status, alert.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		alert.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// UpdateAlert обновляет оценку, рекомендацию, флаги и статус алерта
+func (s *SQLiteStorage) UpdateAlert(alert *models.Alert) error {
+	flags, err := json.Marshal(alert.Flags)
+	if err != nil {
+		return err
+	}
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(`
+			UPDATE alerts
+			SET risk_score = ?, risk_level = ?, recommendation = ?, flags = ?, status = ?
+			WHERE id = ?
+		`, alert.RiskScore, alert.RiskLevel, alert.Recommendation, string(flags), alert.Status, alert.ID)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetAlert получает алерт по ID
+func (s *SQLiteStorage) GetAlert(id int64) (*models.Alert, error) {
+	rows, err := s.DB.Query(`SELECT `+alertColumns+` FROM alerts WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts, err := scanAlerts(rows)
+	if err != nil || len(alerts) == 0 {
+		return nil, err
+	}
+	return alerts[0], nil
+}
+
+// GetAlertByProcessingID получает алерт по processing_id транзакции
+func (s *SQLiteStorage) GetAlertByProcessingID(processingID string) (*models.Alert, error) {
+	rows, err := s.DB.Query(`SELECT `+alertColumns+` FROM alerts WHERE processing_id = ?`, processingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	alerts, err := scanAlerts(rows)
+	if err != nil || len(alerts) == 0 {
+		return nil, err
+	}
+	return alerts[0], nil
+}
+
+// ListAlerts получает алерты по фильтру, начиная с последних
+func (s *SQLiteStorage) ListAlerts(filter models.AlertFilter) ([]*models.Alert, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.AccountNumber != "" {
+		conditions = append(conditions, "account_number = ?")
+		args = append(args, filter.AccountNumber)
+	}
+
+	query := `SELECT ` + alertColumns + ` FROM alerts` + whereClause(conditions) + ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAlerts(rows)
+}
+
+// ListCaseAlerts получает алерты кейса в хронологическом порядке
+func (s *SQLiteStorage) ListCaseAlerts(caseID int64) ([]*models.Alert, error) {
+	rows, err := s.DB.Query(`SELECT `+alertColumns+` FROM alerts WHERE case_id = ? ORDER BY created_at, id`, caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAlerts(rows)
+}
+
+// CreateCase создает кейс
+func (s *SQLiteStorage) CreateCase(c *models.Case) error {
+	if c.Status == "" {
+		c.Status = models.CaseStatusOpen
+	}
+	now := time.Now()
+	c.CreatedAt, c.UpdatedAt = now, now
+
+	query := `
+		INSERT INTO cases (account_number, status, assigned_to, resolution, max_risk_score, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(query, c.AccountNumber, c.Status, c.AssignedTo, c.Resolution, c.MaxRiskScore, c.CreatedAt, c.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		c.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetCase получает кейс по ID
+func (s *SQLiteStorage) GetCase(id int64) (*models.Case, error) {
+	rows, err := s.DB.Query(`SELECT `+caseColumns+` FROM cases c WHERE c.id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cases, err := scanCases(rows)
+	if err != nil || len(cases) == 0 {
+		return nil, err
+	}
+	return cases[0], nil
+}
+
+// GetActiveCaseByAccount получает последний незакрытый кейс по счету
+func (s *SQLiteStorage) GetActiveCaseByAccount(accountNumber string) (*models.Case, error) {
+	query := `SELECT ` + caseColumns + ` FROM cases c
+		WHERE c.account_number = ? AND c.status != ?
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT 1`
+
+	rows, err := s.DB.Query(query, accountNumber, models.CaseStatusClosed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cases, err := scanCases(rows)
+	if err != nil || len(cases) == 0 {
+		return nil, err
+	}
+	return cases[0], nil
+}
+
+// ListCases получает кейсы по фильтру, начиная с последних обновленных
+func (s *SQLiteStorage) ListCases(filter models.CaseFilter) ([]*models.Case, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Status != "" {
+		conditions = append(conditions, "c.status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.AssignedTo != "" {
+		conditions = append(conditions, "c.assigned_to = ?")
+		args = append(args, filter.AssignedTo)
+	}
+	if filter.AccountNumber != "" {
+		conditions = append(conditions, "c.account_number = ?")
+		args = append(args, filter.AccountNumber)
+	}
+
+	query := `SELECT ` + caseColumns + ` FROM cases c` + whereClause(conditions) + ` ORDER BY c.updated_at DESC, c.id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCases(rows)
+}
+
+// UpdateCase обновляет кейс; при закрытии закрываются и все его алерты
+func (s *SQLiteStorage) UpdateCase(c *models.Case) error {
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		if _, err := dbTx.Exec(`
+			UPDATE cases
+			SET status = ?, assigned_to = ?, resolution = ?, max_risk_score = ?,
+			    closed_at = ?, updated_at = ?
+			WHERE id = ?
+		`, c.Status, c.AssignedTo, c.Resolution, c.MaxRiskScore, c.ClosedAt, time.Now(), c.ID); err != nil {
+			return err
+		}
+
+		alertStatus := models.AlertStatusOpen
+		if c.Status == models.CaseStatusClosed {
+			alertStatus = models.AlertStatusClosed
+		}
+		if _, err := dbTx.Exec(`UPDATE alerts SET status = ? WHERE case_id = ?`, alertStatus, c.ID); err != nil {
+			return err
+		}
+
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+}
+
+// AddCaseNote добавляет заметку к кейсу
+func (s *SQLiteStorage) AddCaseNote(note *models.CaseNote) error {
+	note.CreatedAt = time.Now()
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			`INSERT INTO case_notes (case_id, author, text, created_at) VALUES (?, ?, ?, ?)`,
+			note.CaseID, note.Author, note.Text, note.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		note.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// ListCaseNotes получает заметки кейса в хронологическом порядке
+func (s *SQLiteStorage) ListCaseNotes(caseID int64) ([]*models.CaseNote, error) {
+	rows, err := s.DB.Query(
+		`SELECT id, case_id, author, text, created_at FROM case_notes WHERE case_id = ? ORDER BY created_at, id`,
+		caseID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []*models.CaseNote
+	for rows.Next() {
+		var n models.CaseNote
+		if err := rows.Scan(&n.ID, &n.CaseID, &n.Author, &n.Text, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		notes = append(notes, &n)
+	}
+
+	return notes, rows.Err()
+}
+
+// AddCaseAttachment добавляет ссылку на документ к кейсу
+func (s *SQLiteStorage) AddCaseAttachment(attachment *models.CaseAttachment) error {
+	attachment.CreatedAt = time.Now()
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			`INSERT INTO case_attachments (case_id, name, reference, added_by, created_at) VALUES (?, ?, ?, ?, ?)`,
+			attachment.CaseID, attachment.Name, attachment.Reference, attachment.AddedBy, attachment.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		attachment.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// ListCaseAttachments получает вложения кейса в хронологическом порядке
+func (s *SQLiteStorage) ListCaseAttachments(caseID int64) ([]*models.CaseAttachment, error) {
+	rows, err := s.DB.Query(
+		`SELECT id, case_id, name, reference, added_by, created_at FROM case_attachments WHERE case_id = ? ORDER BY created_at, id`,
+		caseID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []*models.CaseAttachment
+	for rows.Next() {
+		var a models.CaseAttachment
+		if err := rows.Scan(&a.ID, &a.CaseID, &a.Name, &a.Reference, &a.AddedBy, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, &a)
+	}
+
+	return attachments, rows.Err()
+}
+
+func scanCases(rows *sql.Rows) ([]*models.Case, error) {
+	var cases []*models.Case
+	for rows.Next() {
+		var c models.Case
+		var closedAt sql.NullTime
+		if err := rows.Scan(
+			&c.ID, &c.AccountNumber, &c.Status, &c.AssignedTo, &c.Resolution,
+			&c.MaxRiskScore, &c.AlertCount, &c.CreatedAt, &c.UpdatedAt, &closedAt,
+		); err != nil {
+			return nil, err
+		}
+		if closedAt.Valid {
+			c.ClosedAt = &closedAt.Time
+		}
+		cases = append(cases, &c)
+	}
+
+	return cases, rows.Err()
+}
+
+func scanAlerts(rows *sql.Rows) ([]*models.Alert, error) {
+	var alerts []*models.Alert
+	for rows.Next() {
+		var a models.Alert
+		var flags string
+		if err := rows.Scan(
+			&a.ID, &a.CaseID, &a.ProcessingID, &a.TransactionID, &a.AccountNumber, &a.RiskScore,
+			&a.RiskLevel, &a.Recommendation, &flags, &a.Status, &a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(flags), &a.Flags); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, &a)
+	}
+
+	return alerts, rows.Err()
+}
+
+// whereClause собирает условия фильтра в WHERE
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
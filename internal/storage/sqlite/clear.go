@@ -1,9 +1,48 @@
 package sqlite
 
-// ClearAllTransactions удаляет все транзакции из БД
-func (s *SQLiteStorage) ClearAllTransactions() error {
-	query := `DELETE FROM transactions`
-	_, err := s.DB.Exec(query)
-	return err
+import (
+	"fmt"
+	"time"
+)
+
+// clearedTables - таблицы, которые очищаются вместе с транзакциями, в порядке удаления:
+// записи удаляются раньше записей, на которые они ссылаются. Реестр клиентов и счетов и черный список сохраняются
+var clearedTables = []string{
+	"alerts",
+	"case_notes",
+	"case_attachments",
+	"sar_reports",
+	"blacklist_matches",
+	"cases",
+	"transaction_flags",
+	"dispositions",
+	"transaction_holds",
+	"decision_approvals",
+	"decision_history",
+	"transaction_decisions",
+	"transaction_analyses",
+	"mandatory_reports",
+	"flow_cycle_accounts",
+	"flow_cycles",
+	"transaction_updates",
+	"transactions",
 }
 
+// ClearAllTransactions удаляет все транзакции вместе с кейсами, алертами, решениями, анализами и отчетами по ним
+// Все таблицы очищаются одной транзакцией БД, чтобы не осталось записей, ссылающихся на удаленные операции
+func (s *SQLiteStorage) ClearAllTransactions() error {
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		for _, table := range clearedTables {
+			if _, err := dbTx.Exec(`DELETE FROM ` + table); err != nil {
+				return fmt.Errorf("failed to clear %s: %w", table, err)
+			}
+		}
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+}
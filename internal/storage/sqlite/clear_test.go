@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearAllTransactions_RemovesDerivedData(t *testing.T) {
+	storage := newTestStorage(t)
+	now := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+
+	require.NoError(t, storage.SaveTransaction("proc_1", &models.Transaction{
+		TransactionID: "TXN-1", AccountNumber: "ACC123456", Amount: 1000, Currency: "RUB",
+		TransactionType: "transfer", Timestamp: now,
+	}))
+	require.NoError(t, storage.SaveFlowCycle(testFlowCycle()))
+
+	seed := []string{
+		`INSERT INTO customers (customer_id, full_name, segment, residency, kyc_risk_rating) VALUES ('CUST-1', 'Customer', 'retail', 'resident', 'low')`,
+		`INSERT INTO cases (id, account_number) VALUES (1, 'ACC123456')`,
+		`INSERT INTO alerts (case_id, processing_id, transaction_id, account_number, risk_score, risk_level) VALUES (1, 'proc_1', 'TXN-1', 'ACC123456', 80, 'high')`,
+		`INSERT INTO case_notes (case_id, author, text) VALUES (1, 'alice', 'note')`,
+		`INSERT INTO case_attachments (case_id, name, reference, added_by) VALUES (1, 'doc', 'ref', 'alice')`,
+		`INSERT INTO sar_reports (case_id, report_code, reference_number, created_by, xml) VALUES (1, 'STR', 'REF-1', 'alice', x'00')`,
+		`INSERT INTO blacklist_entries (id, list_type, account_number, reason, added_by, lookback_from) VALUES (1, 'internal', 'ACC123456', 'fraud', 'bob', '2024-01-01')`,
+		`INSERT INTO blacklist_matches (entry_id, processing_id, transaction_id, account_number, amount, currency, transaction_time, matched_on) VALUES (1, 'proc_1', 'TXN-1', 'ACC123456', 1000, 'RUB', '2024-01-15', 'account')`,
+		`INSERT INTO transaction_flags (processing_id, flag, ruleset_version, flagged_at) VALUES ('proc_1', 'new_beneficiary', 'v1', '2024-01-15')`,
+		`INSERT INTO dispositions (processing_id, disposition, analyst) VALUES ('proc_1', 'true_positive', 'alice')`,
+		`INSERT INTO transaction_decisions (processing_id, decision, actor) VALUES ('proc_1', 'held', 'system')`,
+		`INSERT INTO decision_history (processing_id, from_decision, to_decision, actor) VALUES ('proc_1', 'pending', 'held', 'system')`,
+		`INSERT INTO decision_approvals (processing_id, from_decision, to_decision, requested_by, reason, expires_at) VALUES ('proc_1', 'held', 'blocked', 'alice', 'fraud', '2024-01-16')`,
+		`INSERT INTO transaction_holds (processing_id, expiry_action, expires_at) VALUES ('proc_1', 'blocked', '2024-01-16')`,
+		`INSERT INTO transaction_analyses (processing_id, version, risk_score, risk_level, recommendation, flags, source, analyzed_at) VALUES ('proc_1', 1, 80, 'high', 'require_verification', '[]', 'consumer', '2024-01-15')`,
+		`INSERT INTO mandatory_reports (rule, processing_id, transaction_id, account_number, transaction_type, amount, currency, amount_local, business_date, transaction_time) VALUES ('cash', 'proc_1', 'TXN-1', 'ACC123456', 'withdrawal', 1000, 'RUB', 1000, '2024-01-15', '2024-01-15')`,
+	}
+	for _, query := range seed {
+		_, err := storage.DB.Exec(query)
+		require.NoError(t, err, query)
+	}
+
+	require.NoError(t, storage.ClearAllTransactions())
+
+	for _, table := range []string{
+		"transactions", "cases", "alerts", "case_notes", "case_attachments", "sar_reports", "blacklist_matches",
+		"transaction_flags", "dispositions", "transaction_decisions", "decision_history", "decision_approvals",
+		"transaction_holds", "transaction_analyses", "mandatory_reports", "flow_cycles", "flow_cycle_accounts",
+		"transaction_updates",
+	} {
+		assert.Zero(t, countRows(t, storage, table), table)
+	}
+
+	// Реестр клиентов и черный список не очищаются
+	assert.Equal(t, 1, countRows(t, storage, "customers"))
+	assert.Equal(t, 1, countRows(t, storage, "blacklist_entries"))
+}
+
+func countRows(t *testing.T, storage *SQLiteStorage, table string) int {
+	t.Helper()
+	var count int
+	require.NoError(t, storage.DB.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&count))
+	return count
+}
@@ -0,0 +1,174 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const approvalColumns = `
+	id, processing_id, from_decision, to_decision, requested_by, reason, status,
+	COALESCE(resolved_by, ''), COALESCE(comment, ''), expires_at, created_at, resolved_at
+`
+
+// CreateDecisionApproval сохраняет запрос на подтверждение решения
+func (s *SQLiteStorage) CreateDecisionApproval(a *models.DecisionApproval) error {
+	if a.Status == "" {
+		a.Status = models.ApprovalStatusPending
+	}
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+
+	query := `
+		INSERT INTO decision_approvals (
+			processing_id, from_decision, to_decision, requested_by, reason, status, expires_at, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			query,
+			a.ProcessingID, a.FromDecision, a.ToDecision, a.RequestedBy, a.Reason, a.Status,
+			a.ExpiresAt.UTC(), a.CreatedAt.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+		a.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetDecisionApproval получает запрос на подтверждение по ID
+func (s *SQLiteStorage) GetDecisionApproval(id int64) (*models.DecisionApproval, error) {
+	rows, err := s.DB.Query(`SELECT `+approvalColumns+` FROM decision_approvals WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	approvals, err := scanApprovals(rows)
+	if err != nil || len(approvals) == 0 {
+		return nil, err
+	}
+	return approvals[0], nil
+}
+
+// GetPendingDecisionApproval получает ожидающий подтверждения запрос по транзакции
+func (s *SQLiteStorage) GetPendingDecisionApproval(processingID string) (*models.DecisionApproval, error) {
+	query := `SELECT ` + approvalColumns + ` FROM decision_approvals
+		WHERE processing_id = ? AND status = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1`
+
+	rows, err := s.DB.Query(query, processingID, models.ApprovalStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	approvals, err := scanApprovals(rows)
+	if err != nil || len(approvals) == 0 {
+		return nil, err
+	}
+	return approvals[0], nil
+}
+
+// ListDecisionApprovals получает запросы на подтверждение по фильтру, начиная с последних
+func (s *SQLiteStorage) ListDecisionApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.ProcessingID != "" {
+		conditions = append(conditions, "processing_id = ?")
+		args = append(args, filter.ProcessingID)
+	}
+
+	query := `SELECT ` + approvalColumns + ` FROM decision_approvals` + whereClause(conditions) + ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanApprovals(rows)
+}
+
+// ResolveDecisionApproval переводит ожидающий запрос в итоговый статус
+// Возвращает false, если запрос уже был обработан
+func (s *SQLiteStorage) ResolveDecisionApproval(a *models.DecisionApproval) (bool, error) {
+	resolvedAt := time.Now()
+	if a.ResolvedAt != nil {
+		resolvedAt = *a.ResolvedAt
+	}
+
+	query := `
+		UPDATE decision_approvals
+		SET status = ?, resolved_by = ?, comment = ?, resolved_at = ?
+		WHERE id = ? AND status = ?
+	`
+
+	var affected int64
+	err := retryOperation(func() error {
+		result, err := s.DB.Exec(query, a.Status, a.ResolvedBy, a.Comment, resolvedAt.UTC(), a.ID, models.ApprovalStatusPending)
+		if err != nil {
+			return err
+		}
+		affected, err = result.RowsAffected()
+		return err
+	}, 3, 50*time.Millisecond)
+	if err != nil {
+		return false, err
+	}
+	if affected > 0 {
+		a.ResolvedAt = &resolvedAt
+	}
+	return affected > 0, nil
+}
+
+// ExpireDecisionApprovals переводит в статус expired запросы, не подтвержденные до now
+func (s *SQLiteStorage) ExpireDecisionApprovals(now time.Time) (int64, error) {
+	query := `
+		UPDATE decision_approvals
+		SET status = ?, resolved_at = ?
+		WHERE status = ? AND expires_at <= ?
+	`
+
+	var affected int64
+	err := retryOperation(func() error {
+		result, err := s.DB.Exec(query, models.ApprovalStatusExpired, now.UTC(), models.ApprovalStatusPending, now.UTC())
+		if err != nil {
+			return err
+		}
+		affected, err = result.RowsAffected()
+		return err
+	}, 3, 50*time.Millisecond)
+	return affected, err
+}
+
+func scanApprovals(rows *sql.Rows) ([]*models.DecisionApproval, error) {
+	var approvals []*models.DecisionApproval
+	for rows.Next() {
+		var a models.DecisionApproval
+		var resolvedAt sql.NullTime
+		if err := rows.Scan(
+			&a.ID, &a.ProcessingID, &a.FromDecision, &a.ToDecision, &a.RequestedBy, &a.Reason, &a.Status,
+			&a.ResolvedBy, &a.Comment, &a.ExpiresAt, &a.CreatedAt, &resolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		if resolvedAt.Valid {
+			a.ResolvedAt = &resolvedAt.Time
+		}
+		approvals = append(approvals, &a)
+	}
+
+	return approvals, rows.Err()
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// GetDecision получает текущее решение по транзакции
+// Возвращает nil, если решение еще не принималось (транзакция в статусе pending)
+func (s *SQLiteStorage) GetDecision(processingID string) (*models.TransactionDecision, error) {
+	query := `
+		SELECT processing_id, decision, actor, COALESCE(reason, ''), updated_at
+		FROM transaction_decisions
+		WHERE processing_id = ?
+	`
+
+	var d models.TransactionDecision
+	err := s.DB.QueryRow(query, processingID).Scan(&d.ProcessingID, &d.Decision, &d.Actor, &d.Reason, &d.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// ApplyDecision меняет решение по транзакции и записывает изменение в историю
+// Изменение применяется, только если текущее решение совпадает с change.FromDecision;
+// иначе возвращается false (решение успели изменить параллельно)
+func (s *SQLiteStorage) ApplyDecision(change *models.DecisionChange) (bool, error) {
+	if change.CreatedAt.IsZero() {
+		change.CreatedAt = time.Now()
+	}
+
+	applied := false
+	err := retryOperation(func() error {
+		applied = false
+
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		current := models.DecisionPending
+		err = dbTx.QueryRow(`SELECT decision FROM transaction_decisions WHERE processing_id = ?`, change.ProcessingID).Scan(&current)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if current != change.FromDecision {
+			return nil
+		}
+
+		if _, err := dbTx.Exec(`
+			INSERT INTO transaction_decisions (processing_id, decision, actor, reason, updated_at)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT(processing_id) DO UPDATE SET
+				decision = excluded.decision,
+				actor = excluded.actor,
+				reason = excluded.reason,
+				updated_at = excluded.updated_at
+		`, change.ProcessingID, change.ToDecision, change.Actor, change.Reason, change.CreatedAt); err != nil {
+			return err
+		}
+
+		result, err := dbTx.Exec(`
+			INSERT INTO decision_history (processing_id, from_decision, to_decision, actor, approved_by, reason, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, change.ProcessingID, change.FromDecision, change.ToDecision, change.Actor, change.ApprovedBy, change.Reason, change.CreatedAt)
+		if err != nil {
+			return err
+		}
+		if change.ID, err = result.LastInsertId(); err != nil {
+			return err
+		}
+
+		if err := dbTx.Commit(); err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	}, 3, 50*time.Millisecond)
+
+	return applied, err
+}
+
+// ListDecisionHistory получает историю решений по транзакции в хронологическом порядке
+func (s *SQLiteStorage) ListDecisionHistory(processingID string) ([]*models.DecisionChange, error) {
+	rows, err := s.DB.Query(`
+		SELECT id, processing_id, from_decision, to_decision, actor, COALESCE(approved_by, ''),
+		       COALESCE(reason, ''), created_at
+		FROM decision_history
+		WHERE processing_id = ?
+		ORDER BY created_at, id
+	`, processingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*models.DecisionChange
+	for rows.Next() {
+		var c models.DecisionChange
+		if err := rows.Scan(&c.ID, &c.ProcessingID, &c.FromDecision, &c.ToDecision, &c.Actor, &c.ApprovedBy, &c.Reason, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		history = append(history, &c)
+	}
+	return history, rows.Err()
+}
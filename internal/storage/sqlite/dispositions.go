@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// SaveTransactionFlags сохраняет сработавшие флаги транзакции вместе с версией правил
+// Повторное сохранение тех же флагов (повторный анализ) игнорируется
+func (s *SQLiteStorage) SaveTransactionFlags(processingID string, flags []string, rulesetVersion string, flaggedAt time.Time) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		for _, flag := range flags {
+			if _, err := dbTx.Exec(`
+				INSERT OR IGNORE INTO transaction_flags (processing_id, flag, ruleset_version, flagged_at)
+				VALUES (?, ?, ?, ?)
+			`, processingID, flag, rulesetVersion, flaggedAt.UTC()); err != nil {
+				return err
+			}
+		}
+
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+}
+
+// SaveDisposition создает или заменяет заключение аналитика по транзакции
+func (s *SQLiteStorage) SaveDisposition(d *models.Disposition) error {
+	now := time.Now()
+	var alertID interface{}
+	if d.AlertID != 0 {
+		alertID = d.AlertID
+	}
+
+	query := `
+		INSERT INTO dispositions (processing_id, alert_id, disposition, analyst, comment, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(processing_id) DO UPDATE SET
+			alert_id = excluded.alert_id,
+			disposition = excluded.disposition,
+			analyst = excluded.analyst,
+			comment = excluded.comment,
+			updated_at = excluded.updated_at
+	`
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(query, d.ProcessingID, alertID, d.Disposition, d.Analyst, d.Comment, now, now)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetDisposition получает заключение аналитика по processing_id транзакции
+func (s *SQLiteStorage) GetDisposition(processingID string) (*models.Disposition, error) {
+	query := `
+		SELECT id, processing_id, COALESCE(alert_id, 0), disposition, analyst, COALESCE(comment, ''),
+		       created_at, updated_at
+		FROM dispositions
+		WHERE processing_id = ?
+	`
+
+	var d models.Disposition
+	err := s.DB.QueryRow(query, processingID).Scan(
+		&d.ID, &d.ProcessingID, &d.AlertID, &d.Disposition, &d.Analyst, &d.Comment,
+		&d.CreatedAt, &d.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// GetFlagPrecision считает срабатывания флагов за период [from, to) в разрезе версии правил
+// вместе с заключениями аналитиков, алертами и кейсами, закрытыми с направлением SAR
+func (s *SQLiteStorage) GetFlagPrecision(from, to time.Time) ([]*models.FlagPrecision, error) {
+	query := `
+		SELECT f.flag, f.ruleset_version,
+		       COUNT(*),
+		       SUM(CASE WHEN d.disposition = ? THEN 1 ELSE 0 END),
+		       SUM(CASE WHEN d.disposition = ? THEN 1 ELSE 0 END),
+		       SUM(CASE WHEN d.disposition = ? THEN 1 ELSE 0 END),
+		       COUNT(a.id),
+		       SUM(CASE WHEN c.resolution = ? THEN 1 ELSE 0 END)
+		FROM transaction_flags f
+		LEFT JOIN dispositions d ON d.processing_id = f.processing_id
+		LEFT JOIN alerts a ON a.processing_id = f.processing_id
+		LEFT JOIN cases c ON c.id = a.case_id
+		WHERE f.flagged_at >= ? AND f.flagged_at < ?
+		GROUP BY f.flag, f.ruleset_version
+		ORDER BY COUNT(*) DESC, f.flag, f.ruleset_version
+	`
+
+	rows, err := s.DB.Query(query,
+		models.DispositionTruePositive, models.DispositionFalsePositive, models.DispositionInconclusive,
+		models.CaseResolutionSARFiled, from.UTC(), to.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.FlagPrecision
+	for rows.Next() {
+		var p models.FlagPrecision
+		if err := rows.Scan(
+			&p.Flag, &p.RulesetVersion, &p.Hits,
+			&p.TruePositives, &p.FalsePositives, &p.Inconclusive,
+			&p.Alerts, &p.SARs,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &p)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,137 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// SaveFlowCycle сохраняет цикл и индексирует его по всем участвующим счетам
+// Повторное сохранение цикла с той же замыкающей транзакцией и теми же переводами не создает дубль
+func (s *SQLiteStorage) SaveFlowCycle(cycle *models.FlowCycle) error {
+	accounts, err := json.Marshal(cycle.Accounts)
+	if err != nil {
+		return err
+	}
+	edges, err := json.Marshal(cycle.Edges)
+	if err != nil {
+		return err
+	}
+	if cycle.DetectedAt.IsZero() {
+		cycle.DetectedAt = time.Now()
+	}
+
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		var existingID int64
+		var existingDetectedAt time.Time
+		err = dbTx.QueryRow(
+			`SELECT id, detected_at FROM flow_cycles WHERE closing_transaction_id = ? AND edges = ? LIMIT 1`,
+			cycle.ClosingTransactionID, string(edges),
+		).Scan(&existingID, &existingDetectedAt)
+		if err == nil {
+			cycle.ID = existingID
+			cycle.DetectedAt = existingDetectedAt
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		result, err := dbTx.Exec(`
+			INSERT INTO flow_cycles (
+				closing_transaction_id, accounts, edges, cycle_length, total_amount, detected_at
+			) VALUES (?, ?, ?, ?, ?, ?)
+		`, cycle.ClosingTransactionID, string(accounts), string(edges), cycle.Length, cycle.TotalAmount, cycle.DetectedAt)
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for _, account := range cycle.Accounts {
+			if _, err := dbTx.Exec(
+				`INSERT OR IGNORE INTO flow_cycle_accounts (cycle_id, account_number) VALUES (?, ?)`,
+				id, account,
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := dbTx.Commit(); err != nil {
+			return err
+		}
+		cycle.ID = id
+		return nil
+	}, 3, 50*time.Millisecond)
+}
+
+// ListFlowCycles получает последние обнаруженные циклы
+func (s *SQLiteStorage) ListFlowCycles(limit int) ([]*models.FlowCycle, error) {
+	query := `
+		SELECT id, closing_transaction_id, accounts, edges, cycle_length, total_amount, detected_at
+		FROM flow_cycles
+		ORDER BY detected_at DESC, id DESC
+		LIMIT ?
+	`
+
+	rows, err := s.DB.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanFlowCycles(rows)
+}
+
+// ListAccountFlowCycles получает циклы, в которых участвовал счет
+func (s *SQLiteStorage) ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error) {
+	query := `
+		SELECT c.id, c.closing_transaction_id, c.accounts, c.edges, c.cycle_length, c.total_amount, c.detected_at
+		FROM flow_cycles c
+		JOIN flow_cycle_accounts a ON a.cycle_id = c.id
+		WHERE a.account_number = ?
+		ORDER BY c.detected_at DESC, c.id DESC
+		LIMIT ?
+	`
+
+	rows, err := s.DB.Query(query, accountNumber, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanFlowCycles(rows)
+}
+
+func scanFlowCycles(rows *sql.Rows) ([]*models.FlowCycle, error) {
+	var cycles []*models.FlowCycle
+	for rows.Next() {
+		var cycle models.FlowCycle
+		var accounts, edges string
+		if err := rows.Scan(
+			&cycle.ID, &cycle.ClosingTransactionID, &accounts, &edges,
+			&cycle.Length, &cycle.TotalAmount, &cycle.DetectedAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(accounts), &cycle.Accounts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(edges), &cycle.Edges); err != nil {
+			return nil, err
+		}
+		cycles = append(cycles, &cycle)
+	}
+
+	return cycles, rows.Err()
+}
@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStorage открывает чистую БД во временном каталоге теста
+func newTestStorage(t *testing.T) *SQLiteStorage {
+	t.Helper()
+	storage, err := NewConnection(&config.Config{DB: config.DBConfig{DBPath: filepath.Join(t.TempDir(), "aml.db")}})
+	require.NoError(t, err)
+	t.Cleanup(func() { storage.Close() })
+	return storage
+}
+
+func testFlowCycle() *models.FlowCycle {
+	ts := time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)
+	return &models.FlowCycle{
+		ClosingTransactionID: "TXN-C",
+		Accounts:             []string{"ACC-A", "ACC-B", "ACC-C"},
+		Edges: []models.TransferEdge{
+			{TransactionID: "TXN-A", From: "ACC-A", To: "ACC-B", Amount: 100000, Timestamp: ts},
+			{TransactionID: "TXN-B", From: "ACC-B", To: "ACC-C", Amount: 99000, Timestamp: ts.Add(10 * time.Minute)},
+			{TransactionID: "TXN-C", From: "ACC-C", To: "ACC-A", Amount: 98000, Timestamp: ts.Add(20 * time.Minute)},
+		},
+		Length:      3,
+		TotalAmount: 297000,
+	}
+}
+
+func TestSaveFlowCycle_RepeatedSaveIsIdempotent(t *testing.T) {
+	storage := newTestStorage(t)
+
+	first := testFlowCycle()
+	require.NoError(t, storage.SaveFlowCycle(first))
+
+	repeat := testFlowCycle()
+	require.NoError(t, storage.SaveFlowCycle(repeat))
+	assert.Equal(t, first.ID, repeat.ID)
+
+	cycles, err := storage.ListFlowCycles(10)
+	require.NoError(t, err)
+	assert.Len(t, cycles, 1)
+
+	accountCycles, err := storage.ListAccountFlowCycles("ACC-B", 10)
+	require.NoError(t, err)
+	assert.Len(t, accountCycles, 1)
+}
+
+func TestSaveFlowCycle_OtherPathWithSameClosingTransaction(t *testing.T) {
+	storage := newTestStorage(t)
+
+	require.NoError(t, storage.SaveFlowCycle(testFlowCycle()))
+
+	// Та же замыкающая операция может закрыть другой путь через параллельный перевод
+	other := testFlowCycle()
+	other.Edges[1].TransactionID = "TXN-B2"
+	require.NoError(t, storage.SaveFlowCycle(other))
+
+	cycles, err := storage.ListFlowCycles(10)
+	require.NoError(t, err)
+	assert.Len(t, cycles, 2)
+}
@@ -38,7 +38,7 @@ func (s *SQLiteStorage) GetTransactionByProcessingID(processingID string) (*mode
 func (s *SQLiteStorage) GetFullTransactionByProcessingID(processingID string) (*models.Transaction, error) {
 	query := `
 		SELECT transaction_id, account_number, amount, currency, transaction_type,
-		       counterparty_account, counterparty_bank, counterparty_country,
+		       counterparty_account, counterparty_bank, COALESCE(counterparty_bic, ''), counterparty_country,
 		       timestamp, channel, user_id, branch_id
 		FROM transactions
 		WHERE processing_id = ?
@@ -52,7 +52,7 @@ func (s *SQLiteStorage) GetFullTransactionByProcessingID(processingID string) (*
 		var result models.Transaction
 		err := s.DB.QueryRow(query, processingID).Scan(
 			&result.TransactionID, &result.AccountNumber, &result.Amount, &result.Currency, &result.TransactionType,
-			&result.CounterpartyAccount, &result.CounterpartyBank, &result.CounterpartyCountry,
+			&result.CounterpartyAccount, &result.CounterpartyBank, &result.CounterpartyBIC, &result.CounterpartyCountry,
 			&result.Timestamp, &result.Channel, &result.UserID, &result.BranchID,
 		)
 
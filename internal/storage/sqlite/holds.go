@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const holdColumns = `id, processing_id, expiry_action, status, expires_at, created_at, resolved_at`
+
+// CreateHold сохраняет таймер приостановки; операция приостанавливается по таймеру не более одного раза
+// Возвращает false, если таймер по транзакции уже существует
+func (s *SQLiteStorage) CreateHold(h *models.TransactionHold) (bool, error) {
+	if h.Status == "" {
+		h.Status = models.HoldStatusActive
+	}
+	if h.CreatedAt.IsZero() {
+		h.CreatedAt = time.Now()
+	}
+
+	query := `
+		INSERT OR IGNORE INTO transaction_holds (processing_id, expiry_action, status, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	var created bool
+	err := retryOperation(func() error {
+		result, err := s.DB.Exec(query, h.ProcessingID, h.ExpiryAction, h.Status, h.ExpiresAt.UTC(), h.CreatedAt.UTC())
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		created = affected > 0
+		if created {
+			h.ID, err = result.LastInsertId()
+		}
+		return err
+	}, 3, 50*time.Millisecond)
+
+	return created, err
+}
+
+// GetHold получает таймер приостановки по транзакции
+func (s *SQLiteStorage) GetHold(processingID string) (*models.TransactionHold, error) {
+	rows, err := s.DB.Query(`SELECT `+holdColumns+` FROM transaction_holds WHERE processing_id = ?`, processingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holds, err := scanHolds(rows)
+	if err != nil || len(holds) == 0 {
+		return nil, err
+	}
+	return holds[0], nil
+}
+
+// ListHolds получает таймеры по фильтру в порядке истечения срока
+func (s *SQLiteStorage) ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+
+	query := `SELECT ` + holdColumns + ` FROM transaction_holds` + whereClause(conditions) + ` ORDER BY expires_at, id LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanHolds(rows)
+}
+
+// ListExpiredHolds получает активные таймеры, срок которых истек к now
+func (s *SQLiteStorage) ListExpiredHolds(now time.Time, limit int) ([]*models.TransactionHold, error) {
+	query := `SELECT ` + holdColumns + ` FROM transaction_holds
+		WHERE status = ? AND expires_at <= ?
+		ORDER BY expires_at, id
+		LIMIT ?`
+
+	rows, err := s.DB.Query(query, models.HoldStatusActive, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanHolds(rows)
+}
+
+// ResolveHold переводит активный таймер в итоговый статус
+// Возвращает false, если таймер уже был обработан
+func (s *SQLiteStorage) ResolveHold(h *models.TransactionHold) (bool, error) {
+	resolvedAt := time.Now()
+	if h.ResolvedAt != nil {
+		resolvedAt = *h.ResolvedAt
+	}
+
+	query := `UPDATE transaction_holds SET status = ?, resolved_at = ? WHERE id = ? AND status = ?`
+
+	var affected int64
+	err := retryOperation(func() error {
+		result, err := s.DB.Exec(query, h.Status, resolvedAt.UTC(), h.ID, models.HoldStatusActive)
+		if err != nil {
+			return err
+		}
+		affected, err = result.RowsAffected()
+		return err
+	}, 3, 50*time.Millisecond)
+	if err != nil {
+		return false, err
+	}
+	if affected > 0 {
+		h.ResolvedAt = &resolvedAt
+	}
+	return affected > 0, nil
+}
+
+func scanHolds(rows *sql.Rows) ([]*models.TransactionHold, error) {
+	var holds []*models.TransactionHold
+	for rows.Next() {
+		var h models.TransactionHold
+		var resolvedAt sql.NullTime
+		if err := rows.Scan(&h.ID, &h.ProcessingID, &h.ExpiryAction, &h.Status, &h.ExpiresAt, &h.CreatedAt, &resolvedAt); err != nil {
+			return nil, err
+		}
+		if resolvedAt.Valid {
+			h.ResolvedAt = &resolvedAt.Time
+		}
+		holds = append(holds, &h)
+	}
+	return holds, rows.Err()
+}
@@ -0,0 +1,189 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const mandatoryReportColumns = `
+	id, rule, processing_id, transaction_id, account_number, COALESCE(customer_id, ''), transaction_type,
+	amount, currency, amount_local, daily_total_local, business_date, transaction_time, status,
+	created_at, exported_at
+`
+
+// ListTransactionsByAccounts получает операции указанных типов по счетам за период [from, to)
+// Время операций хранится с исходным часовым поясом, поэтому выборка берется с запасом в сутки
+// и уточняется после чтения
+func (s *SQLiteStorage) ListTransactionsByAccounts(accountNumbers []string, transactionTypes []string, from, to time.Time) ([]*models.TransactionRecord, error) {
+	if len(accountNumbers) == 0 || len(transactionTypes) == 0 {
+		return nil, nil
+	}
+
+	var args []interface{}
+	for _, number := range accountNumbers {
+		args = append(args, number)
+	}
+	for _, t := range transactionTypes {
+		args = append(args, t)
+	}
+	args = append(args, from.Add(-24*time.Hour), to.Add(24*time.Hour))
+
+	query := `
+		SELECT processing_id, transaction_id, account_number, amount, currency, transaction_type,
+		       COALESCE(counterparty_account, ''), COALESCE(counterparty_bank, ''), COALESCE(counterparty_bic, ''),
+		       COALESCE(counterparty_country, ''), timestamp, COALESCE(channel, ''),
+		       COALESCE(user_id, ''), COALESCE(branch_id, '')
+		FROM transactions
+		WHERE account_number IN (` + placeholders(len(accountNumbers)) + `)
+		  AND transaction_type IN (` + placeholders(len(transactionTypes)) + `)
+		  AND timestamp >= ? AND timestamp < ?
+		ORDER BY timestamp, id
+	`
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.TransactionRecord
+	for rows.Next() {
+		var r models.TransactionRecord
+		tx := &r.Transaction
+		if err := rows.Scan(
+			&r.ProcessingID, &tx.TransactionID, &tx.AccountNumber, &tx.Amount, &tx.Currency, &tx.TransactionType,
+			&tx.CounterpartyAccount, &tx.CounterpartyBank, &tx.CounterpartyBIC,
+			&tx.CounterpartyCountry, &tx.Timestamp, &tx.Channel,
+			&tx.UserID, &tx.BranchID,
+		); err != nil {
+			return nil, err
+		}
+		if tx.Timestamp.Before(from) || !tx.Timestamp.Before(to) {
+			continue
+		}
+		result = append(result, &r)
+	}
+
+	return result, rows.Err()
+}
+
+// EnqueueMandatoryReports ставит операции в очередь; уже поставленные по тому же правилу пропускаются
+func (s *SQLiteStorage) EnqueueMandatoryReports(reports []*models.MandatoryReport) (int, error) {
+	if len(reports) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		INSERT OR IGNORE INTO mandatory_reports (
+			rule, processing_id, transaction_id, account_number, customer_id, transaction_type,
+			amount, currency, amount_local, daily_total_local, business_date, transaction_time,
+			status, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	var added int
+	err := retryOperation(func() error {
+		added = 0
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		now := time.Now().UTC()
+		for _, r := range reports {
+			if r.Status == "" {
+				r.Status = models.MandatoryReportQueued
+			}
+			result, err := dbTx.Exec(
+				query,
+				r.Rule, r.ProcessingID, r.TransactionID, r.AccountNumber, r.CustomerID, r.TransactionType,
+				r.Amount, r.Currency, r.AmountLocal, r.DailyTotalLocal, r.BusinessDate, r.TransactionTime.UTC(),
+				r.Status, now,
+			)
+			if err != nil {
+				return err
+			}
+			if n, err := result.RowsAffected(); err == nil && n > 0 {
+				added++
+			}
+		}
+
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+
+	return added, err
+}
+
+// ListMandatoryReports получает записи очереди по фильтру в хронологическом порядке операций
+func (s *SQLiteStorage) ListMandatoryReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.BusinessDate != "" {
+		conditions = append(conditions, "business_date = ?")
+		args = append(args, filter.BusinessDate)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.Rule != "" {
+		conditions = append(conditions, "rule = ?")
+		args = append(args, filter.Rule)
+	}
+
+	query := `SELECT ` + mandatoryReportColumns + ` FROM mandatory_reports` + whereClause(conditions) +
+		` ORDER BY business_date DESC, transaction_time, id LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []*models.MandatoryReport
+	for rows.Next() {
+		var r models.MandatoryReport
+		var exportedAt sql.NullTime
+		if err := rows.Scan(
+			&r.ID, &r.Rule, &r.ProcessingID, &r.TransactionID, &r.AccountNumber, &r.CustomerID, &r.TransactionType,
+			&r.Amount, &r.Currency, &r.AmountLocal, &r.DailyTotalLocal, &r.BusinessDate, &r.TransactionTime, &r.Status,
+			&r.CreatedAt, &exportedAt,
+		); err != nil {
+			return nil, err
+		}
+		if exportedAt.Valid {
+			r.ExportedAt = &exportedAt.Time
+		}
+		reports = append(reports, &r)
+	}
+
+	return reports, rows.Err()
+}
+
+// MarkMandatoryReportsExported отмечает записи как выгруженные
+func (s *SQLiteStorage) MarkMandatoryReportsExported(ids []int64, exportedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := []interface{}{models.MandatoryReportExported, exportedAt.UTC()}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := `UPDATE mandatory_reports SET status = ?, exported_at = ? WHERE id IN (` + placeholders(len(ids)) + `)`
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(query, args...)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// placeholders возвращает список "?, ?, ..." для условия IN
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
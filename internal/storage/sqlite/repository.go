@@ -22,6 +22,11 @@ func (r *Repository) SaveTransaction(processingID string, tx *models.Transaction
 	return r.storage.SaveTransaction(processingID, tx)
 }
 
+// SaveTransactions сохраняет пакет транзакций в одной транзакции БД
+func (r *Repository) SaveTransactions(items []*models.TransactionBatchItem) error {
+	return r.storage.SaveTransactions(items)
+}
+
 // UpdateTransactionAnalysis обновляет результаты анализа транзакции
 func (r *Repository) UpdateTransactionAnalysis(processingID string, riskScore int, riskLevel string, analysisTime time.Time) error {
 	return r.storage.UpdateTransactionAnalysis(processingID, riskScore, riskLevel, analysisTime)
@@ -42,8 +47,468 @@ func (r *Repository) GetAllTransactions(limit int) ([]*models.TransactionStatus,
 	return r.storage.GetAllTransactions(limit)
 }
 
-// ClearAllTransactions удаляет все транзакции из БД
+// SearchTransactions получает страницу транзакций по фильтру
+func (r *Repository) SearchTransactions(filter models.TransactionSearchFilter) ([]*models.TransactionSummary, error) {
+	return r.storage.SearchTransactions(filter)
+}
+
+// ClearAllTransactions удаляет все транзакции и производные от них данные: кейсы, алерты, решения, анализы, отчеты
 func (r *Repository) ClearAllTransactions() error {
 	return r.storage.ClearAllTransactions()
 }
 
+
+// AccountRepository реализует интерфейс storage.AccountRepository для SQLite
+type AccountRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewAccountRepository создает новый репозиторий клиентов и счетов
+func NewAccountRepository(storage *SQLiteStorage) storage.AccountRepository {
+	return &AccountRepository{storage: storage}
+}
+
+// SaveCustomer создает или обновляет клиента
+func (r *AccountRepository) SaveCustomer(customer *models.Customer) error {
+	return r.storage.SaveCustomer(customer)
+}
+
+// GetCustomer получает клиента по customer_id
+func (r *AccountRepository) GetCustomer(customerID string) (*models.Customer, error) {
+	return r.storage.GetCustomer(customerID)
+}
+
+// ListCustomers получает список клиентов
+func (r *AccountRepository) ListCustomers(limit int) ([]*models.Customer, error) {
+	return r.storage.ListCustomers(limit)
+}
+
+// SaveAccount создает или обновляет счет
+func (r *AccountRepository) SaveAccount(account *models.Account) error {
+	return r.storage.SaveAccount(account)
+}
+
+// GetAccount получает счет по номеру
+func (r *AccountRepository) GetAccount(accountNumber string) (*models.Account, error) {
+	return r.storage.GetAccount(accountNumber)
+}
+
+// ListAccounts получает счета клиента
+func (r *AccountRepository) ListAccounts(customerID string) ([]*models.Account, error) {
+	return r.storage.ListAccounts(customerID)
+}
+
+// GetAccountProfile получает счет вместе с данными владельца
+func (r *AccountRepository) GetAccountProfile(accountNumber string) (*models.AccountProfile, error) {
+	return r.storage.GetAccountProfile(accountNumber)
+}
+
+// GetAccountTurnover возвращает сумму исходящих операций по счету начиная с since, не считая операцию excludeTransactionID
+func (r *AccountRepository) GetAccountTurnover(accountNumber, excludeTransactionID string, since time.Time) (float64, error) {
+	return r.storage.GetAccountTurnover(accountNumber, excludeTransactionID, since)
+}
+
+// FlowCycleRepository реализует интерфейс storage.FlowCycleRepository для SQLite
+type FlowCycleRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewFlowCycleRepository создает новый репозиторий круговых потоков
+func NewFlowCycleRepository(storage *SQLiteStorage) storage.FlowCycleRepository {
+	return &FlowCycleRepository{storage: storage}
+}
+
+// SaveFlowCycle сохраняет цикл вместе с участвующими счетами
+func (r *FlowCycleRepository) SaveFlowCycle(cycle *models.FlowCycle) error {
+	return r.storage.SaveFlowCycle(cycle)
+}
+
+// ListFlowCycles получает последние обнаруженные циклы
+func (r *FlowCycleRepository) ListFlowCycles(limit int) ([]*models.FlowCycle, error) {
+	return r.storage.ListFlowCycles(limit)
+}
+
+// ListAccountFlowCycles получает циклы, в которых участвовал счет
+func (r *FlowCycleRepository) ListAccountFlowCycles(accountNumber string, limit int) ([]*models.FlowCycle, error) {
+	return r.storage.ListAccountFlowCycles(accountNumber, limit)
+}
+
+// CaseRepository реализует интерфейс storage.CaseRepository для SQLite
+type CaseRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewCaseRepository создает новый репозиторий алертов и кейсов
+func NewCaseRepository(storage *SQLiteStorage) storage.CaseRepository {
+	return &CaseRepository{storage: storage}
+}
+
+// SaveAlert сохраняет алерт, привязанный к кейсу
+func (r *CaseRepository) SaveAlert(alert *models.Alert) error {
+	return r.storage.SaveAlert(alert)
+}
+
+// UpdateAlert обновляет оценку, рекомендацию, флаги и статус алерта
+func (r *CaseRepository) UpdateAlert(alert *models.Alert) error {
+	return r.storage.UpdateAlert(alert)
+}
+
+// GetAlert получает алерт по ID
+func (r *CaseRepository) GetAlert(id int64) (*models.Alert, error) {
+	return r.storage.GetAlert(id)
+}
+
+// GetAlertByProcessingID получает алерт по processing_id транзакции
+func (r *CaseRepository) GetAlertByProcessingID(processingID string) (*models.Alert, error) {
+	return r.storage.GetAlertByProcessingID(processingID)
+}
+
+// ListAlerts получает алерты по фильтру
+func (r *CaseRepository) ListAlerts(filter models.AlertFilter) ([]*models.Alert, error) {
+	return r.storage.ListAlerts(filter)
+}
+
+// ListCaseAlerts получает алерты кейса
+func (r *CaseRepository) ListCaseAlerts(caseID int64) ([]*models.Alert, error) {
+	return r.storage.ListCaseAlerts(caseID)
+}
+
+// CreateCase создает кейс
+func (r *CaseRepository) CreateCase(c *models.Case) error {
+	return r.storage.CreateCase(c)
+}
+
+// GetCase получает кейс по ID
+func (r *CaseRepository) GetCase(id int64) (*models.Case, error) {
+	return r.storage.GetCase(id)
+}
+
+// GetActiveCaseByAccount получает незакрытый кейс по счету
+func (r *CaseRepository) GetActiveCaseByAccount(accountNumber string) (*models.Case, error) {
+	return r.storage.GetActiveCaseByAccount(accountNumber)
+}
+
+// ListCases получает кейсы по фильтру
+func (r *CaseRepository) ListCases(filter models.CaseFilter) ([]*models.Case, error) {
+	return r.storage.ListCases(filter)
+}
+
+// UpdateCase обновляет статус, исполнителя и решение по кейсу
+func (r *CaseRepository) UpdateCase(c *models.Case) error {
+	return r.storage.UpdateCase(c)
+}
+
+// AddCaseNote добавляет заметку к кейсу
+func (r *CaseRepository) AddCaseNote(note *models.CaseNote) error {
+	return r.storage.AddCaseNote(note)
+}
+
+// ListCaseNotes получает заметки кейса
+func (r *CaseRepository) ListCaseNotes(caseID int64) ([]*models.CaseNote, error) {
+	return r.storage.ListCaseNotes(caseID)
+}
+
+// AddCaseAttachment добавляет ссылку на документ к кейсу
+func (r *CaseRepository) AddCaseAttachment(attachment *models.CaseAttachment) error {
+	return r.storage.AddCaseAttachment(attachment)
+}
+
+// ListCaseAttachments получает вложения кейса
+func (r *CaseRepository) ListCaseAttachments(caseID int64) ([]*models.CaseAttachment, error) {
+	return r.storage.ListCaseAttachments(caseID)
+}
+
+// DispositionRepository реализует интерфейс storage.DispositionRepository для SQLite
+type DispositionRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewDispositionRepository создает новый репозиторий заключений аналитиков
+func NewDispositionRepository(storage *SQLiteStorage) storage.DispositionRepository {
+	return &DispositionRepository{storage: storage}
+}
+
+// SaveTransactionFlags сохраняет сработавшие флаги транзакции вместе с версией правил
+func (r *DispositionRepository) SaveTransactionFlags(processingID string, flags []string, rulesetVersion string, flaggedAt time.Time) error {
+	return r.storage.SaveTransactionFlags(processingID, flags, rulesetVersion, flaggedAt)
+}
+
+// SaveDisposition создает или заменяет заключение аналитика по транзакции
+func (r *DispositionRepository) SaveDisposition(d *models.Disposition) error {
+	return r.storage.SaveDisposition(d)
+}
+
+// GetDisposition получает заключение аналитика по processing_id транзакции
+func (r *DispositionRepository) GetDisposition(processingID string) (*models.Disposition, error) {
+	return r.storage.GetDisposition(processingID)
+}
+
+// GetFlagPrecision считает срабатывания и заключения по флагам за период [from, to)
+func (r *DispositionRepository) GetFlagPrecision(from, to time.Time) ([]*models.FlagPrecision, error) {
+	return r.storage.GetFlagPrecision(from, to)
+}
+
+// DecisionRepository реализует интерфейс storage.DecisionRepository для SQLite
+type DecisionRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewDecisionRepository создает новый репозиторий решений по транзакциям
+func NewDecisionRepository(storage *SQLiteStorage) storage.DecisionRepository {
+	return &DecisionRepository{storage: storage}
+}
+
+// GetDecision получает текущее решение по транзакции
+func (r *DecisionRepository) GetDecision(processingID string) (*models.TransactionDecision, error) {
+	return r.storage.GetDecision(processingID)
+}
+
+// ApplyDecision меняет решение и пишет историю
+func (r *DecisionRepository) ApplyDecision(change *models.DecisionChange) (bool, error) {
+	return r.storage.ApplyDecision(change)
+}
+
+// ListDecisionHistory получает историю решений по транзакции
+func (r *DecisionRepository) ListDecisionHistory(processingID string) ([]*models.DecisionChange, error) {
+	return r.storage.ListDecisionHistory(processingID)
+}
+
+// CreateDecisionApproval сохраняет запрос на подтверждение решения
+func (r *DecisionRepository) CreateDecisionApproval(a *models.DecisionApproval) error {
+	return r.storage.CreateDecisionApproval(a)
+}
+
+// GetDecisionApproval получает запрос на подтверждение по ID
+func (r *DecisionRepository) GetDecisionApproval(id int64) (*models.DecisionApproval, error) {
+	return r.storage.GetDecisionApproval(id)
+}
+
+// GetPendingDecisionApproval получает ожидающий подтверждения запрос по транзакции
+func (r *DecisionRepository) GetPendingDecisionApproval(processingID string) (*models.DecisionApproval, error) {
+	return r.storage.GetPendingDecisionApproval(processingID)
+}
+
+// ListDecisionApprovals получает запросы на подтверждение по фильтру
+func (r *DecisionRepository) ListDecisionApprovals(filter models.DecisionApprovalFilter) ([]*models.DecisionApproval, error) {
+	return r.storage.ListDecisionApprovals(filter)
+}
+
+// ResolveDecisionApproval переводит ожидающий запрос в итоговый статус
+func (r *DecisionRepository) ResolveDecisionApproval(a *models.DecisionApproval) (bool, error) {
+	return r.storage.ResolveDecisionApproval(a)
+}
+
+// ExpireDecisionApprovals помечает просроченные запросы как expired
+func (r *DecisionRepository) ExpireDecisionApprovals(now time.Time) (int64, error) {
+	return r.storage.ExpireDecisionApprovals(now)
+}
+
+// HoldRepository реализует интерфейс storage.HoldRepository для SQLite
+type HoldRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewHoldRepository создает новый репозиторий таймеров приостановки
+func NewHoldRepository(storage *SQLiteStorage) storage.HoldRepository {
+	return &HoldRepository{storage: storage}
+}
+
+// CreateHold сохраняет таймер приостановки
+func (r *HoldRepository) CreateHold(h *models.TransactionHold) (bool, error) {
+	return r.storage.CreateHold(h)
+}
+
+// GetHold получает таймер приостановки по транзакции
+func (r *HoldRepository) GetHold(processingID string) (*models.TransactionHold, error) {
+	return r.storage.GetHold(processingID)
+}
+
+// ListHolds получает таймеры по фильтру
+func (r *HoldRepository) ListHolds(filter models.TransactionHoldFilter) ([]*models.TransactionHold, error) {
+	return r.storage.ListHolds(filter)
+}
+
+// ListExpiredHolds получает активные таймеры с истекшим сроком
+func (r *HoldRepository) ListExpiredHolds(now time.Time, limit int) ([]*models.TransactionHold, error) {
+	return r.storage.ListExpiredHolds(now, limit)
+}
+
+// ResolveHold переводит активный таймер в итоговый статус
+func (r *HoldRepository) ResolveHold(h *models.TransactionHold) (bool, error) {
+	return r.storage.ResolveHold(h)
+}
+
+// SARReportRepository реализует интерфейс storage.SARReportRepository для SQLite
+type SARReportRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewSARReportRepository создает новый репозиторий сообщений о подозрительной деятельности
+func NewSARReportRepository(storage *SQLiteStorage) storage.SARReportRepository {
+	return &SARReportRepository{storage: storage}
+}
+
+// ListCaseTransactions получает операции кейса вместе с флагами анализа
+func (r *SARReportRepository) ListCaseTransactions(caseID int64) ([]*models.CaseTransaction, error) {
+	return r.storage.ListCaseTransactions(caseID)
+}
+
+// CreateSARReport сохраняет сформированное сообщение
+func (r *SARReportRepository) CreateSARReport(report *models.SARReport) error {
+	return r.storage.CreateSARReport(report)
+}
+
+// GetSARReport получает сообщение по ID
+func (r *SARReportRepository) GetSARReport(id int64) (*models.SARReport, error) {
+	return r.storage.GetSARReport(id)
+}
+
+// ListSARReports получает сообщения по фильтру
+func (r *SARReportRepository) ListSARReports(filter models.SARReportFilter) ([]*models.SARReport, error) {
+	return r.storage.ListSARReports(filter)
+}
+
+// UpdateSARReport сохраняет статус, номер регулятора и комментарий сообщения
+func (r *SARReportRepository) UpdateSARReport(report *models.SARReport) error {
+	return r.storage.UpdateSARReport(report)
+}
+
+// MandatoryReportRepository реализует интерфейс storage.MandatoryReportRepository для SQLite
+type MandatoryReportRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewMandatoryReportRepository создает новый репозиторий очереди обязательного контроля
+func NewMandatoryReportRepository(storage *SQLiteStorage) storage.MandatoryReportRepository {
+	return &MandatoryReportRepository{storage: storage}
+}
+
+// ListTransactionsByAccounts получает операции указанных типов по счетам за период [from, to)
+func (r *MandatoryReportRepository) ListTransactionsByAccounts(accountNumbers []string, transactionTypes []string, from, to time.Time) ([]*models.TransactionRecord, error) {
+	return r.storage.ListTransactionsByAccounts(accountNumbers, transactionTypes, from, to)
+}
+
+// EnqueueMandatoryReports ставит операции в очередь обязательного контроля
+func (r *MandatoryReportRepository) EnqueueMandatoryReports(reports []*models.MandatoryReport) (int, error) {
+	return r.storage.EnqueueMandatoryReports(reports)
+}
+
+// ListMandatoryReports получает записи очереди по фильтру
+func (r *MandatoryReportRepository) ListMandatoryReports(filter models.MandatoryReportFilter) ([]*models.MandatoryReport, error) {
+	return r.storage.ListMandatoryReports(filter)
+}
+
+// MarkMandatoryReportsExported отмечает записи как выгруженные
+func (r *MandatoryReportRepository) MarkMandatoryReportsExported(ids []int64, exportedAt time.Time) error {
+	return r.storage.MarkMandatoryReportsExported(ids, exportedAt)
+}
+
+// BlacklistRepository реализует интерфейс storage.BlacklistRepository для SQLite
+type BlacklistRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewBlacklistRepository создает новый репозиторий записей черного и санкционного списков
+func NewBlacklistRepository(storage *SQLiteStorage) storage.BlacklistRepository {
+	return &BlacklistRepository{storage: storage}
+}
+
+// CreateBlacklistEntry сохраняет запись списка
+func (r *BlacklistRepository) CreateBlacklistEntry(e *models.BlacklistEntry) error {
+	return r.storage.CreateBlacklistEntry(e)
+}
+
+// GetBlacklistEntry получает запись списка по ID
+func (r *BlacklistRepository) GetBlacklistEntry(id int64) (*models.BlacklistEntry, error) {
+	return r.storage.GetBlacklistEntry(id)
+}
+
+// ListBlacklistEntries получает записи списков по фильтру
+func (r *BlacklistRepository) ListBlacklistEntries(filter models.BlacklistFilter) ([]*models.BlacklistEntry, error) {
+	return r.storage.ListBlacklistEntries(filter)
+}
+
+// ListAccountNumbersByCustomerName получает счета клиентов с указанным полным именем
+func (r *BlacklistRepository) ListAccountNumbersByCustomerName(name string) ([]string, error) {
+	return r.storage.ListAccountNumbersByCustomerName(name)
+}
+
+// ListTransactionsInvolvingAccounts получает операции, в которых участвуют счета
+func (r *BlacklistRepository) ListTransactionsInvolvingAccounts(accountNumbers []string, since time.Time) ([]*models.TransactionRecord, error) {
+	return r.storage.ListTransactionsInvolvingAccounts(accountNumbers, since)
+}
+
+// SaveBlacklistMatches сохраняет совпадения ретроспективной проверки
+func (r *BlacklistRepository) SaveBlacklistMatches(entryID int64, matches []*models.BlacklistMatch, screenedAt time.Time) error {
+	return r.storage.SaveBlacklistMatches(entryID, matches, screenedAt)
+}
+
+// ListBlacklistMatches получает совпадения ретроспективной проверки записи
+func (r *BlacklistRepository) ListBlacklistMatches(entryID int64) ([]*models.BlacklistMatch, error) {
+	return r.storage.ListBlacklistMatches(entryID)
+}
+
+// AnalysisRepository реализует интерфейс storage.AnalysisRepository для SQLite
+type AnalysisRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewAnalysisRepository создает новый репозиторий истории версий анализа
+func NewAnalysisRepository(storage *SQLiteStorage) storage.AnalysisRepository {
+	return &AnalysisRepository{storage: storage}
+}
+
+// SaveAnalysisVersion сохраняет результат анализа следующей версией
+func (r *AnalysisRepository) SaveAnalysisVersion(v *models.AnalysisVersion) error {
+	return r.storage.SaveAnalysisVersion(v)
+}
+
+// ListAnalysisVersions получает все версии анализа операции
+func (r *AnalysisRepository) ListAnalysisVersions(processingID string) ([]*models.AnalysisVersion, error) {
+	return r.storage.ListAnalysisVersions(processingID)
+}
+
+// ListProcessingIDsForRescore получает операции для повторной оценки
+func (r *AnalysisRepository) ListProcessingIDsForRescore(filter models.RescoreFilter) ([]string, error) {
+	return r.storage.ListProcessingIDsForRescore(filter)
+}
+
+// AccountActivityRepository реализует интерфейс storage.AccountActivityRepository для SQLite
+type AccountActivityRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewAccountActivityRepository создает новый репозиторий итогов операций счета
+func NewAccountActivityRepository(storage *SQLiteStorage) storage.AccountActivityRepository {
+	return &AccountActivityRepository{storage: storage}
+}
+
+// GetAccountActivityTotals считает итоги операций счета
+func (r *AccountActivityRepository) GetAccountActivityTotals(filter models.AccountActivityFilter) (*models.AccountActivityTotals, error) {
+	return r.storage.GetAccountActivityTotals(filter)
+}
+
+// TransactionUpdateRepository реализует интерфейс storage.TransactionUpdateRepository для SQLite
+type TransactionUpdateRepository struct {
+	storage *SQLiteStorage
+}
+
+// NewTransactionUpdateRepository создает новый репозиторий ленты изменений транзакций
+func NewTransactionUpdateRepository(storage *SQLiteStorage) storage.TransactionUpdateRepository {
+	return &TransactionUpdateRepository{storage: storage}
+}
+
+// ListTransactionUpdates возвращает события ленты после позиции
+func (r *TransactionUpdateRepository) ListTransactionUpdates(filter models.TransactionUpdateFilter) (*models.TransactionUpdatePage, error) {
+	return r.storage.ListTransactionUpdates(filter)
+}
+
+// GetTransactionUpdateBounds возвращает границы хранящейся ленты
+func (r *TransactionUpdateRepository) GetTransactionUpdateBounds() (int64, int64, error) {
+	return r.storage.GetTransactionUpdateBounds()
+}
+
+// DeleteTransactionUpdatesBefore удаляет устаревшие события ленты
+func (r *TransactionUpdateRepository) DeleteTransactionUpdatesBefore(before time.Time) (int64, error) {
+	return r.storage.DeleteTransactionUpdatesBefore(before)
+}
@@ -0,0 +1,169 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+const sarReportColumns = `
+	id, case_id, report_code, reference_number, COALESCE(regulator_reference, ''), status,
+	transaction_count, created_by, COALESCE(comment, ''), xml, created_at, updated_at, submitted_at
+`
+
+// ListCaseTransactions получает операции кейса (по его алертам) вместе с флагами анализа
+func (s *SQLiteStorage) ListCaseTransactions(caseID int64) ([]*models.CaseTransaction, error) {
+	query := `
+		SELECT a.processing_id, a.flags,
+		       t.transaction_id, t.account_number, t.amount, t.currency, t.transaction_type,
+		       COALESCE(t.counterparty_account, ''), COALESCE(t.counterparty_bank, ''), COALESCE(t.counterparty_bic, ''),
+		       COALESCE(t.counterparty_country, ''), t.timestamp, COALESCE(t.channel, ''),
+		       COALESCE(t.user_id, ''), COALESCE(t.branch_id, '')
+		FROM alerts a
+		JOIN transactions t ON t.processing_id = a.processing_id
+		WHERE a.case_id = ?
+		ORDER BY t.timestamp, t.id
+	`
+
+	rows, err := s.DB.Query(query, caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.CaseTransaction
+	for rows.Next() {
+		var ct models.CaseTransaction
+		var flags string
+		tx := &ct.Transaction
+		if err := rows.Scan(
+			&ct.ProcessingID, &flags,
+			&tx.TransactionID, &tx.AccountNumber, &tx.Amount, &tx.Currency, &tx.TransactionType,
+			&tx.CounterpartyAccount, &tx.CounterpartyBank, &tx.CounterpartyBIC,
+			&tx.CounterpartyCountry, &tx.Timestamp, &tx.Channel,
+			&tx.UserID, &tx.BranchID,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(flags), &ct.Flags); err != nil {
+			return nil, err
+		}
+		result = append(result, &ct)
+	}
+
+	return result, rows.Err()
+}
+
+// CreateSARReport сохраняет сформированное сообщение
+func (s *SQLiteStorage) CreateSARReport(r *models.SARReport) error {
+	if r.Status == "" {
+		r.Status = models.SARStatusGenerated
+	}
+	now := time.Now()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = now
+	}
+	r.UpdatedAt = r.CreatedAt
+
+	query := `
+		INSERT INTO sar_reports (
+			case_id, report_code, reference_number, status, transaction_count,
+			created_by, comment, xml, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return retryOperation(func() error {
+		result, err := s.DB.Exec(
+			query,
+			r.CaseID, r.ReportCode, r.ReferenceNumber, r.Status, r.TransactionCount,
+			r.CreatedBy, r.Comment, r.XML, r.CreatedAt.UTC(), r.UpdatedAt.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+		r.ID, err = result.LastInsertId()
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+// GetSARReport получает сообщение по ID вместе с XML
+func (s *SQLiteStorage) GetSARReport(id int64) (*models.SARReport, error) {
+	rows, err := s.DB.Query(`SELECT `+sarReportColumns+` FROM sar_reports WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports, err := scanSARReports(rows)
+	if err != nil || len(reports) == 0 {
+		return nil, err
+	}
+	return reports[0], nil
+}
+
+// ListSARReports получает сообщения по фильтру, начиная с последних
+func (s *SQLiteStorage) ListSARReports(filter models.SARReportFilter) ([]*models.SARReport, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.CaseID != 0 {
+		conditions = append(conditions, "case_id = ?")
+		args = append(args, filter.CaseID)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+
+	query := `SELECT ` + sarReportColumns + ` FROM sar_reports` + whereClause(conditions) + ` ORDER BY created_at DESC, id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSARReports(rows)
+}
+
+// UpdateSARReport сохраняет статус, номер регулятора и комментарий сообщения
+func (s *SQLiteStorage) UpdateSARReport(r *models.SARReport) error {
+	r.UpdatedAt = time.Now()
+	var submittedAt interface{}
+	if r.SubmittedAt != nil {
+		submittedAt = r.SubmittedAt.UTC()
+	}
+
+	query := `
+		UPDATE sar_reports
+		SET status = ?, regulator_reference = ?, comment = ?, submitted_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	return retryOperation(func() error {
+		_, err := s.DB.Exec(query, r.Status, r.RegulatorReference, r.Comment, submittedAt, r.UpdatedAt.UTC(), r.ID)
+		return err
+	}, 3, 50*time.Millisecond)
+}
+
+func scanSARReports(rows *sql.Rows) ([]*models.SARReport, error) {
+	var reports []*models.SARReport
+	for rows.Next() {
+		var r models.SARReport
+		var submittedAt sql.NullTime
+		if err := rows.Scan(
+			&r.ID, &r.CaseID, &r.ReportCode, &r.ReferenceNumber, &r.RegulatorReference, &r.Status,
+			&r.TransactionCount, &r.CreatedBy, &r.Comment, &r.XML, &r.CreatedAt, &r.UpdatedAt, &submittedAt,
+		); err != nil {
+			return nil, err
+		}
+		if submittedAt.Valid {
+			r.SubmittedAt = &submittedAt.Time
+		}
+		reports = append(reports, &r)
+	}
+
+	return reports, rows.Err()
+}
@@ -11,19 +11,62 @@ func (s *SQLiteStorage) SaveTransaction(processingID string, tx *models.Transact
 	query := `
 		INSERT INTO transactions (
 			processing_id, transaction_id, account_number, amount, currency,
-			transaction_type, counterparty_account, counterparty_bank,
-			counterparty_country, timestamp, channel, user_id, branch_id, status
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 'pending_review')
+			transaction_type, counterparty_account, counterparty_bank, counterparty_bic,
+			counterparty_country, timestamp, timestamp_utc, channel, user_id, branch_id, status
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 'pending_review')
 	`
 
 	return retryOperation(func() error {
 		_, err := s.DB.Exec(
 			query,
 			processingID, tx.TransactionID, tx.AccountNumber, tx.Amount, tx.Currency,
-			tx.TransactionType, tx.CounterpartyAccount, tx.CounterpartyBank,
-			tx.CounterpartyCountry, tx.Timestamp, tx.Channel, tx.UserID, tx.BranchID,
+			tx.TransactionType, tx.CounterpartyAccount, tx.CounterpartyBank, tx.CounterpartyBIC,
+			tx.CounterpartyCountry, tx.Timestamp, transactionInstant(tx.Timestamp), tx.Channel, tx.UserID, tx.BranchID,
 		)
 		return err
 	}, 3, 50*time.Millisecond)
 }
 
+
+// SaveTransactions сохраняет пакет транзакций в одной транзакции SQLite
+// При ошибке не сохраняется ни одна запись пакета
+func (s *SQLiteStorage) SaveTransactions(items []*models.TransactionBatchItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO transactions (
+			processing_id, transaction_id, account_number, amount, currency,
+			transaction_type, counterparty_account, counterparty_bank, counterparty_bic,
+			counterparty_country, timestamp, timestamp_utc, channel, user_id, branch_id, status
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 'pending_review')
+	`
+
+	return retryOperation(func() error {
+		dbTx, err := s.DB.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		stmt, err := dbTx.Prepare(query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, item := range items {
+			tx := item.Transaction
+			if _, err := stmt.Exec(
+				item.ProcessingID, tx.TransactionID, tx.AccountNumber, tx.Amount, tx.Currency,
+				tx.TransactionType, tx.CounterpartyAccount, tx.CounterpartyBank, tx.CounterpartyBIC,
+				tx.CounterpartyCountry, tx.Timestamp, transactionInstant(tx.Timestamp), tx.Channel, tx.UserID, tx.BranchID,
+			); err != nil {
+				return err
+			}
+		}
+
+		return dbTx.Commit()
+	}, 3, 50*time.Millisecond)
+}
@@ -1,5 +1,11 @@
 package sqlite
 
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
 // initSchema инициализирует схему БД
 func (s *SQLiteStorage) initSchema() error {
 	query := `
@@ -13,8 +19,10 @@ func (s *SQLiteStorage) initSchema() error {
 		transaction_type TEXT NOT NULL,
 		counterparty_account TEXT,
 		counterparty_bank TEXT,
+		counterparty_bic TEXT,
 		counterparty_country TEXT,
 		timestamp DATETIME NOT NULL,
+		timestamp_utc INTEGER,
 		channel TEXT,
 		user_id TEXT,
 		branch_id TEXT,
@@ -31,9 +39,429 @@ func (s *SQLiteStorage) initSchema() error {
 	CREATE INDEX IF NOT EXISTS idx_account_number ON transactions(account_number);
 	CREATE INDEX IF NOT EXISTS idx_status ON transactions(status);
 	CREATE INDEX IF NOT EXISTS idx_created_at ON transactions(created_at);
+	CREATE INDEX IF NOT EXISTS idx_transactions_timestamp ON transactions(timestamp);
+	CREATE INDEX IF NOT EXISTS idx_transactions_risk_level ON transactions(risk_level);
+	CREATE INDEX IF NOT EXISTS idx_transactions_risk_score_id ON transactions(risk_score, id);
+	CREATE INDEX IF NOT EXISTS idx_transactions_amount_id ON transactions(amount, id);
+	CREATE INDEX IF NOT EXISTS idx_transactions_counterparty_country ON transactions(counterparty_country);
+	DROP INDEX IF EXISTS idx_transactions_risk_score;
+	DROP INDEX IF EXISTS idx_transactions_amount;
+	DROP INDEX IF EXISTS idx_transactions_currency;
+	DROP INDEX IF EXISTS idx_transactions_channel;
+
+	CREATE TABLE IF NOT EXISTS customers (
+		customer_id TEXT PRIMARY KEY,
+		full_name TEXT NOT NULL,
+		segment TEXT NOT NULL,
+		residency TEXT NOT NULL,
+		country TEXT,
+		kyc_risk_rating TEXT NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS accounts (
+		account_number TEXT PRIMARY KEY,
+		customer_id TEXT NOT NULL REFERENCES customers(customer_id),
+		currency TEXT,
+		opened_at DATETIME NOT NULL,
+		declared_turnover REAL NOT NULL DEFAULT 0,
+		status TEXT NOT NULL DEFAULT 'active',
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_accounts_customer_id ON accounts(customer_id);
+
+	CREATE TABLE IF NOT EXISTS flow_cycles (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		closing_transaction_id TEXT NOT NULL,
+		accounts TEXT NOT NULL,
+		edges TEXT NOT NULL,
+		cycle_length INTEGER NOT NULL,
+		total_amount REAL NOT NULL,
+		detected_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS flow_cycle_accounts (
+		cycle_id INTEGER NOT NULL REFERENCES flow_cycles(id) ON DELETE CASCADE,
+		account_number TEXT NOT NULL,
+		PRIMARY KEY (cycle_id, account_number)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_flow_cycles_closing_tx ON flow_cycles(closing_transaction_id);
+	CREATE INDEX IF NOT EXISTS idx_flow_cycle_accounts_account ON flow_cycle_accounts(account_number);
+
+	CREATE TABLE IF NOT EXISTS cases (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		account_number TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'open',
+		assigned_to TEXT,
+		resolution TEXT,
+		max_risk_score INTEGER NOT NULL DEFAULT 0,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		closed_at DATETIME
+	);
+
+	CREATE TABLE IF NOT EXISTS alerts (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		case_id INTEGER NOT NULL REFERENCES cases(id),
+		processing_id TEXT UNIQUE NOT NULL,
+		transaction_id TEXT NOT NULL,
+		account_number TEXT NOT NULL,
+		risk_score INTEGER NOT NULL,
+		risk_level TEXT NOT NULL,
+		recommendation TEXT,
+		flags TEXT NOT NULL DEFAULT '[]',
+		status TEXT NOT NULL DEFAULT 'open',
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS case_notes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		case_id INTEGER NOT NULL REFERENCES cases(id),
+		author TEXT NOT NULL,
+		text TEXT NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS case_attachments (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		case_id INTEGER NOT NULL REFERENCES cases(id),
+		name TEXT NOT NULL,
+		reference TEXT NOT NULL,
+		added_by TEXT NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_cases_account_status ON cases(account_number, status);
+	CREATE INDEX IF NOT EXISTS idx_cases_assigned_to ON cases(assigned_to);
+	CREATE INDEX IF NOT EXISTS idx_alerts_case_id ON alerts(case_id);
+	CREATE INDEX IF NOT EXISTS idx_alerts_account_number ON alerts(account_number);
+	CREATE INDEX IF NOT EXISTS idx_case_notes_case_id ON case_notes(case_id);
+	CREATE INDEX IF NOT EXISTS idx_case_attachments_case_id ON case_attachments(case_id);
+
+	CREATE TABLE IF NOT EXISTS transaction_flags (
+		processing_id TEXT NOT NULL,
+		flag TEXT NOT NULL,
+		ruleset_version TEXT NOT NULL,
+		flagged_at DATETIME NOT NULL,
+		PRIMARY KEY (processing_id, flag)
+	);
+
+	CREATE TABLE IF NOT EXISTS dispositions (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		processing_id TEXT UNIQUE NOT NULL,
+		alert_id INTEGER,
+		disposition TEXT NOT NULL,
+		analyst TEXT NOT NULL,
+		comment TEXT,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_transaction_flags_flagged_at ON transaction_flags(flagged_at);
+
+	CREATE TABLE IF NOT EXISTS transaction_decisions (
+		processing_id TEXT PRIMARY KEY,
+		decision TEXT NOT NULL,
+		actor TEXT NOT NULL,
+		reason TEXT,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS decision_history (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		processing_id TEXT NOT NULL,
+		from_decision TEXT NOT NULL,
+		to_decision TEXT NOT NULL,
+		actor TEXT NOT NULL,
+		approved_by TEXT,
+		reason TEXT,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS decision_approvals (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		processing_id TEXT NOT NULL,
+		from_decision TEXT NOT NULL,
+		to_decision TEXT NOT NULL,
+		requested_by TEXT NOT NULL,
+		reason TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'pending',
+		resolved_by TEXT,
+		comment TEXT,
+		expires_at DATETIME NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		resolved_at DATETIME
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_decision_history_processing_id ON decision_history(processing_id);
+	CREATE INDEX IF NOT EXISTS idx_decision_approvals_processing_id ON decision_approvals(processing_id, status);
+	CREATE INDEX IF NOT EXISTS idx_decision_approvals_status ON decision_approvals(status, expires_at);
+
+	CREATE TABLE IF NOT EXISTS transaction_holds (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		processing_id TEXT UNIQUE NOT NULL,
+		expiry_action TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'active',
+		expires_at DATETIME NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		resolved_at DATETIME
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_transaction_holds_status ON transaction_holds(status, expires_at);
+
+	CREATE TABLE IF NOT EXISTS blacklist_entries (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		list_type TEXT NOT NULL,
+		account_number TEXT,
+		name TEXT,
+		reason TEXT NOT NULL,
+		added_by TEXT NOT NULL,
+		lookback_from DATETIME NOT NULL,
+		screened_at DATETIME,
+		match_count INTEGER NOT NULL DEFAULT 0,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS blacklist_matches (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		entry_id INTEGER NOT NULL REFERENCES blacklist_entries(id),
+		processing_id TEXT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		account_number TEXT NOT NULL,
+		counterparty_account TEXT,
+		amount REAL NOT NULL,
+		currency TEXT NOT NULL,
+		transaction_time DATETIME NOT NULL,
+		matched_on TEXT NOT NULL,
+		alert_id INTEGER,
+		case_id INTEGER,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(entry_id, processing_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_blacklist_entries_list_type ON blacklist_entries(list_type);
+	CREATE INDEX IF NOT EXISTS idx_transactions_counterparty_account ON transactions(counterparty_account);
+
+	CREATE TABLE IF NOT EXISTS transaction_analyses (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		processing_id TEXT NOT NULL,
+		version INTEGER NOT NULL,
+		risk_score INTEGER NOT NULL,
+		risk_level TEXT NOT NULL,
+		recommendation TEXT NOT NULL,
+		flags TEXT NOT NULL,
+		evidence TEXT,
+		ruleset_version TEXT,
+		source TEXT NOT NULL,
+		requested_by TEXT,
+		reason TEXT,
+		analyzed_at DATETIME NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		rule_inputs TEXT,
+		UNIQUE(processing_id, version)
+	);
+
+	CREATE TABLE IF NOT EXISTS sar_reports (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		case_id INTEGER NOT NULL REFERENCES cases(id),
+		report_code TEXT NOT NULL,
+		reference_number TEXT UNIQUE NOT NULL,
+		regulator_reference TEXT,
+		status TEXT NOT NULL DEFAULT 'generated',
+		transaction_count INTEGER NOT NULL DEFAULT 0,
+		created_by TEXT NOT NULL,
+		comment TEXT,
+		xml BLOB NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		submitted_at DATETIME
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_sar_reports_case_id ON sar_reports(case_id);
+	CREATE INDEX IF NOT EXISTS idx_sar_reports_status ON sar_reports(status);
+
+	CREATE TABLE IF NOT EXISTS mandatory_reports (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		rule TEXT NOT NULL,
+		processing_id TEXT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		account_number TEXT NOT NULL,
+		customer_id TEXT,
+		transaction_type TEXT NOT NULL,
+		amount REAL NOT NULL,
+		currency TEXT NOT NULL,
+		amount_local REAL NOT NULL,
+		daily_total_local REAL NOT NULL DEFAULT 0,
+		business_date TEXT NOT NULL,
+		transaction_time DATETIME NOT NULL,
+		status TEXT NOT NULL DEFAULT 'queued',
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		exported_at DATETIME,
+		UNIQUE (processing_id, rule)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_mandatory_reports_date_status ON mandatory_reports(business_date, status);
+	CREATE INDEX IF NOT EXISTS idx_transactions_account_timestamp ON transactions(account_number, timestamp);
+
+	-- Лента изменений транзакций для подписок; заполняется триггерами, поэтому видит записи обоих сервисов
+	CREATE TABLE IF NOT EXISTS transaction_updates (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		event_type TEXT NOT NULL,
+		processing_id TEXT NOT NULL,
+		transaction_id TEXT NOT NULL,
+		account_number TEXT NOT NULL,
+		counterparty_account TEXT,
+		amount REAL NOT NULL,
+		currency TEXT NOT NULL,
+		status TEXT NOT NULL,
+		risk_score INTEGER,
+		risk_level TEXT,
+		decision TEXT,
+		alert_id INTEGER,
+		case_id INTEGER,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_transaction_updates_created_at ON transaction_updates(created_at);
+
+	CREATE TRIGGER IF NOT EXISTS trg_transaction_updates_received AFTER INSERT ON transactions
+	BEGIN
+		INSERT INTO transaction_updates (event_type, processing_id, transaction_id, account_number, counterparty_account, amount, currency, status, risk_score, risk_level)
+		VALUES ('received', NEW.processing_id, NEW.transaction_id, NEW.account_number, NEW.counterparty_account, NEW.amount, NEW.currency, NEW.status, NEW.risk_score, NEW.risk_level);
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS trg_transaction_updates_analyzed AFTER UPDATE OF risk_score, risk_level, analysis_timestamp ON transactions
+	BEGIN
+		INSERT INTO transaction_updates (event_type, processing_id, transaction_id, account_number, counterparty_account, amount, currency, status, risk_score, risk_level)
+		VALUES ('analyzed', NEW.processing_id, NEW.transaction_id, NEW.account_number, NEW.counterparty_account, NEW.amount, NEW.currency, NEW.status, NEW.risk_score, NEW.risk_level);
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS trg_transaction_updates_alert AFTER INSERT ON alerts
+	BEGIN
+		INSERT INTO transaction_updates (event_type, processing_id, transaction_id, account_number, counterparty_account, amount, currency, status, risk_score, risk_level, alert_id, case_id)
+		SELECT 'alert_raised', t.processing_id, t.transaction_id, t.account_number, t.counterparty_account, t.amount, t.currency, t.status, t.risk_score, t.risk_level, NEW.id, NEW.case_id
+		FROM transactions t WHERE t.processing_id = NEW.processing_id;
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS trg_transaction_updates_decision AFTER INSERT ON decision_history
+	BEGIN
+		INSERT INTO transaction_updates (event_type, processing_id, transaction_id, account_number, counterparty_account, amount, currency, status, risk_score, risk_level, decision)
+		SELECT 'decision_changed', t.processing_id, t.transaction_id, t.account_number, t.counterparty_account, t.amount, t.currency, t.status, t.risk_score, t.risk_level, NEW.to_decision
+		FROM transactions t WHERE t.processing_id = NEW.processing_id;
+	END;
 	`
 
-	_, err := s.DB.Exec(query)
+	if _, err := s.DB.Exec(query); err != nil {
+		return err
+	}
+
+	// Колонки, добавленные после создания таблиц: CREATE TABLE IF NOT EXISTS не меняет существующую БД
+	for _, c := range addedColumns {
+		if err := s.ensureColumn(c.table, c.column, c.definition); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", c.table, c.column, err)
+		}
+	}
+
+	if err := s.backfillTransactionInstants(); err != nil {
+		return fmt.Errorf("failed to backfill transactions.timestamp_utc: %w", err)
+	}
+
+	// Индексы по добавленным колонкам создаются после миграции: в старой БД колонок нет при выполнении основного запроса
+	for _, index := range addedIndexes {
+		if _, err := s.DB.Exec(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addedColumns - колонки, добавленные в уже существующие таблицы, в порядке появления
+var addedColumns = []struct {
+	table, column, definition string
+}{
+	{"decision_history", "approved_by", "TEXT"},
+	{"transactions", "counterparty_bic", "TEXT"},
+	{"transaction_analyses", "rule_inputs", "TEXT"},
+	{"transactions", "timestamp_utc", "INTEGER"},
+}
+
+// addedIndexes - индексы по колонкам из addedColumns
+var addedIndexes = []string{
+	"CREATE INDEX IF NOT EXISTS idx_transactions_timestamp_utc_id ON transactions(timestamp_utc, id)",
+}
+
+// backfillTransactionInstants заполняет timestamp_utc у операций, сохраненных до появления колонки
+// Время разбирается драйвером, а не SQL: сохраненная строка содержит часовой пояс, который julianday не понимает
+func (s *SQLiteStorage) backfillTransactionInstants() error {
+	rows, err := s.DB.Query("SELECT id, timestamp FROM transactions WHERE timestamp_utc IS NULL")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	instants := make(map[int64]int64)
+	for rows.Next() {
+		var (
+			id        int64
+			timestamp time.Time
+		)
+		if err := rows.Scan(&id, &timestamp); err != nil {
+			return err
+		}
+		instants[id] = transactionInstant(timestamp)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+	if len(instants) == 0 {
+		return nil
+	}
+
+	dbTx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback()
+	for id, instant := range instants {
+		if _, err := dbTx.Exec("UPDATE transactions SET timestamp_utc = ? WHERE id = ?", instant, id); err != nil {
+			return err
+		}
+	}
+	return dbTx.Commit()
+}
+
+// ensureColumn добавляет колонку в существующую таблицу, если ее еще нет
+func (s *SQLiteStorage) ensureColumn(table, column, definition string) error {
+	rows, err := s.DB.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid, notNull, pk int
+			name, colType    string
+			defaultValue     sql.NullString
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = s.DB.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
 	return err
 }
 
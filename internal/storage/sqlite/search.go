@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// transactionSort - колонка сортировки списка транзакций
+// Для каждой колонки есть индекс (column, id), которым выполняются и ORDER BY, и условие курсора
+type transactionSort struct {
+	column   string
+	nullable bool // Непроанализированные операции без оценки идут ниже любой оценки
+}
+
+// transactionSorts - колонки сортировки списка транзакций
+// Время операции хранится с исходным часовым поясом, поэтому сортируется по timestamp_utc
+var transactionSorts = map[string]transactionSort{
+	models.TransactionSortCreatedAt: {column: "id"},
+	models.TransactionSortTimestamp: {column: "timestamp_utc"},
+	models.TransactionSortAmount:    {column: "amount"},
+	models.TransactionSortRiskScore: {column: "risk_score", nullable: true},
+}
+
+// transactionInstant - момент операции для колонки timestamp_utc: микросекунды Unix
+// Значение точно представимо в float64, поэтому курсор не теряет точность
+func transactionInstant(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+// SearchTransactions получает страницу транзакций по фильтру в порядке filter.SortBy/filter.Order
+// Порядок однозначен: при равных значениях поля сортировки записи упорядочиваются по ID
+func (s *SQLiteStorage) SearchTransactions(filter models.TransactionSearchFilter) ([]*models.TransactionSummary, error) {
+	sort, ok := transactionSorts[filter.SortBy]
+	if !ok {
+		sort = transactionSorts[models.TransactionSortCreatedAt]
+	}
+	// NULL считается меньше любой оценки; порядок NULL совпадает с порядком SQLite по умолчанию, и индекс применим
+	direction, nulls, comparison := "ASC", " NULLS FIRST", ">"
+	if filter.Order != models.SortOrderAsc {
+		direction, nulls, comparison = "DESC", " NULLS LAST", "<"
+	}
+	if !sort.nullable {
+		nulls = ""
+	}
+	column := sort.column
+
+	var conditions []string
+	var args []interface{}
+	addEquals := func(column, value string) {
+		if value != "" {
+			conditions = append(conditions, column+" = ?")
+			args = append(args, value)
+		}
+	}
+	addEquals("account_number", filter.AccountNumber)
+	addEquals("counterparty_account", filter.Counterparty)
+	addEquals("risk_level", filter.RiskLevel)
+	addEquals("status", filter.Status)
+	addEquals("currency", filter.Currency)
+	addEquals("counterparty_country", filter.Country)
+	addEquals("channel", filter.Channel)
+	if filter.MinScore != nil {
+		conditions = append(conditions, "risk_score >= ?")
+		args = append(args, *filter.MinScore)
+	}
+	if filter.MaxScore != nil {
+		conditions = append(conditions, "risk_score <= ?")
+		args = append(args, *filter.MaxScore)
+	}
+	conditions, args = appendTimeRange(conditions, args, filter.From, filter.To)
+	if filter.After != nil {
+		condition, cursorArgs := transactionCursorCondition(sort, comparison, filter.After)
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
+	}
+
+	query := `
+		SELECT id, ` + column + `, processing_id, transaction_id, account_number, amount, currency, transaction_type,
+		       COALESCE(counterparty_account, ''), COALESCE(counterparty_country, ''), COALESCE(channel, ''),
+		       timestamp, status, risk_score, risk_level, analysis_timestamp, created_at
+		FROM transactions` + whereClause(conditions) + `
+		ORDER BY ` + column + ` ` + direction + nulls + `, id ` + direction + `
+		LIMIT ?
+	`
+	args = append(args, filter.Limit)
+
+	rows, err := s.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*models.TransactionSummary
+	for rows.Next() {
+		var (
+			t       models.TransactionSummary
+			sortKey sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&t.ID, &sortKey, &t.ProcessingID, &t.TransactionID, &t.AccountNumber, &t.Amount, &t.Currency, &t.TransactionType,
+			&t.CounterpartyAccount, &t.CounterpartyCountry, &t.Channel,
+			&t.Timestamp, &t.Status, &t.RiskScore, &t.RiskLevel, &t.AnalysisTimestamp, &t.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		t.SortKey, t.SortKeyNull = sortKey.Float64, !sortKey.Valid
+		result = append(result, &t)
+	}
+	return result, rows.Err()
+}
+
+// transactionCursorCondition строит условие "после записи курсора" для порядка по sort
+// Записи без значения идут первыми при сортировке по возрастанию и последними при сортировке по убыванию
+func transactionCursorCondition(sort transactionSort, comparison string, after *models.TransactionCursor) (string, []interface{}) {
+	column := sort.column
+	if column == "id" {
+		return "id " + comparison + " ?", []interface{}{after.ID}
+	}
+
+	tail := ""
+	switch {
+	case sort.nullable && after.SortKeyNull:
+		// Курсор среди записей без значения: дальше оставшиеся из них, а при сортировке по возрастанию - все со значением
+		condition := "(" + column + " IS NULL AND id " + comparison + " ?)"
+		if comparison == ">" {
+			condition = "(" + condition + " OR " + column + " IS NOT NULL)"
+		}
+		return condition, []interface{}{after.ID}
+	case sort.nullable && comparison == "<":
+		tail = " OR " + column + " IS NULL"
+	}
+	return "(" + column + " " + comparison + " ? OR (" + column + " = ? AND id " + comparison + " ?)" + tail + ")",
+		[]interface{}{after.SortKey, after.SortKey, after.ID}
+}
+
+// appendTimeRange добавляет условия на время операции в периоде [from, to)
+// Момент сравнивается в UTC по timestamp_utc, независимо от часового пояса операции
+func appendTimeRange(conditions []string, args []interface{}, from, to *time.Time) ([]string, []interface{}) {
+	if from != nil {
+		conditions = append(conditions, "timestamp_utc >= ?")
+		args = append(args, transactionInstant(*from))
+	}
+	if to != nil {
+		conditions = append(conditions, "timestamp_utc < ?")
+		args = append(args, transactionInstant(*to))
+	}
+	return conditions, args
+}
@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"bank-aml-system/internal/models"
+)
+
+// transactionUpdatesSeq - последний выданный ID ленты; остается после удаления событий по сроку хранения
+const transactionUpdatesSeq = `COALESCE((SELECT seq FROM sqlite_sequence WHERE name = 'transaction_updates'), 0)`
+
+// ListTransactionUpdates возвращает события ленты после filter.AfterID, подходящие под условия
+// Чтение идет в одной транзакции, поэтому NextID не пропускает события, записанные во время запроса
+func (s *SQLiteStorage) ListTransactionUpdates(filter models.TransactionUpdateFilter) (*models.TransactionUpdatePage, error) {
+	dbTx, err := s.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback()
+
+	var latest int64
+	if err := dbTx.QueryRow(`SELECT ` + transactionUpdatesSeq).Scan(&latest); err != nil {
+		return nil, err
+	}
+
+	conditions := []string{"id > ?", "id <= ?"}
+	args := []interface{}{filter.AfterID, latest}
+	if filter.AccountNumber != "" {
+		conditions = append(conditions, "(account_number = ? OR counterparty_account = ?)")
+		args = append(args, filter.AccountNumber, filter.AccountNumber)
+	}
+	if filter.RiskLevel != "" {
+		conditions = append(conditions, "risk_level = ?")
+		args = append(args, filter.RiskLevel)
+	}
+	if filter.MinScore != nil {
+		conditions = append(conditions, "risk_score >= ?")
+		args = append(args, *filter.MinScore)
+	}
+	if len(filter.Types) > 0 {
+		conditions = append(conditions, "event_type IN ("+placeholders(len(filter.Types))+")")
+		for _, t := range filter.Types {
+			args = append(args, t)
+		}
+	}
+	args = append(args, filter.Limit)
+
+	rows, err := dbTx.Query(`
+		SELECT id, event_type, processing_id, transaction_id, account_number, counterparty_account,
+		       amount, currency, status, risk_score, risk_level, decision, alert_id, case_id, created_at
+		FROM transaction_updates`+whereClause(conditions)+`
+		ORDER BY id
+		LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &models.TransactionUpdatePage{Updates: []*models.TransactionUpdate{}, NextID: latest}
+	for rows.Next() {
+		var u models.TransactionUpdate
+		var counterparty, decision sql.NullString
+		var riskScore, alertID, caseID sql.NullInt64
+		var riskLevel sql.NullString
+		if err := rows.Scan(
+			&u.ID, &u.Type, &u.ProcessingID, &u.TransactionID, &u.AccountNumber, &counterparty,
+			&u.Amount, &u.Currency, &u.Status, &riskScore, &riskLevel, &decision, &alertID, &caseID, &u.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		u.CounterpartyAccount = counterparty.String
+		u.Decision = decision.String
+		u.AlertID = alertID.Int64
+		u.CaseID = caseID.Int64
+		if riskScore.Valid {
+			score := int(riskScore.Int64)
+			u.RiskScore = &score
+		}
+		if riskLevel.Valid {
+			u.RiskLevel = &riskLevel.String
+		}
+		page.Updates = append(page.Updates, &u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Страница заполнена: продолжаем после последнего прочитанного события, а не после последнего в ленте
+	if filter.Limit > 0 && len(page.Updates) == filter.Limit {
+		page.NextID = page.Updates[len(page.Updates)-1].ID
+	}
+	return page, nil
+}
+
+// GetTransactionUpdateBounds возвращает первый хранящийся и последний выданный ID ленты
+// Если лента пуста, oldest на единицу больше latest
+func (s *SQLiteStorage) GetTransactionUpdateBounds() (oldest, latest int64, err error) {
+	err = s.DB.QueryRow(`
+		SELECT COALESCE((SELECT MIN(id) FROM transaction_updates), `+transactionUpdatesSeq+` + 1), `+transactionUpdatesSeq,
+	).Scan(&oldest, &latest)
+	return oldest, latest, err
+}
+
+// DeleteTransactionUpdatesBefore удаляет события ленты, записанные раньше before
+func (s *SQLiteStorage) DeleteTransactionUpdatesBefore(before time.Time) (int64, error) {
+	var deleted int64
+	err := retryOperation(func() error {
+		result, err := s.DB.Exec(`DELETE FROM transaction_updates WHERE created_at < ?`, before.UTC().Format("2006-01-02 15:04:05"))
+		if err != nil {
+			return err
+		}
+		deleted, err = result.RowsAffected()
+		return err
+	}, 3, 50*time.Millisecond)
+	return deleted, err
+}
@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ibanLengths - длина IBAN по странам реестра IBAN (ISO 13616)
+// Номер счета, начинающийся с кода страны из реестра и двух цифр, проверяется как IBAN;
+// остальные номера считаются внутренними номерами счетов банка
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24,
+	"SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28, "TL": 23,
+	"TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// maxAccountLength - наибольшая длина номера счета (ISO 20022)
+const maxAccountLength = 34
+
+// looksLikeIBAN проверяет, начинается ли номер с кода страны реестра IBAN (в любом регистре) и двух контрольных цифр
+func looksLikeIBAN(account string) bool {
+	if len(account) < 4 {
+		return false
+	}
+	if _, ok := ibanLengths[strings.ToUpper(account[:2])]; !ok {
+		return false
+	}
+	return isDigit(account[2]) && isDigit(account[3])
+}
+
+// checkIBAN проверяет длину IBAN для страны, допустимые символы и контрольную сумму по модулю 97
+// Возвращает описание ошибки или пустую строку
+func checkIBAN(iban string) string {
+	country := strings.ToUpper(iban[:2])
+	if length := ibanLengths[country]; len(iban) != length {
+		return fmt.Sprintf("IBAN for %s must be %d characters long without spaces", country, length)
+	}
+
+	// Первые четыре символа переносятся в конец, буквы заменяются числами A=10 ... Z=35
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case isDigit(c):
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return "IBAN must contain only uppercase letters and digits"
+		}
+	}
+	if remainder != 1 {
+		return "IBAN checksum is invalid"
+	}
+	return ""
+}
+
+// checkAccount проверяет номер счета: IBAN - по контрольной сумме, внутренний номер - по длине и символам
+func checkAccount(account string) string {
+	if looksLikeIBAN(account) {
+		return checkIBAN(account)
+	}
+	if len(account) > maxAccountLength {
+		return fmt.Sprintf("must be at most %d characters long", maxAccountLength)
+	}
+	if strings.IndexFunc(account, func(r rune) bool { return !isAccountRune(r) }) >= 0 {
+		return "must contain only letters, digits and hyphens"
+	}
+	return ""
+}
+
+func isAccountRune(r rune) bool {
+	return r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
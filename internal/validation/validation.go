@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Error - ошибка проверки запроса с описанием по полям
+type Error struct {
+	Fields []models.FieldError
+}
+
+// Error перечисляет ошибки полей через точку с запятой
+func (e *Error) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		parts = append(parts, field.Field+": "+field.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// FieldErrors возвращает ошибки полей, если err - ошибка проверки
+func FieldErrors(err error) ([]models.FieldError, bool) {
+	var validationErr *Error
+	if !errors.As(err, &validationErr) {
+		return nil, false
+	}
+	return validationErr.Fields, true
+}
+
+// Validator проверяет входящие транзакции одинаково для REST, пакетной загрузки и gRPC
+// Правила полей задаются тегами binding модели (те же, что проверяет gin), поверх них проверяются
+// контрольная сумма IBAN, код страны в BIC и допустимое время операции
+type Validator struct {
+	validate      *validator.Validate
+	maxFutureSkew time.Duration
+	maxAge        time.Duration
+	now           func() time.Time
+}
+
+// NewValidator создает проверку транзакций по конфигурации
+func NewValidator(cfg config.ValidationConfig) *Validator {
+	validate := validator.New()
+	validate.SetTagName("binding")
+	validate.RegisterTagNameFunc(jsonFieldName)
+
+	return &Validator{
+		validate:      validate,
+		maxFutureSkew: cfg.MaxFutureSkew,
+		maxAge:        cfg.MaxAge,
+		now:           time.Now,
+	}
+}
+
+// ValidateTransaction проверяет транзакцию и возвращает *Error со всеми ошибками полей
+// Незаданное время операции не проверяется: его заменяет время приема
+func (v *Validator) ValidateTransaction(tx *models.Transaction) error {
+	var fields []models.FieldError
+	if err := v.validate.Struct(tx); err != nil {
+		var tagErrs validator.ValidationErrors
+		if !errors.As(err, &tagErrs) {
+			return err
+		}
+		for _, tagErr := range tagErrs {
+			fields = append(fields, models.FieldError{Field: tagErr.Field(), Message: tagMessage(tagErr)})
+		}
+	}
+	invalid := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		invalid[field.Field] = true
+	}
+	addError := func(field, message string) {
+		if message != "" && !invalid[field] {
+			fields = append(fields, models.FieldError{Field: field, Message: message})
+		}
+	}
+
+	if tx.AccountNumber != "" {
+		addError("account_number", checkAccount(tx.AccountNumber))
+	}
+	if tx.CounterpartyAccount != "" {
+		addError("counterparty_account", checkAccount(tx.CounterpartyAccount))
+	}
+	// Формат BIC уже проверен тегом: дополнительная проверка только для корректного по формату кода
+	if tx.CounterpartyBIC != "" && !invalid["counterparty_bic"] {
+		addError("counterparty_bic", v.checkBIC(tx.CounterpartyBIC))
+	}
+	if !tx.Timestamp.IsZero() {
+		addError("timestamp", v.checkTimestamp(tx.Timestamp))
+	}
+
+	if len(fields) > 0 {
+		return &Error{Fields: fields}
+	}
+	return nil
+}
+
+// checkBIC дополняет проверку формата BIC: только заглавные буквы и существующий код страны (символы 5-6)
+func (v *Validator) checkBIC(bic string) string {
+	if bic != strings.ToUpper(bic) {
+		return "BIC must be in uppercase"
+	}
+	if v.validate.Var(bic[4:6], "iso3166_1_alpha2") != nil {
+		return fmt.Sprintf("BIC country code %s is not an ISO 3166-1 alpha-2 code", bic[4:6])
+	}
+	return ""
+}
+
+// checkTimestamp проверяет, что время операции не слишком далеко в будущем или прошлом
+func (v *Validator) checkTimestamp(timestamp time.Time) string {
+	now := v.now()
+	if v.maxFutureSkew > 0 && timestamp.After(now.Add(v.maxFutureSkew)) {
+		return fmt.Sprintf("must not be more than %s in the future", v.maxFutureSkew)
+	}
+	if v.maxAge > 0 && timestamp.Before(now.Add(-v.maxAge)) {
+		return fmt.Sprintf("must not be older than %s", v.maxAge)
+	}
+	return ""
+}
+
+// tagMessage описывает нарушенное правило тега binding
+func tagMessage(err validator.FieldError) string {
+	switch err.Tag() {
+	case "required":
+		return "is required"
+	case "max":
+		return fmt.Sprintf("must be at most %s characters long", err.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", err.Param())
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(err.Param(), " ", ", ")
+	case "iso4217":
+		return "must be an ISO 4217 currency code in uppercase, e.g. RUB"
+	case "iso3166_1_alpha2":
+		return "must be an ISO 3166-1 alpha-2 country code in uppercase, e.g. KY"
+	case "bic":
+		return "must be a BIC (ISO 9362) of 8 or 11 characters, e.g. SABRRUMM"
+	default:
+		return fmt.Sprintf("failed the %s check", err.Tag())
+	}
+}
+
+// jsonFieldName возвращает имя поля в JSON, чтобы ошибки указывали поля запроса
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
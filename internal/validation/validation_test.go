@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"bank-aml-system/config"
+	"bank-aml-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func newTestValidator() *Validator {
+	v := NewValidator(config.ValidationConfig{MaxFutureSkew: 5 * time.Minute, MaxAge: 365 * 24 * time.Hour})
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+func validTransaction() *models.Transaction {
+	return &models.Transaction{
+		TransactionID:       "TXN-001",
+		AccountNumber:       "ACC123456",
+		Amount:              1500.0,
+		Currency:            "RUB",
+		TransactionType:     "international_transfer",
+		CounterpartyAccount: "DE89370400440532013000",
+		CounterpartyBank:    "Commerzbank",
+		CounterpartyBIC:     "COBADEFFXXX",
+		CounterpartyCountry: "DE",
+		Timestamp:           testNow.Add(-time.Hour),
+		Channel:             "online",
+	}
+}
+
+func TestValidateTransaction_Valid(t *testing.T) {
+	v := newTestValidator()
+	assert.NoError(t, v.ValidateTransaction(validTransaction()))
+
+	// Необязательные поля и время операции можно не передавать
+	tx := validTransaction()
+	tx.CounterpartyAccount, tx.CounterpartyBIC, tx.CounterpartyCountry, tx.Channel = "", "", "", ""
+	tx.Timestamp = time.Time{}
+	assert.NoError(t, v.ValidateTransaction(tx))
+}
+
+func TestValidateTransaction_FieldErrors(t *testing.T) {
+	cases := map[string]struct {
+		modify  func(tx *models.Transaction)
+		field   string
+		message string
+	}{
+		"lowercase currency":      {func(tx *models.Transaction) { tx.Currency = "rub" }, "currency", "ISO 4217"},
+		"unknown currency":        {func(tx *models.Transaction) { tx.Currency = "RUR" }, "currency", "ISO 4217"},
+		"country name":            {func(tx *models.Transaction) { tx.CounterpartyCountry = "Cayman" }, "counterparty_country", "ISO 3166-1"},
+		"lowercase country":       {func(tx *models.Transaction) { tx.CounterpartyCountry = "ky" }, "counterparty_country", "ISO 3166-1"},
+		"unknown type":            {func(tx *models.Transaction) { tx.TransactionType = "wire" }, "transaction_type", "must be one of: transfer, international_transfer"},
+		"unknown channel":         {func(tx *models.Transaction) { tx.Channel = "fax" }, "channel", "must be one of"},
+		"zero amount":             {func(tx *models.Transaction) { tx.Amount = 0 }, "amount", "is required"},
+		"missing account":         {func(tx *models.Transaction) { tx.AccountNumber = "" }, "account_number", "is required"},
+		"iban checksum":           {func(tx *models.Transaction) { tx.CounterpartyAccount = "DE89370400440532013001" }, "counterparty_account", "checksum"},
+		"iban length":             {func(tx *models.Transaction) { tx.AccountNumber = "GB82WEST1234569876543" }, "account_number", "must be 22 characters"},
+		"lowercase iban":          {func(tx *models.Transaction) { tx.AccountNumber = "gb82west12345698765432" }, "account_number", "uppercase"},
+		"account characters":      {func(tx *models.Transaction) { tx.AccountNumber = "ACC 123" }, "account_number", "letters, digits and hyphens"},
+		"bic format":              {func(tx *models.Transaction) { tx.CounterpartyBIC = "COBADE" }, "counterparty_bic", "ISO 9362"},
+		"bic lowercase":           {func(tx *models.Transaction) { tx.CounterpartyBIC = "cobadeff" }, "counterparty_bic", "uppercase"},
+		"bic country":             {func(tx *models.Transaction) { tx.CounterpartyBIC = "COBAQQFF" }, "counterparty_bic", "country code QQ"},
+		"timestamp in the future": {func(tx *models.Transaction) { tx.Timestamp = testNow.Add(time.Hour) }, "timestamp", "in the future"},
+		"timestamp in the past":   {func(tx *models.Transaction) { tx.Timestamp = testNow.AddDate(-2, 0, 0) }, "timestamp", "older than"},
+		"transaction id too long": {func(tx *models.Transaction) { tx.TransactionID = string(make([]byte, 65)) }, "transaction_id", "at most 64"},
+	}
+	v := newTestValidator()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tx := validTransaction()
+			tc.modify(tx)
+
+			err := v.ValidateTransaction(tx)
+
+			fields, ok := FieldErrors(err)
+			require.True(t, ok, "expected validation error, got %v", err)
+			require.Len(t, fields, 1)
+			assert.Equal(t, tc.field, fields[0].Field)
+			assert.Contains(t, fields[0].Message, tc.message)
+		})
+	}
+}
+
+func TestValidateTransaction_ReportsAllFields(t *testing.T) {
+	tx := validTransaction()
+	tx.Currency = "rub"
+	tx.CounterpartyCountry = "Cayman"
+	tx.CounterpartyAccount = "DE89370400440532013001"
+
+	err := newTestValidator().ValidateTransaction(tx)
+
+	fields, ok := FieldErrors(err)
+	require.True(t, ok)
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Field)
+	}
+	assert.ElementsMatch(t, []string{"currency", "counterparty_country", "counterparty_account"}, names)
+	assert.Contains(t, err.Error(), "currency: must be an ISO 4217 currency code")
+}
+
+func TestValidateTransaction_TimeWindowDisabled(t *testing.T) {
+	v := NewValidator(config.ValidationConfig{})
+	tx := validTransaction()
+	tx.Timestamp = time.Now().AddDate(-10, 0, 0)
+	assert.NoError(t, v.ValidateTransaction(tx))
+}
+
+func TestCheckAccount(t *testing.T) {
+	valid := []string{"ACC123456", "40817810099910004312", "GB82WEST12345698765432", "RU0204452560040702810412345678901", "US12345678"}
+	for _, account := range valid {
+		assert.Empty(t, checkAccount(account), account)
+	}
+	assert.NotEmpty(t, checkAccount("ACC12345678901234567890123456789012345"))
+}